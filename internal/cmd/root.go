@@ -8,11 +8,15 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/core/config"
 	"github.com/futuretea/rancher-mcp-server/pkg/core/logging"
 	"github.com/futuretea/rancher-mcp-server/pkg/core/version"
 	internalhttp "github.com/futuretea/rancher-mcp-server/pkg/server/http"
 	"github.com/futuretea/rancher-mcp-server/pkg/server/mcp"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/rancher"
 )
 
 // IOStreams represents standard input, output, and error streams
@@ -34,11 +38,15 @@ func bindFlags(cmd *cobra.Command) error {
 		"sse_base_url": "sse-base-url",
 		"log_level":    "log-level",
 		// Rancher configuration
-		"rancher_server_url":   "rancher-server-url",
-		"rancher_token":        "rancher-token",
-		"rancher_access_key":   "rancher-access-key",
-		"rancher_secret_key":   "rancher-secret-key",
-		"rancher_tls_insecure": "rancher-tls-insecure",
+		"rancher_server_url":        "rancher-server-url",
+		"rancher_token":             "rancher-token",
+		"rancher_access_key":        "rancher-access-key",
+		"rancher_secret_key":        "rancher-secret-key",
+		"rancher_tls_insecure":      "rancher-tls-insecure",
+		"rancher_request_timeout":   "rancher-request-timeout",
+		"rancher_rate_limit_qps":    "rancher-rate-limit-qps",
+		"rancher_rate_limit_burst":  "rancher-rate-limit-burst",
+		"rancher_project_cache_ttl": "rancher-project-cache-ttl",
 		// Security configuration
 		"read_only":           "read-only",
 		"disable_destructive": "disable-destructive",
@@ -48,13 +56,22 @@ func bindFlags(cmd *cobra.Command) error {
 		"enable_container_file_upload":   "enable-container-file-upload",
 		"enable_container_file_download": "enable-container-file-download",
 		"max_file_size":                  "max-file-size",
+		// Credential configuration
+		"enable_cluster_kubeconfig": "enable-cluster-kubeconfig",
+		"kubeconfig_max_ttl":        "kubeconfig-max-ttl",
 		// Output configuration
-		"list_output":    "list-output",
-		"output_filters": "output-filters",
+		"list_output":            "list-output",
+		"output_filters":         "output-filters",
+		"time_display":           "time-display",
+		"table_auto_width":       "table-auto-width",
+		"table_max_column_width": "table-max-column-width",
+		// Audit configuration
+		"audit_log": "audit-log",
 		// Toolset configuration
-		"toolsets":       "toolsets",
-		"enabled_tools":  "enabled-tools",
-		"disabled_tools": "disabled-tools",
+		"toolsets":          "toolsets",
+		"disabled_toolsets": "disabled-toolsets",
+		"enabled_tools":     "enabled-tools",
+		"disabled_tools":    "disabled-tools",
 	}
 
 	for key, flag := range flagBindings {
@@ -109,6 +126,10 @@ for network access.`,
 	cmd.Flags().String("rancher-access-key", "", "Rancher access key")
 	cmd.Flags().String("rancher-secret-key", "", "Rancher secret key")
 	cmd.Flags().Bool("rancher-tls-insecure", false, "Rancher server tls insecure")
+	cmd.Flags().Duration("rancher-request-timeout", steve.DefaultRequestTimeout, "Timeout for each Kubernetes API request to a cluster (0 disables it)")
+	cmd.Flags().Float64("rancher-rate-limit-qps", steve.DefaultRateLimitQPS, "Kubernetes API requests per second allowed to each cluster (0 keeps client-go defaults)")
+	cmd.Flags().Int("rancher-rate-limit-burst", steve.DefaultRateLimitBurst, "Burst of Kubernetes API requests allowed to each cluster above rancher-rate-limit-qps")
+	cmd.Flags().Duration("rancher-project-cache-ttl", norman.DefaultProjectCacheTTL, "How long a cluster's project list and the detected project of a namespace are reused before being fetched again (0 disables the cache)")
 
 	// Security configuration flags
 	cmd.Flags().Bool("read-only", true, "Run in read-only mode")
@@ -118,13 +139,22 @@ for network access.`,
 	cmd.Flags().Bool("enable-container-file-upload", false, "Enable container file upload tool")
 	cmd.Flags().Bool("enable-container-file-download", false, "Enable container file download tool")
 	cmd.Flags().String("max-file-size", "10Mi", "Maximum file size for container file operations (Kubernetes quantity format)")
+	cmd.Flags().Bool("enable-cluster-kubeconfig", false, "Enable the cluster_kubeconfig tool, which mints Rancher tokens (disabled by default; requires read-only=false)")
+	cmd.Flags().Duration("kubeconfig-max-ttl", rancher.DefaultKubeconfigMaxTTL, "Maximum token lifetime cluster_kubeconfig may request")
 
 	// Output configuration flags
 	cmd.Flags().String("list-output", "json", "Output format for list operations (json, table, yaml)")
 	cmd.Flags().StringSlice("output-filters", []string{"metadata.managedFields"}, "Fields to filter from output (e.g., metadata.managedFields)")
+	cmd.Flags().String("time-display", "absolute", "How timestamps are displayed: absolute (local time) or relative (age, e.g. 3d4h)")
+	cmd.Flags().Bool("table-auto-width", false, "Size table columns to their widest value instead of fixed widths")
+	cmd.Flags().Int("table-max-column-width", paramutil.DefaultTableMaxColumnWidth, "Maximum column width in characters when table-auto-width is enabled")
+
+	// Audit configuration flags
+	cmd.Flags().String("audit-log", "", "Write an audit record of every tool call to stderr or to the given file (disabled when empty)")
 
 	// Toolset configuration flags
-	cmd.Flags().StringSlice("toolsets", []string{"kubernetes", "rancher"}, "Comma-separated list of toolsets to enable")
+	cmd.Flags().StringSlice("toolsets", []string{"kubernetes", "rancher"}, "Comma-separated list of toolsets to enable (kubernetes, rancher)")
+	cmd.Flags().StringSlice("disabled-toolsets", []string{}, "Comma-separated list of toolsets to disable")
 	cmd.Flags().StringSlice("enabled-tools", []string{}, "Comma-separated list of tools to enable")
 	cmd.Flags().StringSlice("disabled-tools", []string{}, "Comma-separated list of tools to disable")
 
@@ -5,6 +5,9 @@ package norman
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/rancher/norman/clientbase"
 	"github.com/rancher/norman/types"
@@ -17,16 +20,58 @@ import (
 // ErrNotConfigured is returned when the Norman client is not properly configured
 var ErrNotConfigured = fmt.Errorf("norman client not configured")
 
+// DefaultProjectCacheTTL is how long a cluster's project list is reused before
+// the management API is queried again.
+const DefaultProjectCacheTTL = 30 * time.Second
+
 // Type aliases for compatibility with existing code
 type (
 	Cluster = managementClient.Cluster
 	Project = managementClient.Project
 	User    = managementClient.User
+	Node    = managementClient.Node
+	Token   = managementClient.Token
+
+	ResourceQuotaLimit         = managementClient.ResourceQuotaLimit
+	ProjectRoleTemplateBinding = managementClient.ProjectRoleTemplateBinding
 )
 
 // Client wraps the Rancher management client for Norman API operations
 type Client struct {
 	management *managementClient.Client
+	// serverURL and insecure are used to build kubeconfigs for minted tokens
+	serverURL string
+	insecure  bool
+
+	// ProjectCacheTTL is how long ListProjects reuses a cluster's project
+	// list, and how long a namespace's detected project is remembered. Zero
+	// disables both caches.
+	ProjectCacheTTL time.Duration
+	// Project lists per cluster (clusterID -> entry) and the projects
+	// namespaces were detected in (clusterID -> namespace -> entry)
+	projectCacheMu   sync.Mutex
+	projectCache     map[string]projectCacheEntry
+	namespaceProject map[string]map[string]namespaceProjectEntry
+}
+
+// projectCacheEntry is a cached project list of one cluster.
+type projectCacheEntry struct {
+	projects []managementClient.Project
+	expires  time.Time
+}
+
+// NamespaceProject is the project a namespace was detected in.
+type NamespaceProject struct {
+	// ProjectID is the full "<clusterID>:<projectID>" ID
+	ProjectID string
+	// Source describes the namespace metadata the project was detected from
+	Source string
+}
+
+// namespaceProjectEntry is a cached namespace to project detection.
+type namespaceProjectEntry struct {
+	NamespaceProject
+	expires time.Time
 }
 
 // IsUsable returns true when the client has an initialized management backend.
@@ -57,7 +102,10 @@ func NewClient(cfg *config.StaticConfig) (*Client, error) {
 	}
 
 	return &Client{
-		management: management,
+		management:      management,
+		serverURL:       cfg.RancherServerURL,
+		insecure:        cfg.RancherTLSInsecure,
+		ProjectCacheTTL: cfg.RancherProjectCacheTTL,
 	}, nil
 }
 
@@ -81,11 +129,15 @@ func (c *Client) GetCluster(ctx context.Context, clusterID string) (*managementC
 	return c.LookupCluster(ctx, clusterID)
 }
 
-// ListProjects returns all projects for a cluster
+// ListProjects returns all projects for a cluster. Results are reused for
+// ProjectCacheTTL; use InvalidateProjects to drop them earlier.
 func (c *Client) ListProjects(_ context.Context, clusterID string) ([]managementClient.Project, error) {
 	if c.management == nil {
 		return nil, ErrNotConfigured
 	}
+	if projects, ok := c.cachedProjects(clusterID); ok {
+		return projects, nil
+	}
 
 	projectList, err := c.management.Project.List(&types.ListOpts{
 		Filters: map[string]interface{}{
@@ -96,9 +148,132 @@ func (c *Client) ListProjects(_ context.Context, clusterID string) ([]management
 		return nil, fmt.Errorf("failed to list projects for cluster %s: %w", clusterID, err)
 	}
 
+	c.storeProjects(clusterID, projectList.Data)
 	return projectList.Data, nil
 }
 
+// InvalidateProjects drops the cached project list and namespace projects of
+// a cluster, or of every cluster when clusterID is empty.
+func (c *Client) InvalidateProjects(clusterID string) {
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+	if clusterID == "" {
+		c.projectCache = nil
+		c.namespaceProject = nil
+		return
+	}
+	delete(c.projectCache, clusterID)
+	delete(c.namespaceProject, clusterID)
+}
+
+// CachedNamespaceProject returns the project a namespace was detected in, if
+// it was stored less than ProjectCacheTTL ago.
+func (c *Client) CachedNamespaceProject(clusterID, namespace string) (NamespaceProject, bool) {
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+
+	entry, ok := c.namespaceProject[clusterID][namespace]
+	if !ok || time.Now().After(entry.expires) {
+		return NamespaceProject{}, false
+	}
+	return entry.NamespaceProject, true
+}
+
+// StoreNamespaceProject caches the project a namespace was detected in for
+// ProjectCacheTTL.
+func (c *Client) StoreNamespaceProject(clusterID, namespace string, project NamespaceProject) {
+	if c.ProjectCacheTTL <= 0 {
+		return
+	}
+
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+	if c.namespaceProject == nil {
+		c.namespaceProject = make(map[string]map[string]namespaceProjectEntry)
+	}
+	if c.namespaceProject[clusterID] == nil {
+		c.namespaceProject[clusterID] = make(map[string]namespaceProjectEntry)
+	}
+	c.namespaceProject[clusterID][namespace] = namespaceProjectEntry{
+		NamespaceProject: project,
+		expires:          time.Now().Add(c.ProjectCacheTTL),
+	}
+}
+
+// InvalidateNamespaceProject drops the cached project of a namespace, e.g.
+// after the namespace was modified or deleted.
+func (c *Client) InvalidateNamespaceProject(clusterID, namespace string) {
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+	delete(c.namespaceProject[clusterID], namespace)
+}
+
+// cachedProjects returns a copy of the unexpired cached project list of a cluster.
+func (c *Client) cachedProjects(clusterID string) ([]managementClient.Project, bool) {
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+
+	entry, ok := c.projectCache[clusterID]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return slices.Clone(entry.projects), true
+}
+
+// storeProjects caches a copy of the project list of a cluster, so callers may
+// modify the slice they were returned.
+func (c *Client) storeProjects(clusterID string, projects []managementClient.Project) {
+	if c.ProjectCacheTTL <= 0 {
+		return
+	}
+
+	c.projectCacheMu.Lock()
+	defer c.projectCacheMu.Unlock()
+	if c.projectCache == nil {
+		c.projectCache = make(map[string]projectCacheEntry)
+	}
+	c.projectCache[clusterID] = projectCacheEntry{
+		projects: slices.Clone(projects),
+		expires:  time.Now().Add(c.ProjectCacheTTL),
+	}
+}
+
+// ListProjectRoleTemplateBindings returns the role bindings granting access to a project
+func (c *Client) ListProjectRoleTemplateBindings(_ context.Context, projectID string) ([]managementClient.ProjectRoleTemplateBinding, error) {
+	if c.management == nil {
+		return nil, ErrNotConfigured
+	}
+
+	bindingList, err := c.management.ProjectRoleTemplateBinding.List(&types.ListOpts{
+		Filters: map[string]interface{}{
+			"projectId": projectID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings for project %s: %w", projectID, err)
+	}
+
+	return bindingList.Data, nil
+}
+
+// ListNodes returns all Rancher-managed nodes for a cluster
+func (c *Client) ListNodes(_ context.Context, clusterID string) ([]managementClient.Node, error) {
+	if c.management == nil {
+		return nil, ErrNotConfigured
+	}
+
+	nodeList, err := c.management.Node.List(&types.ListOpts{
+		Filters: map[string]interface{}{
+			"clusterId": clusterID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes for cluster %s: %w", clusterID, err)
+	}
+
+	return nodeList.Data, nil
+}
+
 // ListUsers returns all users
 func (c *Client) ListUsers(_ context.Context) ([]managementClient.User, error) {
 	if c.management == nil {
@@ -143,16 +318,20 @@ func (c *Client) LookupCluster(_ context.Context, clusterID string) (*Cluster, e
 }
 
 // LookupProject finds a project by ID within a cluster
-// Returns the project if found, or a helpful error if not found
+// Returns the project if found, or a helpful error if not found or if the
+// project belongs to a different cluster
 func (c *Client) LookupProject(_ context.Context, clusterID, projectID string) (*Project, error) {
 	if c.management == nil {
 		return nil, ErrNotConfigured
 	}
 
 	project, err := c.management.Project.ByID(projectID)
-	if err != nil || project.ClusterID != clusterID {
+	if err != nil {
 		return nil, fmt.Errorf("project not found: '%s' in cluster '%s' (use project_list to get available project IDs)", projectID, clusterID)
 	}
+	if project.ClusterID != clusterID {
+		return nil, fmt.Errorf("project '%s' belongs to cluster '%s', not '%s'", projectID, project.ClusterID, clusterID)
+	}
 	return project, nil
 }
 
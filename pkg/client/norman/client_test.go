@@ -0,0 +1,104 @@
+package norman
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProjectCache(t *testing.T) {
+	c := &Client{ProjectCacheTTL: time.Minute}
+	projects := []Project{{Name: "default"}, {Name: "system"}}
+
+	if _, ok := c.cachedProjects("c-a"); ok {
+		t.Fatal("expected a miss before anything is stored")
+	}
+
+	c.storeProjects("c-a", projects)
+	c.storeProjects("c-b", projects[:1])
+	got, ok := c.cachedProjects("c-a")
+	if !ok || len(got) != 2 {
+		t.Fatalf("cachedProjects(c-a) = %v, %v", got, ok)
+	}
+
+	// Neither the stored nor the returned slice aliases the cache
+	projects[0].Name = "changed"
+	got[1].Name = "changed"
+	if again, _ := c.cachedProjects("c-a"); again[0].Name != "default" || again[1].Name != "system" {
+		t.Errorf("cache was modified through a caller's slice: %v", again)
+	}
+
+	c.InvalidateProjects("c-a")
+	if _, ok := c.cachedProjects("c-a"); ok {
+		t.Error("expected c-a to be invalidated")
+	}
+	if _, ok := c.cachedProjects("c-b"); !ok {
+		t.Error("invalidating c-a must keep c-b")
+	}
+
+	c.InvalidateProjects("")
+	if _, ok := c.cachedProjects("c-b"); ok {
+		t.Error("expected an empty cluster ID to invalidate every cluster")
+	}
+}
+
+func TestProjectCache_Expires(t *testing.T) {
+	c := &Client{ProjectCacheTTL: time.Minute}
+	c.storeProjects("c-a", []Project{{Name: "default"}})
+
+	entry := c.projectCache["c-a"]
+	entry.expires = time.Now().Add(-time.Second)
+	c.projectCache["c-a"] = entry
+
+	if _, ok := c.cachedProjects("c-a"); ok {
+		t.Error("expected an expired entry to miss")
+	}
+}
+
+func TestProjectCache_Disabled(t *testing.T) {
+	c := &Client{}
+	c.storeProjects("c-a", []Project{{Name: "default"}})
+	if _, ok := c.cachedProjects("c-a"); ok {
+		t.Error("expected no caching with a zero ProjectCacheTTL")
+	}
+}
+
+func TestNamespaceProjectCache(t *testing.T) {
+	c := &Client{ProjectCacheTTL: time.Minute}
+	detected := NamespaceProject{ProjectID: "c-a:p-1", Source: "label field.cattle.io/projectId"}
+
+	c.StoreNamespaceProject("c-a", "web", detected)
+	c.StoreNamespaceProject("c-a", "db", detected)
+	c.StoreNamespaceProject("c-b", "web", detected)
+	if got, ok := c.CachedNamespaceProject("c-a", "web"); !ok || got != detected {
+		t.Fatalf("CachedNamespaceProject(c-a, web) = %+v, %v", got, ok)
+	}
+
+	c.InvalidateNamespaceProject("c-a", "web")
+	if _, ok := c.CachedNamespaceProject("c-a", "web"); ok {
+		t.Error("expected c-a/web to be invalidated")
+	}
+	if _, ok := c.CachedNamespaceProject("c-a", "db"); !ok {
+		t.Error("invalidating c-a/web must keep c-a/db")
+	}
+
+	// Invalidating a cluster's projects also forgets its namespaces
+	c.InvalidateProjects("c-a")
+	if _, ok := c.CachedNamespaceProject("c-a", "db"); ok {
+		t.Error("expected c-a/db to be invalidated with the cluster's projects")
+	}
+	if _, ok := c.CachedNamespaceProject("c-b", "web"); !ok {
+		t.Error("invalidating c-a must keep c-b/web")
+	}
+
+	c.namespaceProject["c-b"]["web"] = namespaceProjectEntry{NamespaceProject: detected, expires: time.Now().Add(-time.Second)}
+	if _, ok := c.CachedNamespaceProject("c-b", "web"); ok {
+		t.Error("expected an expired entry to miss")
+	}
+
+	disabled := &Client{}
+	disabled.StoreNamespaceProject("c-a", "web", detected)
+	if _, ok := disabled.CachedNamespaceProject("c-a", "web"); ok {
+		t.Error("expected no caching with a zero ProjectCacheTTL")
+	}
+	disabled.InvalidateNamespaceProject("c-a", "web")
+}
@@ -0,0 +1,133 @@
+package norman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	urlutil "github.com/futuretea/rancher-mcp-server/pkg/util/url"
+)
+
+// ErrProjectScopedTokensUnsupported is returned when a project-scoped token is
+// requested from a Rancher server whose token schema has no project field.
+var ErrProjectScopedTokensUnsupported = errors.New("project-scoped tokens are not supported by this Rancher server")
+
+// tokenFieldProjectID is the token field a Rancher server that supports
+// project-scoped tokens advertises in its schema.
+const tokenFieldProjectID = "projectId"
+
+// KubeconfigOptions controls the token minted for a kubeconfig.
+type KubeconfigOptions struct {
+	// TTL is the token lifetime. Rancher caps it at its auth-token-max-ttl-minutes setting.
+	TTL time.Duration
+	// ClusterScoped limits the token to the cluster instead of every cluster
+	// and the management API the user can reach.
+	ClusterScoped bool
+	// ProjectID ("<clusterID>:<projectID>") limits the token to one project.
+	// It implies ClusterScoped and requires SupportsProjectScopedTokens.
+	ProjectID string
+	// Namespace is the default namespace of the kubeconfig context.
+	Namespace string
+}
+
+// projectToken is a token create request with the project field that the
+// generated Token type does not know about.
+type projectToken struct {
+	Token
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// Kubeconfig is a kubeconfig for one cluster with the token it embeds.
+type Kubeconfig struct {
+	Config    string
+	Token     string
+	TokenName string
+	ExpiresAt string
+}
+
+// GenerateKubeconfigWithToken mints a token with the given TTL and scope and
+// returns a kubeconfig that uses it. Unlike GenerateKubeconfig, whose token
+// lifetime is fixed by Rancher settings, the caller controls the credential.
+func (c *Client) GenerateKubeconfigWithToken(ctx context.Context, clusterID string, opts KubeconfigOptions) (*Kubeconfig, error) {
+	cluster, err := c.LookupCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &Token{
+		Description: fmt.Sprintf("rancher-mcp-server kubeconfig for cluster %s", cluster.ID),
+		TTLMillis:   opts.TTL.Milliseconds(),
+	}
+	if opts.ClusterScoped || opts.ProjectID != "" {
+		request.ClusterID = cluster.ID
+	}
+
+	var token *Token
+	if opts.ProjectID != "" {
+		if err := c.CheckProjectScopedTokens(); err != nil {
+			return nil, err
+		}
+		request.Description = fmt.Sprintf("rancher-mcp-server kubeconfig for project %s", opts.ProjectID)
+		token = &Token{}
+		err = c.management.Ops.DoCreate(managementClient.TokenType, &projectToken{Token: *request, ProjectID: opts.ProjectID}, token)
+	} else {
+		token, err = c.management.Token.Create(request)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token for cluster %s: %w", clusterID, err)
+	}
+
+	config, err := buildKubeconfig(c.serverURL, c.insecure, cluster, opts.Namespace, token.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &Kubeconfig{
+		Config:    config,
+		Token:     token.Token,
+		TokenName: token.Name,
+		ExpiresAt: token.ExpiresAt,
+	}, nil
+}
+
+// CheckProjectScopedTokens returns ErrProjectScopedTokensUnsupported unless the
+// Rancher server's token schema has a project field. Released Rancher versions
+// only scope tokens to a cluster.
+func (c *Client) CheckProjectScopedTokens() error {
+	if c.management == nil {
+		return ErrNotConfigured
+	}
+	if _, ok := c.management.Types[managementClient.TokenType].ResourceFields[tokenFieldProjectID]; !ok {
+		return fmt.Errorf("%w: tokens can only be scoped to a cluster; omit project to get a cluster-scoped token", ErrProjectScopedTokensUnsupported)
+	}
+	return nil
+}
+
+// buildKubeconfig returns a kubeconfig that reaches the cluster through the
+// Rancher proxy with the given bearer token. The context is named after the
+// cluster and defaults to namespace when it is set.
+func buildKubeconfig(serverURL string, insecure bool, cluster *Cluster, namespace, token string) (string, error) {
+	name := cluster.Name
+	if name == "" {
+		name = cluster.ID
+	}
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                urlutil.GetSteveURL(serverURL, cluster.ID),
+		InsecureSkipTLSVerify: insecure,
+	}
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: token}
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name, Namespace: namespace}
+	config.CurrentContext = name
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	return string(data), nil
+}
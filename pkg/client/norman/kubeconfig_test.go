@@ -0,0 +1,81 @@
+package norman
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rancher/norman/clientbase"
+	"github.com/rancher/norman/types"
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestBuildKubeconfig(t *testing.T) {
+	cluster := &Cluster{Name: "prod"}
+	cluster.ID = "c-abc12"
+
+	data, err := buildKubeconfig("https://rancher.example.com/v3", true, cluster, "", "token-xyz:secret")
+	if err != nil {
+		t.Fatalf("buildKubeconfig() error: %v", err)
+	}
+
+	config, err := clientcmd.Load([]byte(data))
+	if err != nil {
+		t.Fatalf("generated kubeconfig does not load: %v\n%s", err, data)
+	}
+	if config.CurrentContext != "prod" {
+		t.Errorf("current context = %q, want prod", config.CurrentContext)
+	}
+	if got := config.Clusters["prod"]; got == nil || got.Server != "https://rancher.example.com/k8s/clusters/c-abc12" || !got.InsecureSkipTLSVerify {
+		t.Errorf("unexpected cluster entry: %+v", got)
+	}
+	if got := config.AuthInfos["prod"]; got == nil || got.Token != "token-xyz:secret" {
+		t.Errorf("unexpected user entry: %+v", got)
+	}
+}
+
+func TestBuildKubeconfigNamespace(t *testing.T) {
+	cluster := &Cluster{}
+	cluster.ID = "c-abc12"
+
+	data, err := buildKubeconfig("https://rancher.example.com", false, cluster, "team-a", "token-xyz:secret")
+	if err != nil {
+		t.Fatalf("buildKubeconfig() error: %v", err)
+	}
+	config, err := clientcmd.Load([]byte(data))
+	if err != nil {
+		t.Fatalf("generated kubeconfig does not load: %v", err)
+	}
+	if config.CurrentContext != "c-abc12" {
+		t.Errorf("current context = %q, want the cluster ID when the name is empty", config.CurrentContext)
+	}
+	if got := config.Contexts["c-abc12"]; got == nil || got.Namespace != "team-a" {
+		t.Errorf("unexpected context entry: %+v", got)
+	}
+}
+
+func TestCheckProjectScopedTokens(t *testing.T) {
+	withTokenFields := func(fields map[string]types.Field) *Client {
+		return &Client{management: &managementClient.Client{APIBaseClient: clientbase.APIBaseClient{
+			Types: map[string]types.Schema{managementClient.TokenType: {ResourceFields: fields}},
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		client  *Client
+		wantErr error
+	}{
+		{name: "not configured", client: &Client{}, wantErr: ErrNotConfigured},
+		{name: "cluster scope only", client: withTokenFields(map[string]types.Field{"clusterId": {}}), wantErr: ErrProjectScopedTokensUnsupported},
+		{name: "project field advertised", client: withTokenFields(map[string]types.Field{"clusterId": {}, "projectId": {}})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.CheckProjectScopedTokens()
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("CheckProjectScopedTokens() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package steve
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessCheck describes an action to check with a SelfSubjectAccessReview.
+type AccessCheck struct {
+	Verb        string
+	Kind        string // resource kind, resolved to a GVR like other lookups
+	Subresource string
+	Namespace   string
+	Name        string
+}
+
+// AccessResult is the outcome of a SelfSubjectAccessReview.
+type AccessResult struct {
+	Allowed         bool   `json:"allowed"`
+	Denied          bool   `json:"denied"`
+	Reason          string `json:"reason,omitempty"`
+	EvaluationError string `json:"evaluationError,omitempty"`
+}
+
+// CheckAccess asks the cluster whether the configured credentials may perform
+// the given action, without attempting it.
+func (c *Client) CheckAccess(ctx context.Context, clusterID string, check AccessCheck) (*AccessResult, error) {
+	gvr, err := c.resolveGVR(clusterID, check.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := c.getClientset(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   check.Namespace,
+				Verb:        check.Verb,
+				Group:       gvr.Group,
+				Version:     gvr.Version,
+				Resource:    gvr.Resource,
+				Subresource: check.Subresource,
+				Name:        check.Name,
+			},
+		},
+	}
+
+	created, err := withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*authorizationv1.SelfSubjectAccessReview, error) {
+		return clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create self subject access review: %w", err)
+	}
+
+	return &AccessResult{
+		Allowed:         created.Status.Allowed,
+		Denied:          created.Status.Denied,
+		Reason:          created.Status.Reason,
+		EvaluationError: created.Status.EvaluationError,
+	}, nil
+}
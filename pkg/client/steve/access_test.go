@@ -0,0 +1,46 @@
+package steve
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCheckAccess(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+
+	var got *authorizationv1.ResourceAttributes
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		got = review.Spec.ResourceAttributes
+		review.Status = authorizationv1.SubjectAccessReviewStatus{
+			Allowed: false,
+			Denied:  true,
+			Reason:  "no RBAC policy matched",
+		}
+		return true, review, nil
+	})
+	client.clientsets["cluster"] = clientset
+
+	result, err := client.CheckAccess(context.Background(), "cluster", AccessCheck{
+		Verb:      "delete",
+		Kind:      "deployment",
+		Namespace: "default",
+		Name:      "web",
+	})
+	if err != nil {
+		t.Fatalf("CheckAccess() unexpected error: %v", err)
+	}
+
+	if got == nil || got.Verb != "delete" || got.Group != "apps" || got.Resource != "deployments" || got.Namespace != "default" || got.Name != "web" {
+		t.Errorf("resource attributes = %+v, want delete apps/deployments default/web", got)
+	}
+	if result.Allowed || !result.Denied || result.Reason != "no RBAC policy matched" {
+		t.Errorf("result = %+v, want denied with reason", result)
+	}
+}
@@ -4,8 +4,10 @@ package steve
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/util/url"
 
@@ -26,7 +28,7 @@ import (
 type ResourceReader interface {
 	GetResource(ctx context.Context, clusterID, kind, namespace, name string) (*unstructured.Unstructured, error)
 	ListResources(ctx context.Context, clusterID, kind, namespace string, opts *ListOptions) (*unstructured.UnstructuredList, error)
-	GetEvents(ctx context.Context, clusterID, namespace, name, kind string) ([]corev1.Event, error)
+	GetEvents(ctx context.Context, clusterID, namespace, name, kind string, opts *ListOptions) ([]corev1.Event, error)
 }
 
 // Client provides methods for interacting with Kubernetes clusters via Rancher's Steve API.
@@ -37,21 +39,81 @@ type Client struct {
 	secretKey string
 	insecure  bool
 
+	// RequestTimeout caps each API request made to a cluster, on top of any
+	// deadline of the caller's context. Zero disables the cap. Watches and
+	// exec streams are long-lived by design and are not capped.
+	RequestTimeout time.Duration
+
+	// RateLimitQPS and RateLimitBurst configure a token bucket shared by all
+	// requests to a cluster, so one session cannot overwhelm Rancher. Requests
+	// over the limit wait for a token until their context deadline. A zero
+	// RateLimitQPS keeps client-go's default limit of 5 requests/s per client.
+	RateLimitQPS   float32
+	RateLimitBurst int
+
+	// Per-cluster caches, dropped together when the cluster's entry is older
+	// than cacheTTL (a zero cacheTTL keeps entries until InvalidateCluster).
 	cacheMu        sync.Mutex
+	cacheTTL       time.Duration
+	cachedAt       map[string]time.Time
+	restConfigs    map[string]*rest.Config
 	dynamicClients map[string]dynamic.Interface
 	clientsets     map[string]kubernetes.Interface
+	// OpenAPI v3 components.schemas per cluster and group version path.
+	openAPISchemaCache map[string]map[string]map[string]interface{}
+
+	// Resolved resource kinds per cluster (clusterID -> kind -> entry).
+	// A zero discoveryTTL disables the cache.
+	discoveryMu  sync.RWMutex
+	discoveryTTL time.Duration
+	discovery    map[string]map[string]discoveryEntry
+}
+
+const (
+	// DefaultClientCacheTTL is how long per-cluster REST configs and clients
+	// are reused before being rebuilt.
+	DefaultClientCacheTTL = 30 * time.Minute
+	// DefaultDiscoveryCacheTTL is how long a resolved resource kind is reused
+	// before API discovery runs again.
+	DefaultDiscoveryCacheTTL = 10 * time.Minute
+	// DefaultRequestTimeout is the default per-request cap on cluster API calls.
+	DefaultRequestTimeout = 30 * time.Second
+	// DefaultRateLimitQPS and DefaultRateLimitBurst are the default per-cluster
+	// request rate limit.
+	DefaultRateLimitQPS   = 10
+	DefaultRateLimitBurst = 20
+	// NegativeDiscoveryCacheTTL caps how long an unknown kind is remembered, so a
+	// typo does not repeatedly hit discovery but a newly installed CRD shows up soon.
+	NegativeDiscoveryCacheTTL = 30 * time.Second
+)
+
+// errKindNotDiscovered reports that API discovery found no resource for a kind.
+var errKindNotDiscovered = errors.New("resource kind not found")
+
+// discoveryEntry is a cached kind resolution; err is set for negative entries.
+type discoveryEntry struct {
+	gvr     schema.GroupVersionResource
+	err     error
+	expires time.Time
 }
 
-// NewClient creates a new Steve API client.
-func NewClient(serverURL, token, accessKey, secretKey string, insecure bool) *Client {
+// NewClient creates a new Steve API client. discoveryCacheTTL controls how long
+// resolved resource kinds are cached; zero disables the discovery cache.
+func NewClient(serverURL, token, accessKey, secretKey string, insecure bool, discoveryCacheTTL time.Duration) *Client {
 	return &Client{
-		serverURL:      serverURL,
-		token:          token,
-		accessKey:      accessKey,
-		secretKey:      secretKey,
-		insecure:       insecure,
-		dynamicClients: make(map[string]dynamic.Interface),
-		clientsets:     make(map[string]kubernetes.Interface),
+		serverURL:          serverURL,
+		token:              token,
+		accessKey:          accessKey,
+		secretKey:          secretKey,
+		insecure:           insecure,
+		cacheTTL:           DefaultClientCacheTTL,
+		cachedAt:           make(map[string]time.Time),
+		restConfigs:        make(map[string]*rest.Config),
+		dynamicClients:     make(map[string]dynamic.Interface),
+		clientsets:         make(map[string]kubernetes.Interface),
+		openAPISchemaCache: make(map[string]map[string]map[string]interface{}),
+		discoveryTTL:       discoveryCacheTTL,
+		discovery:          make(map[string]map[string]discoveryEntry),
 	}
 }
 
@@ -60,6 +122,19 @@ type ListOptions struct {
 	LabelSelector string
 	FieldSelector string
 	Limit         int64
+	// Continue resumes a previous limited list; the token for the next page is
+	// returned in the list's metadata.continue.
+	Continue string
+}
+
+// ApplyOptions contains options for server-side apply.
+type ApplyOptions struct {
+	// FieldManager is recorded as the owner of the applied fields.
+	FieldManager string
+	// Force takes over fields that conflict with other field managers.
+	Force bool
+	// DryRun validates the request on the server without persisting it.
+	DryRun bool
 }
 
 // WatchOptions contains options for watching resources.
@@ -68,6 +143,26 @@ type WatchOptions struct {
 	FieldSelector string
 }
 
+// withRequestTimeout runs call with ctx bounded by c.RequestTimeout. When the
+// cap (rather than the caller's own deadline or cancellation) ends the request,
+// the error names the cluster and the timeout. Other errors are classified as
+// not found, forbidden, unauthorized or timed out where possible.
+func withRequestTimeout[T any](c *Client, ctx context.Context, clusterID string, call func(context.Context) (T, error)) (T, error) {
+	if c.RequestTimeout <= 0 {
+		result, err := call(ctx)
+		return result, classifyError(err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, c.RequestTimeout)
+	defer cancel()
+
+	result, err := call(reqCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(reqCtx.Err(), context.DeadlineExceeded) {
+		return result, fmt.Errorf("request to cluster %s %w after %s: %w", clusterID, ErrTimeout, c.RequestTimeout, context.DeadlineExceeded)
+	}
+	return result, classifyError(err)
+}
+
 // createRestConfig creates a Kubernetes REST config for the given cluster.
 func (c *Client) createRestConfig(clusterID string) (*rest.Config, error) {
 	clusterURL := url.GetSteveURL(c.serverURL, clusterID)
@@ -93,27 +188,48 @@ func (c *Client) createRestConfig(clusterID string) (*rest.Config, error) {
 	}
 	kubeconfig.CurrentContext = "context"
 
-	return clientcmd.NewNonInteractiveClientConfig(
+	restConfig, err := clientcmd.NewNonInteractiveClientConfig(
 		*kubeconfig,
 		kubeconfig.CurrentContext,
 		&clientcmd.ConfigOverrides{},
 		nil,
 	).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	// The dynamic client, the clientset and copies of this config share the limiter
+	if c.RateLimitQPS > 0 {
+		restConfig.RateLimiter = newClusterRateLimiter(clusterID, c.RateLimitQPS, c.RateLimitBurst)
+	}
+	return restConfig, nil
+}
+
+// getRestConfig returns a copy of the cached REST config for the given cluster.
+func (c *Client) getRestConfig(clusterID string) (*rest.Config, error) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	c.prepareCacheLocked(clusterID)
+
+	restConfig, err := c.restConfigLocked(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return rest.CopyConfig(restConfig), nil
 }
 
 // getDynamicClient creates a dynamic Kubernetes client for the given cluster.
 func (c *Client) getDynamicClient(clusterID string) (dynamic.Interface, error) {
 	c.cacheMu.Lock()
 	defer c.cacheMu.Unlock()
-	c.ensureCachesLocked()
+	c.prepareCacheLocked(clusterID)
 
 	if client, ok := c.dynamicClients[clusterID]; ok {
 		return client, nil
 	}
 
-	restConfig, err := c.createRestConfig(clusterID)
+	restConfig, err := c.restConfigLocked(clusterID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create REST config: %w", err)
+		return nil, err
 	}
 
 	client, err := dynamic.NewForConfig(restConfig)
@@ -121,6 +237,7 @@ func (c *Client) getDynamicClient(clusterID string) (dynamic.Interface, error) {
 		return nil, err
 	}
 	c.dynamicClients[clusterID] = client
+	c.touchClusterLocked(clusterID)
 	return client, nil
 }
 
@@ -128,15 +245,15 @@ func (c *Client) getDynamicClient(clusterID string) (dynamic.Interface, error) {
 func (c *Client) getClientset(clusterID string) (kubernetes.Interface, error) {
 	c.cacheMu.Lock()
 	defer c.cacheMu.Unlock()
-	c.ensureCachesLocked()
+	c.prepareCacheLocked(clusterID)
 
 	if clientset, ok := c.clientsets[clusterID]; ok {
 		return clientset, nil
 	}
 
-	restConfig, err := c.createRestConfig(clusterID)
+	restConfig, err := c.restConfigLocked(clusterID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create REST config: %w", err)
+		return nil, err
 	}
 
 	clientset, err := kubernetes.NewForConfig(restConfig)
@@ -144,16 +261,111 @@ func (c *Client) getClientset(clusterID string) (kubernetes.Interface, error) {
 		return nil, err
 	}
 	c.clientsets[clusterID] = clientset
+	c.touchClusterLocked(clusterID)
 	return clientset, nil
 }
 
+// InvalidateCluster drops the cached REST config, clients, and resolved
+// resource kinds for a cluster so the next call rebuilds them.
+func (c *Client) InvalidateCluster(clusterID string) {
+	c.cacheMu.Lock()
+	c.ensureCachesLocked()
+	c.dropClusterLocked(clusterID)
+	c.cacheMu.Unlock()
+
+	c.discoveryMu.Lock()
+	delete(c.discovery, clusterID)
+	c.discoveryMu.Unlock()
+}
+
+func (c *Client) restConfigLocked(clusterID string) (*rest.Config, error) {
+	if restConfig, ok := c.restConfigs[clusterID]; ok {
+		return restConfig, nil
+	}
+
+	restConfig, err := c.createRestConfig(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create REST config: %w", err)
+	}
+	c.restConfigs[clusterID] = restConfig
+	c.touchClusterLocked(clusterID)
+	return restConfig, nil
+}
+
+// lookupDiscovery returns an unexpired cached resolution of kind in the given cluster.
+func (c *Client) lookupDiscovery(clusterID, kind string) (discoveryEntry, bool) {
+	c.discoveryMu.RLock()
+	defer c.discoveryMu.RUnlock()
+
+	entry, ok := c.discovery[clusterID][kind]
+	if !ok || time.Now().After(entry.expires) {
+		return discoveryEntry{}, false
+	}
+	return entry, true
+}
+
+// storeDiscovery caches the resolution of kind in the given cluster. Failed
+// resolutions are kept for at most NegativeDiscoveryCacheTTL.
+func (c *Client) storeDiscovery(clusterID, kind string, gvr schema.GroupVersionResource, err error) {
+	ttl := c.discoveryTTL
+	if ttl <= 0 {
+		return
+	}
+	if err != nil {
+		ttl = min(ttl, NegativeDiscoveryCacheTTL)
+	}
+
+	c.discoveryMu.Lock()
+	defer c.discoveryMu.Unlock()
+	if c.discovery == nil {
+		c.discovery = make(map[string]map[string]discoveryEntry)
+	}
+	if c.discovery[clusterID] == nil {
+		c.discovery[clusterID] = make(map[string]discoveryEntry)
+	}
+	c.discovery[clusterID][kind] = discoveryEntry{gvr: gvr, err: err, expires: time.Now().Add(ttl)}
+}
+
+// prepareCacheLocked initializes the caches and drops the cluster's entries
+// once they are older than cacheTTL.
+func (c *Client) prepareCacheLocked(clusterID string) {
+	c.ensureCachesLocked()
+	cachedAt, ok := c.cachedAt[clusterID]
+	if ok && c.cacheTTL > 0 && time.Since(cachedAt) >= c.cacheTTL {
+		c.dropClusterLocked(clusterID)
+	}
+}
+
+func (c *Client) touchClusterLocked(clusterID string) {
+	if _, ok := c.cachedAt[clusterID]; !ok {
+		c.cachedAt[clusterID] = time.Now()
+	}
+}
+
+func (c *Client) dropClusterLocked(clusterID string) {
+	delete(c.cachedAt, clusterID)
+	delete(c.restConfigs, clusterID)
+	delete(c.dynamicClients, clusterID)
+	delete(c.clientsets, clusterID)
+	delete(c.openAPISchemaCache, clusterID)
+}
+
 func (c *Client) ensureCachesLocked() {
+	if c.cachedAt == nil {
+		c.cachedAt = make(map[string]time.Time)
+	}
+	if c.restConfigs == nil {
+		c.restConfigs = make(map[string]*rest.Config)
+	}
 	if c.dynamicClients == nil {
 		c.dynamicClients = make(map[string]dynamic.Interface)
 	}
 	if c.clientsets == nil {
 		c.clientsets = make(map[string]kubernetes.Interface)
 	}
+	if c.openAPISchemaCache == nil {
+		c.openAPISchemaCache = make(map[string]map[string]map[string]interface{})
+	}
 }
 
 // getResourceInterface returns a dynamic resource interface for the given parameters.
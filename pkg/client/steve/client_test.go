@@ -1,13 +1,24 @@
 package steve
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
 )
 
 func TestGetDynamicClient_ReusesClientPerCluster(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	first, err := client.getDynamicClient("cluster-a")
 	if err != nil {
@@ -24,7 +35,7 @@ func TestGetDynamicClient_ReusesClientPerCluster(t *testing.T) {
 }
 
 func TestGetDynamicClient_SeparatesClusters(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	first, err := client.getDynamicClient("cluster-a")
 	if err != nil {
@@ -61,7 +72,7 @@ func TestGetDynamicClient_InitializesZeroValueCaches(t *testing.T) {
 }
 
 func TestGetClientset_ReusesClientsetPerCluster(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	first, err := client.getClientset("cluster-a")
 	if err != nil {
@@ -78,7 +89,7 @@ func TestGetClientset_ReusesClientsetPerCluster(t *testing.T) {
 }
 
 func TestGetClientset_ReusesClientsetAcrossConcurrentCalls(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	const workers = 8
 	results := make([]uintptr, workers)
@@ -111,6 +122,254 @@ func TestGetClientset_ReusesClientsetAcrossConcurrentCalls(t *testing.T) {
 	}
 }
 
+func TestGetRestConfig_SharedByClients(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+
+	if _, err := client.getDynamicClient("cluster-a"); err != nil {
+		t.Fatalf("getDynamicClient() returned unexpected error: %v", err)
+	}
+	cached := client.restConfigs["cluster-a"]
+	if cached == nil {
+		t.Fatal("expected REST config to be cached after building a dynamic client")
+	}
+	if _, err := client.getClientset("cluster-a"); err != nil {
+		t.Fatalf("getClientset() returned unexpected error: %v", err)
+	}
+	if client.restConfigs["cluster-a"] != cached {
+		t.Fatal("expected clientset to reuse the cached REST config")
+	}
+
+	restConfig, err := client.getRestConfig("cluster-a")
+	if err != nil {
+		t.Fatalf("getRestConfig() returned unexpected error: %v", err)
+	}
+	if restConfig == cached {
+		t.Fatal("expected getRestConfig() to return a copy of the cached config")
+	}
+	if restConfig.Host != cached.Host {
+		t.Fatalf("getRestConfig() host = %q, want %q", restConfig.Host, cached.Host)
+	}
+}
+
+func TestClientCache_ExpiresAfterTTL(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	client.cacheTTL = time.Minute
+
+	first, err := client.getDynamicClient("cluster-a")
+	if err != nil {
+		t.Fatalf("getDynamicClient() returned unexpected error: %v", err)
+	}
+
+	client.cachedAt["cluster-a"] = time.Now().Add(-2 * time.Minute)
+
+	second, err := client.getDynamicClient("cluster-a")
+	if err != nil {
+		t.Fatalf("getDynamicClient() after expiry returned unexpected error: %v", err)
+	}
+	if interfacePointer(first) == interfacePointer(second) {
+		t.Fatal("expected expired dynamic client to be rebuilt")
+	}
+}
+
+func TestInvalidateCluster(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, time.Minute)
+	injected := fake.NewSimpleDynamicClient(scheme.Scheme)
+	client.dynamicClients["cluster-a"] = injected
+	client.dynamicClients["cluster-b"] = injected
+	client.storeDiscovery("cluster-a", "widgets", schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, nil)
+
+	client.InvalidateCluster("cluster-a")
+
+	if _, ok := client.dynamicClients["cluster-a"]; ok {
+		t.Fatal("expected dynamic client to be dropped for the invalidated cluster")
+	}
+	if _, ok := client.lookupDiscovery("cluster-a", "widgets"); ok {
+		t.Fatal("expected discovery cache to be dropped for the invalidated cluster")
+	}
+	if _, ok := client.dynamicClients["cluster-b"]; !ok {
+		t.Fatal("expected other clusters to keep their cached clients")
+	}
+}
+
+func TestResolveGVR_UsesDiscoveryCache(t *testing.T) {
+	client := NewClient("https://example.invalid", "token", "", "", false, time.Minute)
+	want := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	client.storeDiscovery("cluster-a", "Widget", want, nil)
+
+	// A cache miss would need API discovery against an unreachable server
+	got, err := client.resolveGVR("cluster-a", " Widget ")
+	if err != nil {
+		t.Fatalf("resolveGVR() returned unexpected error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("resolveGVR() = %v, want %v", got, want)
+	}
+}
+
+func TestResolveGVR_CachesUnknownKinds(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, time.Minute)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true}},
+	}}
+	client.clientsets["cluster-a"] = clientset
+
+	_, err := client.resolveGVR("cluster-a", "widgit")
+	if !isKindNotDiscovered(err) {
+		t.Fatalf("resolveGVR() error = %v, want kind not found", err)
+	}
+	if !errors.Is(err, ErrUnsupportedKind) {
+		t.Errorf("resolveGVR() error = %v, want ErrUnsupportedKind", err)
+	}
+	discoveryCalls := len(clientset.Actions())
+	if discoveryCalls == 0 {
+		t.Fatal("expected the first lookup to run API discovery")
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.resolveGVR("cluster-a", "widgit"); !isKindNotDiscovered(err) {
+			t.Fatalf("cached resolveGVR() error = %v, want kind not found", err)
+		}
+	}
+	if got := len(clientset.Actions()); got != discoveryCalls {
+		t.Fatalf("discovery actions = %d after cached lookups, want %d", got, discoveryCalls)
+	}
+
+	entry, ok := client.lookupDiscovery("cluster-a", "widgit")
+	if !ok {
+		t.Fatal("expected unknown kind to be cached")
+	}
+	if ttl := time.Until(entry.expires); ttl > NegativeDiscoveryCacheTTL {
+		t.Fatalf("negative entry TTL = %v, want at most %v", ttl, NegativeDiscoveryCacheTTL)
+	}
+}
+
+func TestResolveGVR_DiscoveryCacheDisabled(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	client.storeDiscovery("cluster-a", "widgets", schema.GroupVersionResource{Resource: "widgets"}, nil)
+
+	if _, ok := client.lookupDiscovery("cluster-a", "widgets"); ok {
+		t.Fatal("expected zero TTL to disable the discovery cache")
+	}
+}
+
+func TestResolveGVR_KindForms(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}}},
+		},
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "proxies", SingularName: "proxy", Kind: "Proxy", Namespaced: true},
+				{Name: "gateways", Kind: "Gateway", Namespaced: true},
+			},
+		},
+	}
+	client.clientsets["cluster-a"] = clientset
+
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	proxies := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "proxies"}
+	gateways := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gateways"}
+	tests := []struct {
+		kind string
+		want schema.GroupVersionResource
+	}{
+		{kind: "deployment", want: deployments},
+		{kind: "Deployment", want: deployments},
+		{kind: "deployments", want: deployments},
+		{kind: "Deployments", want: deployments},
+		{kind: "proxy", want: proxies},
+		{kind: "Proxy", want: proxies},
+		{kind: "proxies", want: proxies},
+		{kind: "Proxies", want: proxies},
+		{kind: "Proxys", want: proxies},
+		{kind: "example.com/v1/Proxys", want: proxies},
+		{kind: "Gateways", want: gateways},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			got, err := client.resolveGVR("cluster-a", tt.kind)
+			if err != nil || got != tt.want {
+				t.Errorf("resolveGVR(%q) = %v, %v; want %v", tt.kind, got, err, tt.want)
+			}
+		})
+	}
+
+	// A trailing "s" is only dropped when the rest is a singular name or kind,
+	// not another plural.
+	for _, kind := range []string{"gatewayss", "podss", "proxie"} {
+		if gvr, err := client.resolveGVR("cluster-a", kind); !isKindNotDiscovered(err) {
+			t.Errorf("resolveGVR(%q) = %v, %v; want kind not found", kind, gvr, err)
+		}
+	}
+}
+
 func interfacePointer(value interface{}) uintptr {
 	return reflect.ValueOf(value).Pointer()
 }
+
+func TestWithRequestTimeout(t *testing.T) {
+	blockUntilDone := func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+
+	t.Run("timeout names cluster and duration", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+		client.RequestTimeout = 10 * time.Millisecond
+
+		_, err := withRequestTimeout(client, context.Background(), "c-abc", blockUntilDone)
+		if err == nil {
+			t.Fatal("expected timeout error")
+		}
+		if !strings.Contains(err.Error(), "request to cluster c-abc timed out after 10ms") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) || !errors.Is(err, ErrTimeout) {
+			t.Errorf("expected error to wrap context.DeadlineExceeded and ErrTimeout, got %v", err)
+		}
+	})
+
+	t.Run("API errors are classified", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+		client.RequestTimeout = time.Minute
+
+		_, err := withRequestTimeout(client, context.Background(), "c-abc", func(context.Context) (string, error) {
+			return "", apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "db", errors.New("denied"))
+		})
+		if !errors.Is(err, ErrForbidden) || !apierrors.IsForbidden(err) {
+			t.Errorf("expected a classified forbidden error, got %v", err)
+		}
+	})
+
+	t.Run("caller cancellation is passed through", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+		client.RequestTimeout = time.Minute
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := withRequestTimeout(client, ctx, "c-abc", blockUntilDone)
+		if !errors.Is(err, context.Canceled) || strings.Contains(err.Error(), "timed out") {
+			t.Errorf("expected plain cancellation error, got %v", err)
+		}
+	})
+
+	t.Run("zero timeout leaves context unbounded", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+
+		_, err := withRequestTimeout(client, context.Background(), "c-abc", func(ctx context.Context) (string, error) {
+			if _, ok := ctx.Deadline(); ok {
+				t.Error("expected no deadline when RequestTimeout is zero")
+			}
+			return "ok", nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
@@ -27,19 +27,38 @@ func buildEventFieldSelector(name, namespace, kind string) string {
 	return strings.Join(selectors, ",")
 }
 
+// joinFieldSelectors combines non-empty field selector expressions with commas.
+func joinFieldSelectors(selectors ...string) string {
+	var parts []string
+	for _, s := range selectors {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
 // GetEvents retrieves Kubernetes events related to a specific resource.
 // Filters by involvedObject fields: name, namespace, and optionally kind.
-func (c *Client) GetEvents(ctx context.Context, clusterID, namespace, name, kind string) ([]corev1.Event, error) {
+// Label and field selectors from opts are applied server-side in addition
+// to the involvedObject filters; opts may be nil.
+func (c *Client) GetEvents(ctx context.Context, clusterID, namespace, name, kind string, opts *ListOptions) ([]corev1.Event, error) {
 	clientset, err := c.getClientset(clusterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
 	}
 
-	listOpts := metav1.ListOptions{
-		FieldSelector: buildEventFieldSelector(name, namespace, kind),
+	fieldSelector := buildEventFieldSelector(name, namespace, kind)
+	listOpts := metav1.ListOptions{}
+	if opts != nil {
+		listOpts.LabelSelector = opts.LabelSelector
+		fieldSelector = joinFieldSelectors(fieldSelector, opts.FieldSelector)
 	}
+	listOpts.FieldSelector = fieldSelector
 
-	eventList, err := clientset.CoreV1().Events(namespace).List(ctx, listOpts)
+	eventList, err := withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*corev1.EventList, error) {
+		return clientset.CoreV1().Events(namespace).List(ctx, listOpts)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
@@ -72,7 +91,7 @@ func (c *Client) DescribeResource(ctx context.Context, clusterID, kind, namespac
 	result := &DescribeResult{Resource: resource}
 
 	// Use the resource's actual Kind (proper casing) for event field selector
-	if events, err := c.GetEvents(ctx, clusterID, namespace, name, resource.GetKind()); err == nil {
+	if events, err := c.GetEvents(ctx, clusterID, namespace, name, resource.GetKind(), nil); err == nil {
 		result.Events = events
 	}
 
@@ -0,0 +1,20 @@
+package steve
+
+import "testing"
+
+func TestJoinFieldSelectors(t *testing.T) {
+	got := joinFieldSelectors("involvedObject.kind=Pod", "reason=FailedScheduling")
+	if got != "involvedObject.kind=Pod,reason=FailedScheduling" {
+		t.Errorf("unexpected selector: %s", got)
+	}
+
+	got = joinFieldSelectors("", "reason=BackOff")
+	if got != "reason=BackOff" {
+		t.Errorf("expected user selector only, got %s", got)
+	}
+
+	got = joinFieldSelectors("", "")
+	if got != "" {
+		t.Errorf("expected empty selector, got %s", got)
+	}
+}
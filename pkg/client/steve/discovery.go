@@ -3,8 +3,12 @@ package steve
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/core/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -20,6 +24,7 @@ type APIResourceInfo struct {
 	Group        string
 	Version      string
 	Verbs        []string
+	ShortNames   []string
 }
 
 // GVR returns the GroupVersionResource for this API resource.
@@ -62,15 +67,7 @@ func (c *Client) ListAPIResources(_ context.Context, clusterID string) ([]APIRes
 			if strings.Contains(r.Name, "/") {
 				continue
 			}
-			allResources = append(allResources, APIResourceInfo{
-				Name:         r.Name,
-				SingularName: r.SingularName,
-				Namespaced:   r.Namespaced,
-				Kind:         r.Kind,
-				Group:        gv.Group,
-				Version:      gv.Version,
-				Verbs:        r.Verbs,
-			})
+			allResources = append(allResources, newAPIResourceInfo(gv, r))
 		}
 	}
 
@@ -88,25 +85,40 @@ func (c *Client) listCoreAPIResources(clientset kubernetes.Interface) ([]APIReso
 		if strings.Contains(r.Name, "/") {
 			continue
 		}
-		allResources = append(allResources, APIResourceInfo{
-			Name:         r.Name,
-			SingularName: r.SingularName,
-			Namespaced:   r.Namespaced,
-			Kind:         r.Kind,
-			Group:        "",
-			Version:      "v1",
-			Verbs:        r.Verbs,
-		})
+		allResources = append(allResources, newAPIResourceInfo(schema.GroupVersion{Version: "v1"}, r))
 	}
 	return allResources, nil
 }
 
+func newAPIResourceInfo(gv schema.GroupVersion, r metav1.APIResource) APIResourceInfo {
+	return APIResourceInfo{
+		Name:         r.Name,
+		SingularName: r.SingularName,
+		Namespaced:   r.Namespaced,
+		Kind:         r.Kind,
+		Group:        gv.Group,
+		Version:      gv.Version,
+		Verbs:        r.Verbs,
+		ShortNames:   r.ShortNames,
+	}
+}
+
+// DefaultGetAllConcurrency is the number of resource types listed in parallel
+// by GetAllResources when GetAllOptions.MaxConcurrency is unset.
+const DefaultGetAllConcurrency = 10
+
 // GetAllOptions contains options for GetAllResources.
 type GetAllOptions struct {
 	Namespace     string
 	ExcludeEvents bool
 	Scope         string // "namespaced", "cluster", or "" (all)
 	Limit         int64
+	// CustomResourcesOnly restricts enumeration to resources outside the
+	// built-in Kubernetes API groups, i.e. those typically served by CRDs.
+	CustomResourcesOnly bool
+	// MaxConcurrency caps parallel list calls across resource types.
+	// Zero or negative uses DefaultGetAllConcurrency.
+	MaxConcurrency int
 }
 
 // AllResourceItem represents a single resource found by GetAllResources.
@@ -121,6 +133,9 @@ type AllResourceItem struct {
 // AllResourcesResult contains all resources retrieved by GetAllResources.
 type AllResourcesResult struct {
 	Items []AllResourceItem
+	// PermissionDenied lists the resource types (as resource.group/version)
+	// that were skipped because listing them was forbidden or unauthorized.
+	PermissionDenied []string
 }
 
 // GetAllResources retrieves all resources across all (or specified) resource types.
@@ -135,27 +150,100 @@ func (c *Client) GetAllResources(ctx context.Context, clusterID string, opts *Ge
 		return nil, err
 	}
 
-	result := &AllResourcesResult{
-		Items: make([]AllResourceItem, 0),
-	}
-
+	var toFetch []APIResourceInfo
 	for _, ar := range apiResources {
-		if !c.shouldFetchResource(ar, opts) {
-			continue
+		if c.shouldFetchResource(ar, opts) {
+			toFetch = append(toFetch, ar)
 		}
+	}
 
-		namespace := resolveResourceNamespace(ar, opts.Namespace)
-		items, err := c.listResourcesForType(ctx, clusterID, ar, namespace, opts.Limit)
-		if err != nil {
-			// Skip resources that cannot be listed.
-			continue
+	concurrency := DefaultGetAllConcurrency
+	if opts.MaxConcurrency > 0 {
+		concurrency = opts.MaxConcurrency
+	}
+
+	// Each worker writes to its own slot; the combined list is sorted afterwards.
+	perType := make([][]AllResourceItem, len(toFetch))
+	denied := make([]bool, len(toFetch))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, ar := range toFetch {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			// Stop dispatching, but let running workers finish writing their slots
+			wg.Wait()
+			return nil, ctx.Err()
 		}
+
+		wg.Add(1)
+		go func(i int, ar APIResourceInfo) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			namespace := resolveResourceNamespace(ar, opts.Namespace)
+			items, err := c.listResourcesForType(ctx, clusterID, ar, namespace, opts.Limit)
+			if err != nil {
+				// Skip resources that cannot be listed; permission failures are
+				// reported so callers can tell them apart from empty types.
+				if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+					logging.Debug("Skipping %s in cluster %s: %v", ar.GVR().String(), clusterID, err)
+					denied[i] = true
+				}
+				return
+			}
+			perType[i] = items
+		}(i, ar)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &AllResourcesResult{
+		Items: make([]AllResourceItem, 0),
+	}
+	for i, items := range perType {
 		result.Items = append(result.Items, items...)
+		if denied[i] {
+			result.PermissionDenied = append(result.PermissionDenied, gvrName(toFetch[i]))
+		}
 	}
+	sortAllResourceItems(result.Items)
+	sort.Strings(result.PermissionDenied)
 
 	return result, nil
 }
 
+// gvrName formats a resource type as resource.group/version, or resource/v1
+// for the core group.
+func gvrName(ar APIResourceInfo) string {
+	if ar.Group == "" {
+		return ar.Name + "/" + ar.Version
+	}
+	return ar.Name + "." + ar.Group + "/" + ar.Version
+}
+
+// sortAllResourceItems orders items by API version, kind, namespace and name so
+// the output does not depend on which list call finished first.
+func sortAllResourceItems(items []AllResourceItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.APIVersion != b.APIVersion {
+			return a.APIVersion < b.APIVersion
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
 func (c *Client) shouldFetchResource(ar APIResourceInfo, opts *GetAllOptions) bool {
 	if !hasVerb(ar.Verbs, "list") {
 		return false
@@ -163,9 +251,29 @@ func (c *Client) shouldFetchResource(ar APIResourceInfo, opts *GetAllOptions) bo
 	if opts.ExcludeEvents && ar.Name == "events" {
 		return false
 	}
+	if opts.CustomResourcesOnly && isBuiltinGroup(ar.Group) {
+		return false
+	}
 	return matchesScope(ar.Namespaced, opts.Scope)
 }
 
+// builtinGroups lists the built-in API groups that do not use the k8s.io suffix.
+var builtinGroups = map[string]bool{
+	"":            true,
+	"apps":        true,
+	"autoscaling": true,
+	"batch":       true,
+	"extensions":  true,
+	"policy":      true,
+}
+
+// isBuiltinGroup reports whether an API group is served by Kubernetes itself:
+// the core group, the legacy unsuffixed groups, and the k8s.io groups.
+// SIG-sponsored CRD groups use x-k8s.io and are not considered built-in.
+func isBuiltinGroup(group string) bool {
+	return builtinGroups[group] || group == "k8s.io" || strings.HasSuffix(group, ".k8s.io")
+}
+
 func matchesScope(isNamespaced bool, scope string) bool {
 	switch scope {
 	case "namespaced":
@@ -191,7 +299,9 @@ func (c *Client) listResourcesForType(ctx context.Context, clusterID string, ar
 		return nil, err
 	}
 
-	list, err := ri.List(ctx, metav1.ListOptions{Limit: limit})
+	list, err := withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.UnstructuredList, error) {
+		return ri.List(ctx, metav1.ListOptions{Limit: limit})
+	})
 	if err != nil {
 		return nil, err
 	}
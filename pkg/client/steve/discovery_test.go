@@ -7,12 +7,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
 func TestListResourcesForType_PointersAreDistinct(t *testing.T) {
 	ctx := context.Background()
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	cm1 := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{Name: "cm1", Namespace: "default"},
@@ -55,3 +56,92 @@ func TestListResourcesForType_PointersAreDistinct(t *testing.T) {
 		t.Fatal("Resource pointers should point to distinct objects")
 	}
 }
+
+func TestShouldFetchResource_CustomResourcesOnly(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	opts := &GetAllOptions{CustomResourcesOnly: true}
+	list := []string{"list"}
+
+	tests := []struct {
+		group string
+		want  bool
+	}{
+		{group: "", want: false},
+		{group: "apps", want: false},
+		{group: "batch", want: false},
+		{group: "rbac.authorization.k8s.io", want: false},
+		{group: "apiextensions.k8s.io", want: false},
+		{group: "cluster.x-k8s.io", want: true},
+		{group: "management.cattle.io", want: true},
+		{group: "cert-manager.io", want: true},
+	}
+	for _, tt := range tests {
+		ar := APIResourceInfo{Name: "things", Group: tt.group, Verbs: list}
+		if got := client.shouldFetchResource(ar, opts); got != tt.want {
+			t.Errorf("shouldFetchResource(group %q) = %v, want %v", tt.group, got, tt.want)
+		}
+	}
+
+	ar := APIResourceInfo{Name: "things", Group: "apps", Verbs: list}
+	if !client.shouldFetchResource(ar, &GetAllOptions{}) {
+		t.Error("expected built-in groups to be fetched without CustomResourcesOnly")
+	}
+}
+
+func TestSortAllResourceItems(t *testing.T) {
+	items := []AllResourceItem{
+		{Name: "b", Namespace: "ns1", Kind: "ConfigMap", APIVersion: "v1"},
+		{Name: "web", Namespace: "ns1", Kind: "Deployment", APIVersion: "apps/v1"},
+		{Name: "a", Namespace: "ns2", Kind: "ConfigMap", APIVersion: "v1"},
+		{Name: "a", Namespace: "ns1", Kind: "ConfigMap", APIVersion: "v1"},
+		{Name: "token", Namespace: "ns1", Kind: "Secret", APIVersion: "v1"},
+	}
+
+	sortAllResourceItems(items)
+
+	want := []string{"Deployment/ns1/web", "ConfigMap/ns1/a", "ConfigMap/ns1/b", "ConfigMap/ns2/a", "Secret/ns1/token"}
+	for i, item := range items {
+		if got := item.Kind + "/" + item.Namespace + "/" + item.Name; got != want[i] {
+			t.Errorf("items[%d] = %s, want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestListAPIResources(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", SingularName: "deployment", Kind: "Deployment", Namespaced: true, ShortNames: []string{"deploy"}},
+			},
+		},
+	}
+	client.clientsets["cluster"] = clientset
+
+	resources, err := client.ListAPIResources(context.Background(), "cluster")
+	if err != nil {
+		t.Fatalf("ListAPIResources() error: %v", err)
+	}
+	got := map[string]APIResourceInfo{}
+	for _, r := range resources {
+		got[r.Name+"."+r.Group] = r
+	}
+	if _, ok := got["pods/log."]; ok || len(got) != 2 {
+		t.Fatalf("unexpected resources, subresources must be skipped: %+v", resources)
+	}
+	if pods := got["pods."]; pods.Version != "v1" || len(pods.ShortNames) != 1 || pods.ShortNames[0] != "po" {
+		t.Errorf("pods = %+v", pods)
+	}
+	if deployments := got["deployments.apps"]; deployments.Version != "v1" || deployments.Kind != "Deployment" || len(deployments.ShortNames) != 1 || deployments.ShortNames[0] != "deploy" {
+		t.Errorf("deployments = %+v", deployments)
+	}
+}
@@ -0,0 +1,182 @@
+package steve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// mirrorPodAnnotation marks static pods mirrored by the kubelet; they cannot be evicted.
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// DrainOptions contains options for draining a node.
+type DrainOptions struct {
+	// GracePeriodSeconds overrides each pod's termination grace period when set.
+	GracePeriodSeconds *int64
+	// IgnoreDaemonSets skips DaemonSet-managed pods instead of refusing to drain.
+	IgnoreDaemonSets bool
+	// DeleteEmptyDir allows evicting pods that use emptyDir volumes (their data is lost).
+	DeleteEmptyDir bool
+	// Force allows evicting pods that no controller owns; nothing recreates them.
+	Force bool
+}
+
+// DrainPodRef identifies a pod handled during a drain, with an optional reason.
+type DrainPodRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// DrainResult summarizes a node drain.
+type DrainResult struct {
+	Node    string        `json:"node"`
+	Evicted []DrainPodRef `json:"evicted"`
+	Skipped []DrainPodRef `json:"skipped,omitempty"`
+	Failed  []DrainPodRef `json:"failed,omitempty"`
+}
+
+// ToJSON converts the DrainResult to a JSON string.
+func (r *DrainResult) ToJSON() (string, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ListNodePods lists all pods scheduled onto the given node.
+func (c *Client) ListNodePods(ctx context.Context, clusterID, nodeName string) ([]corev1.Pod, error) {
+	clientset, err := c.getClientset(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	podList, err := withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*corev1.PodList, error) {
+		return clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+			FieldSelector: "spec.nodeName=" + nodeName,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+	return podList.Items, nil
+}
+
+// EvictPod evicts a pod through the eviction subresource, honoring PodDisruptionBudgets.
+func (c *Client) EvictPod(ctx context.Context, clusterID, namespace, name string, gracePeriodSeconds *int64) error {
+	clientset, err := c.getClientset(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	if gracePeriodSeconds != nil {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds}
+	}
+	_, err = withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, clientset.PolicyV1().Evictions(namespace).Evict(ctx, eviction)
+	})
+	return err
+}
+
+// ClassifyPodsForDrain splits the pods on a node into pods to evict and pods to
+// skip. It returns an error naming the pods that block the drain under opts
+// (DaemonSet-managed pods without IgnoreDaemonSets, emptyDir pods without
+// DeleteEmptyDir, pods without a controller owner without Force).
+func ClassifyPodsForDrain(pods []corev1.Pod, opts DrainOptions) (evict []corev1.Pod, skipped []DrainPodRef, err error) {
+	var blocked []string
+	for _, pod := range pods {
+		ref := DrainPodRef{Namespace: pod.Namespace, Name: pod.Name}
+		switch {
+		case pod.Annotations[mirrorPodAnnotation] != "":
+			ref.Reason = "mirror pod"
+			skipped = append(skipped, ref)
+		case isDaemonSetPod(pod):
+			if !opts.IgnoreDaemonSets {
+				blocked = append(blocked, fmt.Sprintf("%s/%s (DaemonSet-managed, set ignoreDaemonSets)", pod.Namespace, pod.Name))
+				continue
+			}
+			ref.Reason = "DaemonSet-managed"
+			skipped = append(skipped, ref)
+		case pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed:
+			// Finished pods hold no workload; evicting them just cleans up.
+			evict = append(evict, pod)
+		default:
+			var reasons []string
+			if !hasControllerOwner(pod) && !opts.Force {
+				reasons = append(reasons, "not managed by a controller, set force")
+			}
+			if hasEmptyDir(pod) && !opts.DeleteEmptyDir {
+				reasons = append(reasons, "uses emptyDir, set deleteEmptyDir")
+			}
+			if len(reasons) > 0 {
+				blocked = append(blocked, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, strings.Join(reasons, "; ")))
+				continue
+			}
+			evict = append(evict, pod)
+		}
+	}
+
+	if len(blocked) > 0 {
+		return nil, nil, fmt.Errorf("cannot drain node: %d pod(s) would block the drain: %v", len(blocked), blocked)
+	}
+	return evict, skipped, nil
+}
+
+// DrainNode evicts the given pods from a node and reports the outcome per pod.
+// Eviction failures (e.g. PodDisruptionBudget violations) are recorded without
+// aborting the remaining evictions. Once ctx is done, no further evictions are
+// attempted and the remaining pods are reported as failed with the context error.
+func (c *Client) DrainNode(ctx context.Context, clusterID, nodeName string, evict []corev1.Pod, skipped []DrainPodRef, opts DrainOptions) *DrainResult {
+	result := &DrainResult{
+		Node:    nodeName,
+		Evicted: []DrainPodRef{},
+		Skipped: skipped,
+	}
+	for _, pod := range evict {
+		ref := DrainPodRef{Namespace: pod.Namespace, Name: pod.Name}
+		if err := ctx.Err(); err != nil {
+			ref.Reason = err.Error()
+			result.Failed = append(result.Failed, ref)
+			continue
+		}
+		if err := c.EvictPod(ctx, clusterID, pod.Namespace, pod.Name, opts.GracePeriodSeconds); err != nil {
+			ref.Reason = err.Error()
+			result.Failed = append(result.Failed, ref)
+			continue
+		}
+		result.Evicted = append(result.Evicted, ref)
+	}
+	return result
+}
+
+func isDaemonSetPod(pod corev1.Pod) bool {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" && ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// hasControllerOwner reports whether a controller will recreate the pod once evicted.
+func hasControllerOwner(pod corev1.Pod) bool {
+	return metav1.GetControllerOf(&pod) != nil
+}
+
+func hasEmptyDir(pod corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
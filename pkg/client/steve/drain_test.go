@@ -0,0 +1,214 @@
+package steve
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func drainTestPod(name string, mutate func(*corev1.Pod)) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if mutate != nil {
+		mutate(&pod)
+	}
+	return pod
+}
+
+func TestClassifyPodsForDrain(t *testing.T) {
+	controller := true
+	mirror := drainTestPod("mirror", func(p *corev1.Pod) {
+		p.Annotations = map[string]string{mirrorPodAnnotation: "abc"}
+	})
+	daemon := drainTestPod("daemon", func(p *corev1.Pod) {
+		p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: &controller}}
+	})
+	replicaSetOwner := []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs", Controller: &controller}}
+	scratch := drainTestPod("scratch", func(p *corev1.Pod) {
+		p.OwnerReferences = replicaSetOwner
+		p.Spec.Volumes = []corev1.Volume{{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	})
+	completed := drainTestPod("completed", func(p *corev1.Pod) {
+		p.Status.Phase = corev1.PodSucceeded
+		p.Spec.Volumes = scratch.Spec.Volumes
+	})
+	web := drainTestPod("web", func(p *corev1.Pod) {
+		p.OwnerReferences = replicaSetOwner
+	})
+	bare := drainTestPod("bare", nil)
+	bareScratch := drainTestPod("bare-scratch", func(p *corev1.Pod) {
+		p.Spec.Volumes = scratch.Spec.Volumes
+	})
+
+	tests := []struct {
+		name        string
+		pods        []corev1.Pod
+		opts        DrainOptions
+		wantEvict   []string
+		wantSkipped []string
+		wantErr     string
+	}{
+		{
+			name:      "plain pod is evicted",
+			pods:      []corev1.Pod{web},
+			wantEvict: []string{"web"},
+		},
+		{
+			name:        "mirror pod is skipped",
+			pods:        []corev1.Pod{mirror, web},
+			wantEvict:   []string{"web"},
+			wantSkipped: []string{"mirror"},
+		},
+		{
+			name:    "DaemonSet pod blocks by default",
+			pods:    []corev1.Pod{daemon, web},
+			wantErr: "ignoreDaemonSets",
+		},
+		{
+			name:        "DaemonSet pod skipped with ignoreDaemonSets",
+			pods:        []corev1.Pod{daemon, web},
+			opts:        DrainOptions{IgnoreDaemonSets: true},
+			wantEvict:   []string{"web"},
+			wantSkipped: []string{"daemon"},
+		},
+		{
+			name:    "emptyDir pod blocks by default",
+			pods:    []corev1.Pod{scratch},
+			wantErr: "deleteEmptyDir",
+		},
+		{
+			name:      "emptyDir pod evicted with deleteEmptyDir",
+			pods:      []corev1.Pod{scratch},
+			opts:      DrainOptions{DeleteEmptyDir: true},
+			wantEvict: []string{"scratch"},
+		},
+		{
+			name:    "pod without a controller blocks by default",
+			pods:    []corev1.Pod{bare, web},
+			wantErr: "default/bare (not managed by a controller, set force)",
+		},
+		{
+			name:      "pod without a controller evicted with force",
+			pods:      []corev1.Pod{bare, web},
+			opts:      DrainOptions{Force: true},
+			wantEvict: []string{"bare", "web"},
+		},
+		{
+			name:    "pod without a controller reports every blocking reason",
+			pods:    []corev1.Pod{bareScratch},
+			wantErr: "not managed by a controller, set force; uses emptyDir, set deleteEmptyDir",
+		},
+		{
+			name:    "force does not allow emptyDir pods",
+			pods:    []corev1.Pod{bareScratch},
+			opts:    DrainOptions{Force: true},
+			wantErr: "deleteEmptyDir",
+		},
+		{
+			name:      "completed pod is evicted regardless of emptyDir",
+			pods:      []corev1.Pod{completed},
+			wantEvict: []string{"completed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evict, skipped, err := ClassifyPodsForDrain(tt.pods, tt.opts)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ClassifyPodsForDrain() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ClassifyPodsForDrain() unexpected error: %v", err)
+			}
+
+			var gotEvict, gotSkipped []string
+			for _, pod := range evict {
+				gotEvict = append(gotEvict, pod.Name)
+			}
+			for _, ref := range skipped {
+				if ref.Reason == "" {
+					t.Errorf("skipped pod %s has no reason", ref.Name)
+				}
+				gotSkipped = append(gotSkipped, ref.Name)
+			}
+			if strings.Join(gotEvict, ",") != strings.Join(tt.wantEvict, ",") {
+				t.Errorf("evict = %v, want %v", gotEvict, tt.wantEvict)
+			}
+			if strings.Join(gotSkipped, ",") != strings.Join(tt.wantSkipped, ",") {
+				t.Errorf("skipped = %v, want %v", gotSkipped, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestDrainNode_RecordsEvictionFailures(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		if action.(k8stesting.CreateAction).GetObject().(metav1.Object).GetName() == "guarded" {
+			return true, nil, errors.New("cannot evict pod as it would violate the pod's disruption budget")
+		}
+		return true, nil, nil
+	})
+	client.clientsets["cluster"] = clientset
+
+	pods := []corev1.Pod{drainTestPod("web", nil), drainTestPod("guarded", nil)}
+	skipped := []DrainPodRef{{Namespace: "default", Name: "mirror", Reason: "mirror pod"}}
+
+	result := client.DrainNode(context.Background(), "cluster", "node-1", pods, skipped, DrainOptions{})
+
+	if len(result.Evicted) != 1 || result.Evicted[0].Name != "web" {
+		t.Errorf("Evicted = %+v, want [web]", result.Evicted)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].Name != "guarded" || !strings.Contains(result.Failed[0].Reason, "disruption budget") {
+		t.Errorf("Failed = %+v, want [guarded] with disruption budget reason", result.Failed)
+	}
+	if len(result.Skipped) != 1 {
+		t.Errorf("Skipped = %+v, want 1 entry", result.Skipped)
+	}
+}
+
+func TestDrainNode_StopsWhenContextCancelled(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	evictions := 0
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		evictions++
+		// The caller disconnects after the first eviction.
+		cancel()
+		return true, nil, nil
+	})
+	client.clientsets["cluster"] = clientset
+
+	pods := []corev1.Pod{drainTestPod("first", nil), drainTestPod("second", nil), drainTestPod("third", nil)}
+	result := client.DrainNode(ctx, "cluster", "node-1", pods, nil, DrainOptions{})
+
+	if evictions != 1 {
+		t.Errorf("evictions = %d, want 1", evictions)
+	}
+	if len(result.Evicted) != 1 || result.Evicted[0].Name != "first" {
+		t.Errorf("Evicted = %+v, want [first]", result.Evicted)
+	}
+	if len(result.Failed) != 2 || !strings.Contains(result.Failed[0].Reason, "context canceled") {
+		t.Errorf("Failed = %+v, want second and third with context canceled", result.Failed)
+	}
+}
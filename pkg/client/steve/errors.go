@@ -0,0 +1,52 @@
+package steve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Error classes for cluster API failures. Errors returned by the client wrap
+// one of these and the original error, so callers can check either with
+// errors.Is or the apierrors helpers.
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrForbidden    = errors.New("permission denied (check token RBAC)")
+	ErrUnauthorized = errors.New("authentication failed (check token)")
+	ErrTimeout      = errors.New("timed out")
+)
+
+// ErrUnsupportedKind reports that a kind resolves to no API resource, neither
+// through the built-in aliases nor through API discovery.
+var ErrUnsupportedKind = errors.New("unsupported resource kind")
+
+// classifyError prefixes a cluster API error with its class. Errors that are
+// already classified, and errors that do not fall into a class, are returned
+// unchanged.
+func classifyError(err error) error {
+	if err == nil || isClassified(err) {
+		return err
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case apierrors.IsForbidden(err):
+		return fmt.Errorf("%w: %w", ErrForbidden, err)
+	case apierrors.IsUnauthorized(err):
+		return fmt.Errorf("%w: %w", ErrUnauthorized, err)
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("request %w: %w", ErrTimeout, err)
+	}
+	return err
+}
+
+func isClassified(err error) bool {
+	for _, class := range []error{ErrNotFound, ErrForbidden, ErrUnauthorized, ErrTimeout} {
+		if errors.Is(err, class) {
+			return true
+		}
+	}
+	return false
+}
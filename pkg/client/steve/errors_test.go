@@ -0,0 +1,63 @@
+package steve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestClassifyError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantClass  error
+		wantPrefix string
+	}{
+		{name: "not found", err: apierrors.NewNotFound(pods, "web"), wantClass: ErrNotFound, wantPrefix: "resource not found: "},
+		{name: "forbidden", err: apierrors.NewForbidden(pods, "web", errors.New("no access")), wantClass: ErrForbidden, wantPrefix: "permission denied (check token RBAC): "},
+		{name: "unauthorized", err: apierrors.NewUnauthorized("bad token"), wantClass: ErrUnauthorized, wantPrefix: "authentication failed (check token): "},
+		{name: "server timeout", err: apierrors.NewServerTimeout(pods, "list", 1), wantClass: ErrTimeout, wantPrefix: "request timed out: "},
+		{name: "deadline exceeded", err: fmt.Errorf("get: %w", context.DeadlineExceeded), wantClass: ErrTimeout, wantPrefix: "request timed out: "},
+		{name: "other", err: errors.New("connection refused")},
+		{name: "already classified", err: fmt.Errorf("%w: web", ErrNotFound), wantClass: ErrNotFound, wantPrefix: "resource not found: web"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyError(tt.err)
+			if tt.wantClass == nil {
+				if got != tt.err {
+					t.Errorf("expected unclassified error to be returned unchanged, got %v", got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.wantClass) {
+				t.Errorf("expected %v to wrap %v", got, tt.wantClass)
+			}
+			if !strings.HasPrefix(got.Error(), tt.wantPrefix) {
+				t.Errorf("error %q does not start with %q", got.Error(), tt.wantPrefix)
+			}
+			if strings.Count(got.Error(), tt.wantClass.Error()) != 1 {
+				t.Errorf("expected the class to appear once in %q", got.Error())
+			}
+		})
+	}
+
+	t.Run("apierrors helpers still match", func(t *testing.T) {
+		if !apierrors.IsNotFound(classifyError(apierrors.NewNotFound(pods, "web"))) {
+			t.Error("expected apierrors.IsNotFound to match a classified error")
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if classifyError(nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+}
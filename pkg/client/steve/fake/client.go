@@ -30,24 +30,24 @@ func NewClient() *Client {
 
 // AddResource pre-loads a test resource into the fake.
 func (c *Client) AddResource(obj *unstructured.Unstructured) {
-	kind := strings.ToLower(strings.TrimSpace(obj.GetKind()))
+	kind := normalizeKind(obj.GetKind())
 	c.resources[kind] = append(c.resources[kind], obj)
 }
 
 // GetResource looks up a resource by kind, namespace, and name.
 func (c *Client) GetResource(_ context.Context, _ string, kind, namespace, name string) (*unstructured.Unstructured, error) {
-	normalizedKind := strings.ToLower(strings.TrimSpace(kind))
+	normalizedKind := normalizeKind(kind)
 	for _, r := range c.resources[normalizedKind] {
 		if r.GetName() == name && (namespace == "" || r.GetNamespace() == namespace) {
 			return r, nil
 		}
 	}
-	return nil, fmt.Errorf("resource not found: %s/%s (kind %s)", namespace, name, kind)
+	return nil, fmt.Errorf("%w: %s/%s (kind %s)", steve.ErrNotFound, namespace, name, kind)
 }
 
 // ListResources lists resources by kind, filtered by namespace and label selector.
 func (c *Client) ListResources(_ context.Context, _ string, kind, namespace string, opts *steve.ListOptions) (*unstructured.UnstructuredList, error) {
-	normalizedKind := strings.ToLower(strings.TrimSpace(kind))
+	normalizedKind := normalizeKind(kind)
 
 	var sel labels.Selector
 	if opts != nil && opts.LabelSelector != "" {
@@ -71,13 +71,24 @@ func (c *Client) ListResources(_ context.Context, _ string, kind, namespace stri
 	return result, nil
 }
 
+// normalizeKind lowercases kind and drops an apiVersion prefix, so
+// "apps/v1/ReplicaSet" finds resources added with kind ReplicaSet.
+func normalizeKind(kind string) string {
+	kind = strings.TrimSpace(kind)
+	if i := strings.LastIndex(kind, "/"); i >= 0 {
+		kind = kind[i+1:]
+	}
+	return strings.ToLower(kind)
+}
+
 // AddEvent pre-loads a test event into the fake.
 func (c *Client) AddEvent(event corev1.Event) {
 	c.events = append(c.events, event)
 }
 
 // GetEvents returns events matching the filters (nil name/kind means no filter).
-func (c *Client) GetEvents(_ context.Context, _ string, namespace, name, kind string) ([]corev1.Event, error) {
+// Label and field selectors in opts are ignored.
+func (c *Client) GetEvents(_ context.Context, _ string, namespace, name, kind string, _ *steve.ListOptions) ([]corev1.Event, error) {
 	var result []corev1.Event
 	for _, e := range c.events {
 		if namespace != "" && e.Namespace != namespace {
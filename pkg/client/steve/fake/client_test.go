@@ -51,6 +51,19 @@ func TestClient_GetResource_NotFound(t *testing.T) {
 	}
 }
 
+func TestClient_GetResource_APIVersionKind(t *testing.T) {
+	c := NewClient()
+	c.AddResource(makeResource("ReplicaSet", "web-abc", "default", nil))
+
+	obj, err := c.GetResource(context.Background(), "cluster-1", "apps/v1/ReplicaSet", "default", "web-abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.GetName() != "web-abc" {
+		t.Errorf("expected web-abc, got %s", obj.GetName())
+	}
+}
+
 func TestClient_GetResource_WrongKind(t *testing.T) {
 	c := NewClient()
 	c.AddResource(makeResource("Pod", "my-pod", "default", nil))
@@ -177,7 +190,7 @@ func TestClient_GetEvents_All(t *testing.T) {
 		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod-2", Namespace: "kube-system"},
 	})
 
-	events, err := c.GetEvents(context.Background(), "cluster-1", "", "", "")
+	events, err := c.GetEvents(context.Background(), "cluster-1", "", "", "", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -199,7 +212,7 @@ func TestClient_GetEvents_NamespaceFilter(t *testing.T) {
 		InvolvedObject: corev1.ObjectReference{Kind: "Pod"},
 	})
 
-	events, err := c.GetEvents(context.Background(), "cluster-1", "default", "", "")
+	events, err := c.GetEvents(context.Background(), "cluster-1", "default", "", "", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -219,7 +232,7 @@ func TestClient_GetEvents_KindFilter(t *testing.T) {
 		InvolvedObject: corev1.ObjectReference{Kind: "Node"},
 	})
 
-	events, err := c.GetEvents(context.Background(), "cluster-1", "", "", "Node")
+	events, err := c.GetEvents(context.Background(), "cluster-1", "", "", "Node", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -21,7 +21,7 @@ var parameterCodec = runtime.NewParameterCodec(scheme.Scheme)
 
 // ExecInPod executes a command inside a container and returns stdout, stderr, and any error.
 func (c *Client) ExecInPod(ctx context.Context, clusterID, namespace, podName, container string, command []string, stdin io.Reader) ([]byte, []byte, error) {
-	restConfig, err := c.createRestConfig(clusterID)
+	restConfig, err := c.getRestConfig(clusterID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("create REST config: %w", err)
 	}
@@ -32,6 +32,7 @@ var K8sKindsToGVRs = map[string]schema.GroupVersionResource{
 	"limits":                {Group: "", Version: "v1", Resource: "limitranges"},
 	"endpoints":             {Group: "", Version: "v1", Resource: "endpoints"},
 	"ep":                    {Group: "", Version: "v1", Resource: "endpoints"},
+	"replicationcontroller": {Group: "", Version: "v1", Resource: "replicationcontrollers"},
 
 	// --- Apps Resources (Group: "apps") ---
 	"deployment":  {Group: "apps", Version: "v1", Resource: "deployments"},
@@ -66,6 +67,12 @@ var K8sKindsToGVRs = map[string]schema.GroupVersionResource{
 	"clusterrole":        {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
 	"clusterrolebinding": {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"},
 
+	// --- Certificates Resources (Group: "certificates.k8s.io") ---
+	"certificatesigningrequest": {Group: "certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"},
+
+	// --- Scheduling Resources (Group: "scheduling.k8s.io") ---
+	"priorityclass": {Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"},
+
 	// --- Storage Resources (Group: "storage.k8s.io") ---
 	"storageclass":     {Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"},
 	"sc":               {Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"},
@@ -116,9 +123,61 @@ var K8sKindsToGVRs = map[string]schema.GroupVersionResource{
 	"clusterissuer": {Group: "cert-manager.io", Version: "v1", Resource: "clusterissuers"},
 }
 
-// GetGVR returns the GroupVersionResource for a given kind.
-// Returns an empty GVR and false if the kind is not found.
+// KindAliases maps kubectl short names and plural resource names to the kind
+// they stand for in K8sKindsToGVRs. Short names that are already keys of
+// K8sKindsToGVRs are not repeated here.
+var KindAliases = map[string]string{
+	// Short names
+	"po":  "pod",
+	"rc":  "replicationcontroller",
+	"csr": "certificatesigningrequest",
+	"pc":  "priorityclass",
+
+	// Plural resource names
+	"pods":                       "pod",
+	"services":                   "service",
+	"configmaps":                 "configmap",
+	"secrets":                    "secret",
+	"events":                     "event",
+	"namespaces":                 "namespace",
+	"nodes":                      "node",
+	"serviceaccounts":            "serviceaccount",
+	"persistentvolumes":          "persistentvolume",
+	"persistentvolumeclaims":     "persistentvolumeclaim",
+	"resourcequotas":             "resourcequota",
+	"limitranges":                "limitrange",
+	"replicationcontrollers":     "replicationcontroller",
+	"deployments":                "deployment",
+	"statefulsets":               "statefulset",
+	"daemonsets":                 "daemonset",
+	"replicasets":                "replicaset",
+	"jobs":                       "job",
+	"cronjobs":                   "cronjob",
+	"ingresses":                  "ingress",
+	"networkpolicies":            "networkpolicy",
+	"ingressclasses":             "ingressclass",
+	"horizontalpodautoscalers":   "horizontalpodautoscaler",
+	"roles":                      "role",
+	"rolebindings":               "rolebinding",
+	"clusterroles":               "clusterrole",
+	"clusterrolebindings":        "clusterrolebinding",
+	"storageclasses":             "storageclass",
+	"volumeattachments":          "volumeattachment",
+	"crds":                       "customresourcedefinition",
+	"poddisruptionbudgets":       "poddisruptionbudget",
+	"certificatesigningrequests": "certificatesigningrequest",
+	"priorityclasses":            "priorityclass",
+}
+
+// GetGVR returns the GroupVersionResource for a given lowercase kind, short
+// name or alias. Returns an empty GVR and false if the kind is not found.
 func GetGVR(kind string) (schema.GroupVersionResource, bool) {
-	gvr, ok := K8sKindsToGVRs[kind]
-	return gvr, ok
+	if gvr, ok := K8sKindsToGVRs[kind]; ok {
+		return gvr, true
+	}
+	if canonical, ok := KindAliases[kind]; ok {
+		gvr, ok := K8sKindsToGVRs[canonical]
+		return gvr, ok
+	}
+	return schema.GroupVersionResource{}, false
 }
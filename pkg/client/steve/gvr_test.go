@@ -0,0 +1,64 @@
+package steve
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGetGVR_ShortNamesAndAliases(t *testing.T) {
+	tests := []struct {
+		kind string
+		want schema.GroupVersionResource
+	}{
+		{kind: "pod", want: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{kind: "po", want: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{kind: "pods", want: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{kind: "svc", want: schema.GroupVersionResource{Version: "v1", Resource: "services"}},
+		{kind: "cm", want: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+		{kind: "ns", want: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}},
+		{kind: "no", want: schema.GroupVersionResource{Version: "v1", Resource: "nodes"}},
+		{kind: "sa", want: schema.GroupVersionResource{Version: "v1", Resource: "serviceaccounts"}},
+		{kind: "pvc", want: schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}},
+		{kind: "ep", want: schema.GroupVersionResource{Version: "v1", Resource: "endpoints"}},
+		{kind: "rc", want: schema.GroupVersionResource{Version: "v1", Resource: "replicationcontrollers"}},
+		{kind: "deploy", want: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}},
+		{kind: "deployments", want: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}},
+		{kind: "sts", want: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}},
+		{kind: "ds", want: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}},
+		{kind: "rs", want: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}},
+		{kind: "cj", want: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}},
+		{kind: "ing", want: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}},
+		{kind: "netpol", want: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}},
+		{kind: "hpa", want: schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}},
+		{kind: "pdb", want: schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}},
+		{kind: "sc", want: schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}},
+		{kind: "crd", want: schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}},
+		{kind: "crds", want: schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}},
+		{kind: "csr", want: schema.GroupVersionResource{Group: "certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"}},
+		{kind: "pc", want: schema.GroupVersionResource{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			got, ok := GetGVR(tt.kind)
+			if !ok || got != tt.want {
+				t.Errorf("GetGVR(%q) = %v, %v; want %v", tt.kind, got, ok, tt.want)
+			}
+		})
+	}
+
+	if gvr, ok := GetGVR("widget"); ok {
+		t.Errorf("GetGVR(widget) = %v, want not found", gvr)
+	}
+}
+
+func TestKindAliasesResolve(t *testing.T) {
+	for alias, kind := range KindAliases {
+		if _, ok := K8sKindsToGVRs[kind]; !ok {
+			t.Errorf("alias %q points to unknown kind %q", alias, kind)
+		}
+		if _, ok := K8sKindsToGVRs[alias]; ok {
+			t.Errorf("alias %q shadows a kind of K8sKindsToGVRs", alias)
+		}
+	}
+}
@@ -5,11 +5,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+// DefaultMultiPodLogConcurrency is the number of pods whose logs are fetched
+// in parallel by GetMultiPodLogs when PodLogOptions.MaxConcurrency is unset.
+const DefaultMultiPodLogConcurrency = 8
+
 // PodLogOptions contains options for fetching pod logs.
 type PodLogOptions struct {
 	Container    string
@@ -17,6 +23,12 @@ type PodLogOptions struct {
 	SinceSeconds *int64
 	Timestamps   bool
 	Previous     bool
+	// IncludeInitContainers makes GetAllContainerLogs also fetch init
+	// container logs.
+	IncludeInitContainers bool
+	// MaxConcurrency caps parallel pod log fetches in GetMultiPodLogs.
+	// Zero or negative uses DefaultMultiPodLogConcurrency.
+	MaxConcurrency int
 }
 
 // GetPodLogs retrieves logs from a specific pod and container.
@@ -41,22 +53,58 @@ func (c *Client) GetPodLogs(ctx context.Context, clusterID, namespace, podName s
 		podLogOpts.Previous = opts.Previous
 	}
 
-	req := clientset.CoreV1().Pods(namespace).GetLogs(podName, podLogOpts)
-	stream, err := req.Stream(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to open log stream: %w", err)
-	}
-	defer func() { _ = stream.Close() }()
+	// Opening and reading the stream share one request timeout.
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (string, error) {
+		req := clientset.CoreV1().Pods(namespace).GetLogs(podName, podLogOpts)
+		stream, err := req.Stream(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to open log stream: %w", err)
+		}
+		defer func() { _ = stream.Close() }()
+
+		buf := new(bytes.Buffer)
+		if _, err := io.Copy(buf, stream); err != nil {
+			return "", fmt.Errorf("failed to read log stream: %w", err)
+		}
+		return buf.String(), nil
+	})
+}
+
+// ContainerType distinguishes app, init and ephemeral containers of a pod.
+type ContainerType string
+
+// Container types; app containers use the empty type.
+const (
+	ContainerTypeApp       ContainerType = ""
+	ContainerTypeInit      ContainerType = "init"
+	ContainerTypeEphemeral ContainerType = "ephemeral"
+)
 
-	buf := new(bytes.Buffer)
-	if _, err := io.Copy(buf, stream); err != nil {
-		return "", fmt.Errorf("failed to read log stream: %w", err)
+// ContainerLogKey returns the GetAllContainerLogs map key of a container:
+// the bare name for app containers, "<type>:<name>" otherwise. Container names
+// are DNS labels, so the ':' cannot clash.
+func ContainerLogKey(name string, containerType ContainerType) string {
+	if containerType == ContainerTypeApp {
+		return name
 	}
+	return string(containerType) + ":" + name
+}
 
-	return buf.String(), nil
+// SplitContainerLogKey returns the container name and type of a
+// GetAllContainerLogs map key.
+func SplitContainerLogKey(key string) (string, ContainerType) {
+	for _, containerType := range []ContainerType{ContainerTypeInit, ContainerTypeEphemeral} {
+		if name, ok := strings.CutPrefix(key, string(containerType)+":"); ok {
+			return name, containerType
+		}
+	}
+	return key, ContainerTypeApp
 }
 
-// GetAllContainerLogs retrieves logs from all containers in a pod.
+// GetAllContainerLogs retrieves logs from all containers in a pod, keyed by
+// ContainerLogKey. Ephemeral containers are always included; init containers
+// only when opts.IncludeInitContainers is set. A non-empty opts.Container
+// limits the result to that container, whatever its type.
 func (c *Client) GetAllContainerLogs(ctx context.Context, clusterID, namespace, podName string, opts *PodLogOptions) (map[string]string, error) {
 	pod, err := c.GetResource(ctx, clusterID, "pod", namespace, podName)
 	if err != nil {
@@ -75,6 +123,8 @@ func (c *Client) GetAllContainerLogs(ctx context.Context, clusterID, namespace,
 	timestamps := false
 	var sinceSeconds *int64
 	previous := false
+	only := ""
+	includeInit := false
 	if opts != nil {
 		if opts.TailLines != nil {
 			tailLines = *opts.TailLines
@@ -82,17 +132,33 @@ func (c *Client) GetAllContainerLogs(ctx context.Context, clusterID, namespace,
 		timestamps = opts.Timestamps
 		sinceSeconds = opts.SinceSeconds
 		previous = opts.Previous
+		only = opts.Container
+		includeInit = opts.IncludeInitContainers
+	}
+
+	keys := make(map[string]string)
+	if includeInit || only != "" {
+		initContainers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "initContainers")
+		for _, name := range containerNames(initContainers) {
+			keys[name] = ContainerLogKey(name, ContainerTypeInit)
+		}
+	}
+	ephemeralContainers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "ephemeralContainers")
+	for _, name := range containerNames(ephemeralContainers) {
+		keys[name] = ContainerLogKey(name, ContainerTypeEphemeral)
+	}
+	for _, name := range containerNames(containers) {
+		keys[name] = name
 	}
 
 	logs := make(map[string]string)
-	for _, ctr := range containers {
-		container, ok := ctr.(map[string]interface{})
-		if !ok {
+	for name, key := range keys {
+		if only != "" && name != only {
 			continue
 		}
-		name, ok := container["name"].(string)
-		if !ok {
-			continue
+		// Stop fetching once the caller has gone away.
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
 
 		logOpts := &PodLogOptions{
@@ -104,15 +170,30 @@ func (c *Client) GetAllContainerLogs(ctx context.Context, clusterID, namespace,
 		}
 		containerLogs, err := c.GetPodLogs(ctx, clusterID, namespace, podName, logOpts)
 		if err != nil {
-			logs[name] = fmt.Sprintf("Error getting logs: %v", err)
+			logs[key] = fmt.Sprintf("Error getting logs: %v", err)
 		} else {
-			logs[name] = containerLogs
+			logs[key] = containerLogs
 		}
 	}
 
 	return logs, nil
 }
 
+// containerNames returns the names of the containers in a pod spec container list.
+func containerNames(containers []interface{}) []string {
+	names := make([]string, 0, len(containers))
+	for _, ctr := range containers {
+		container, ok := ctr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := container["name"].(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // MultiPodLogResult contains the log result for a single pod.
 type MultiPodLogResult struct {
 	Pod       string            `json:"pod"`
@@ -136,27 +217,46 @@ func (c *Client) GetMultiPodLogs(ctx context.Context, clusterID, namespace strin
 		return []MultiPodLogResult{}, nil
 	}
 
-	results := make([]MultiPodLogResult, 0, len(podList.Items))
+	concurrency := DefaultMultiPodLogConcurrency
+	if opts != nil && opts.MaxConcurrency > 0 {
+		concurrency = opts.MaxConcurrency
+	}
 
-	for _, pod := range podList.Items {
-		podName := pod.GetName()
-		podNamespace := pod.GetNamespace()
+	// Each worker writes to its own slot, so results keep the pod list order.
+	results := make([]MultiPodLogResult, len(podList.Items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
 
-		result := MultiPodLogResult{
-			Pod:       podName,
-			Namespace: podNamespace,
+	for i, pod := range podList.Items {
+		results[i] = MultiPodLogResult{
+			Pod:       pod.GetName(),
+			Namespace: pod.GetNamespace(),
 			Logs:      make(map[string]string),
 		}
 
-		containerLogs, err := c.GetAllContainerLogs(ctx, clusterID, podNamespace, podName, opts)
-		if err != nil {
-			result.Error = err.Error()
-		} else {
-			result.Logs = containerLogs
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Error = ctx.Err().Error()
+			continue
 		}
 
-		results = append(results, result)
+		wg.Add(1)
+		go func(result *MultiPodLogResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// A failure for one pod (e.g. deleted mid-call) is recorded on its
+			// result and does not abort the others.
+			containerLogs, err := c.GetAllContainerLogs(ctx, clusterID, result.Namespace, result.Pod, opts)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Logs = containerLogs
+		}(&results[i])
 	}
 
+	wg.Wait()
 	return results, nil
 }
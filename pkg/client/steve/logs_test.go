@@ -2,19 +2,22 @@ package steve
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	dynfake "k8s.io/client-go/dynamic/fake"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
 func TestGetAllContainerLogs_MissingContainersReturnsError(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	pod := &unstructured.Unstructured{
 		Object: map[string]interface{}{
@@ -41,7 +44,7 @@ func TestGetAllContainerLogs_MissingContainersReturnsError(t *testing.T) {
 }
 
 func TestGetAllContainerLogs_HappyPath(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
@@ -75,7 +78,7 @@ func TestGetAllContainerLogs_HappyPath(t *testing.T) {
 }
 
 func TestGetAllContainerLogs_PropagatesOptions(t *testing.T) {
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
@@ -104,3 +107,132 @@ func TestGetAllContainerLogs_PropagatesOptions(t *testing.T) {
 		t.Fatalf("expected logs for 2 containers, got %d", len(logs))
 	}
 }
+
+func TestGetMultiPodLogs_PreservesOrderWithConcurrency(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+
+	var objects []runtime.Object
+	for i := 0; i < 20; i++ {
+		objects = append(objects, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%02d", i), Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		})
+	}
+	client.dynamicClients["cluster"] = dynfake.NewSimpleDynamicClient(scheme.Scheme, objects...)
+	client.clientsets["cluster"] = k8sfake.NewSimpleClientset()
+
+	results, err := client.GetMultiPodLogs(context.Background(), "cluster", "default", "", &PodLogOptions{MaxConcurrency: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != len(objects) {
+		t.Fatalf("expected %d results, got %d", len(objects), len(results))
+	}
+
+	listed, err := client.ListResources(context.Background(), "cluster", "pod", "default", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, result := range results {
+		if result.Pod != listed.Items[i].GetName() {
+			t.Errorf("results[%d].Pod = %q, want %q", i, result.Pod, listed.Items[i].GetName())
+		}
+		if result.Error != "" {
+			t.Errorf("results[%d] unexpected error: %s", i, result.Error)
+		}
+		if result.Logs["app"] != "fake logs" {
+			t.Errorf("results[%d] logs = %q, want fake logs", i, result.Logs["app"])
+		}
+	}
+}
+
+func TestGetMultiPodLogs_PartialFailure(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+
+	good := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "good", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	}
+	broken := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "broken", "namespace": "default"},
+		"spec":       map[string]interface{}{},
+	}}
+	client.dynamicClients["cluster"] = dynfake.NewSimpleDynamicClient(scheme.Scheme, good, broken)
+	client.clientsets["cluster"] = k8sfake.NewSimpleClientset()
+
+	results, err := client.GetMultiPodLogs(context.Background(), "cluster", "default", "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		switch result.Pod {
+		case "good":
+			if result.Error != "" || result.Logs["app"] != "fake logs" {
+				t.Errorf("good pod result = %+v, want logs without error", result)
+			}
+		case "broken":
+			if result.Error == "" {
+				t.Error("expected error for pod without containers")
+			}
+		}
+	}
+}
+
+func TestGetAllContainerLogs_ContainerTypes(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "setup"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		opts *PodLogOptions
+		want []string
+	}{
+		{name: "default", opts: nil, want: []string{"app", "ephemeral:debugger"}},
+		{name: "include init containers", opts: &PodLogOptions{IncludeInitContainers: true}, want: []string{"app", "ephemeral:debugger", "init:setup"}},
+		{name: "target ephemeral container", opts: &PodLogOptions{Container: "debugger"}, want: []string{"ephemeral:debugger"}},
+		{name: "target init container", opts: &PodLogOptions{Container: "setup"}, want: []string{"init:setup"}},
+		{name: "target app container", opts: &PodLogOptions{Container: "app", IncludeInitContainers: true}, want: []string{"app"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("https://example.com", "token", "", "", false, 0)
+			client.dynamicClients["cluster"] = dynfake.NewSimpleDynamicClient(scheme.Scheme, pod.DeepCopy())
+			client.clientsets["cluster"] = k8sfake.NewSimpleClientset()
+
+			logs, err := client.GetAllContainerLogs(context.Background(), "cluster", "default", "pod", tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var keys []string
+			for key := range logs {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			if strings.Join(keys, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("keys = %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitContainerLogKey(t *testing.T) {
+	for _, containerType := range []ContainerType{ContainerTypeApp, ContainerTypeInit, ContainerTypeEphemeral} {
+		name, gotType := SplitContainerLogKey(ContainerLogKey("c", containerType))
+		if name != "c" || gotType != containerType {
+			t.Errorf("round trip of %q = %q, %q", containerType, name, gotType)
+		}
+	}
+}
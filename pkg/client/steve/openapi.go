@@ -0,0 +1,144 @@
+package steve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// openAPIGVKExtension lists the group/version/kinds an OpenAPI schema describes.
+const openAPIGVKExtension = "x-kubernetes-group-version-kind"
+
+// KindSchema is the OpenAPI v3 schema of a resource kind.
+type KindSchema struct {
+	GVK schema.GroupVersionKind
+	// Name is the key of the kind's schema in Schemas.
+	Name string
+	// Schemas holds components.schemas of the kind's group version; schemas
+	// reference each other as "#/components/schemas/<name>".
+	Schemas map[string]interface{}
+}
+
+// GetKindSchema returns the OpenAPI v3 schema of kind, read from the
+// cluster's discovery endpoint. The schemas of a group version are cached
+// with the cluster's clients.
+func (c *Client) GetKindSchema(_ context.Context, clusterID, kind string) (*KindSchema, error) {
+	gvr, err := c.resolveGVR(clusterID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := c.getClientset(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	groupVersion := gvr.GroupVersion().String()
+	resources, err := clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover resources for %s: %w", groupVersion, err)
+	}
+	gvk := gvr.GroupVersion().WithKind("")
+	for _, r := range resources.APIResources {
+		if r.Name == gvr.Resource {
+			gvk.Kind = r.Kind
+			break
+		}
+	}
+	if gvk.Kind == "" {
+		return nil, fmt.Errorf("%w: %s in %s", errKindNotDiscovered, gvr.Resource, groupVersion)
+	}
+
+	schemas, err := c.openAPISchemas(clusterID, clientset, openAPIPath(gvr.GroupVersion()))
+	if err != nil {
+		return nil, err
+	}
+	name, ok := findKindSchemaName(schemas, gvk)
+	if !ok {
+		return nil, fmt.Errorf("no OpenAPI schema for %s", gvk)
+	}
+	return &KindSchema{GVK: gvk, Name: name, Schemas: schemas}, nil
+}
+
+// openAPIPath is the OpenAPI v3 discovery path of a group version.
+func openAPIPath(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return "api/" + gv.Version
+	}
+	return "apis/" + gv.Group + "/" + gv.Version
+}
+
+// openAPISchemas returns components.schemas of the OpenAPI v3 document at
+// path, fetching and caching it on first use.
+func (c *Client) openAPISchemas(clusterID string, clientset kubernetes.Interface, path string) (map[string]interface{}, error) {
+	c.cacheMu.Lock()
+	c.prepareCacheLocked(clusterID)
+	schemas, ok := c.openAPISchemaCache[clusterID][path]
+	c.cacheMu.Unlock()
+	if ok {
+		return schemas, nil
+	}
+
+	paths, err := clientset.Discovery().OpenAPIV3().Paths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OpenAPI v3 paths: %w", err)
+	}
+	groupVersion, ok := paths[path]
+	if !ok {
+		return nil, fmt.Errorf("the cluster serves no OpenAPI v3 schema for %s", path)
+	}
+	data, err := groupVersion.Schema("application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenAPI v3 schema for %s: %w", path, err)
+	}
+	var doc struct {
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI v3 schema for %s: %w", path, err)
+	}
+
+	c.cacheMu.Lock()
+	c.ensureCachesLocked()
+	if c.openAPISchemaCache[clusterID] == nil {
+		c.openAPISchemaCache[clusterID] = make(map[string]map[string]interface{})
+	}
+	c.openAPISchemaCache[clusterID][path] = doc.Components.Schemas
+	c.touchClusterLocked(clusterID)
+	c.cacheMu.Unlock()
+	return doc.Components.Schemas, nil
+}
+
+// findKindSchemaName returns the name of the schema whose
+// x-kubernetes-group-version-kind extension lists gvk.
+func findKindSchemaName(schemas map[string]interface{}, gvk schema.GroupVersionKind) (string, bool) {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s, ok := schemas[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		gvks, _ := s[openAPIGVKExtension].([]interface{})
+		for _, entry := range gvks {
+			m, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if m["group"] == gvk.Group && m["version"] == gvk.Version && m["kind"] == gvk.Kind {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
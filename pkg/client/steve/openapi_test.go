@@ -0,0 +1,80 @@
+package steve
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func deploymentSchemas() map[string]interface{} {
+	return map[string]interface{}{
+		"io.k8s.api.apps.v1.Deployment": map[string]interface{}{
+			"type": "object",
+			openAPIGVKExtension: []interface{}{
+				map[string]interface{}{"group": "apps", "version": "v1", "kind": "Deployment"},
+			},
+		},
+		"io.k8s.api.apps.v1.DeploymentSpec": map[string]interface{}{"type": "object"},
+	}
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	if got := openAPIPath(schema.GroupVersion{Version: "v1"}); got != "api/v1" {
+		t.Errorf("core path = %q, want api/v1", got)
+	}
+	if got := openAPIPath(schema.GroupVersion{Group: "apps", Version: "v1"}); got != "apis/apps/v1" {
+		t.Errorf("apps path = %q, want apis/apps/v1", got)
+	}
+}
+
+func TestFindKindSchemaName(t *testing.T) {
+	schemas := deploymentSchemas()
+	name, ok := findKindSchemaName(schemas, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	if !ok || name != "io.k8s.api.apps.v1.Deployment" {
+		t.Errorf("findKindSchemaName() = %q, %v", name, ok)
+	}
+	if _, ok := findKindSchemaName(schemas, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}); ok {
+		t.Error("expected no schema for StatefulSet")
+	}
+}
+
+func TestGetKindSchema_UsesCachedSchemas(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+	}}
+	client.clientsets["cluster"] = clientset
+	// The fake discovery client cannot serve OpenAPI v3, so the schemas must come from the cache.
+	client.openAPISchemaCache["cluster"] = map[string]map[string]interface{}{"apis/apps/v1": deploymentSchemas()}
+
+	got, err := client.GetKindSchema(context.Background(), "cluster", "deployment")
+	if err != nil {
+		t.Fatalf("GetKindSchema() error = %v", err)
+	}
+	if got.GVK.Kind != "Deployment" || got.Name != "io.k8s.api.apps.v1.Deployment" {
+		t.Errorf("GetKindSchema() = %+v", got)
+	}
+
+	client.InvalidateCluster("cluster")
+	if _, ok := client.openAPISchemaCache["cluster"]; ok {
+		t.Error("InvalidateCluster should drop the cached schemas")
+	}
+}
+
+func TestGetKindSchema_UnknownResource(t *testing.T) {
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{GroupVersion: "apps/v1"}}
+	client.clientsets["cluster"] = clientset
+
+	_, err := client.GetKindSchema(context.Background(), "cluster", "deployment")
+	if err == nil || !strings.Contains(err.Error(), "deployments") {
+		t.Errorf("expected a not-discovered error, got %v", err)
+	}
+}
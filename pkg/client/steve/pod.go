@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // InspectPodResult contains the results of inspecting a pod.
@@ -14,6 +18,40 @@ type InspectPodResult struct {
 	Parent  *unstructured.Unstructured `json:"parent,omitempty"`
 	Metrics *unstructured.Unstructured `json:"metrics,omitempty"`
 	Logs    map[string]string          `json:"logs"`
+	// Events involving the pod, newest first.
+	Events []corev1.Event `json:"events"`
+	// Containers summarizes the state of each init, app and ephemeral container.
+	Containers []ContainerStatusSummary `json:"containers"`
+	// LikelyProblem is a one-line explanation of why the pod is unhealthy, if it is.
+	LikelyProblem string `json:"likelyProblem,omitempty"`
+}
+
+// Container states reported in ContainerStatusSummary.State.
+const (
+	ContainerStateRunning    = "running"
+	ContainerStateWaiting    = "waiting"
+	ContainerStateTerminated = "terminated"
+)
+
+// ContainerStatusSummary is the readiness and restart information of a single container.
+type ContainerStatusSummary struct {
+	Name         string        `json:"name"`
+	Type         ContainerType `json:"type,omitempty"`
+	Ready        bool          `json:"ready"`
+	RestartCount int32         `json:"restartCount"`
+	State        string        `json:"state,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+	Message      string        `json:"message,omitempty"`
+	ExitCode     *int32        `json:"exitCode,omitempty"`
+	// Last termination, populated once the container has restarted.
+	LastTerminationReason   string `json:"lastTerminationReason,omitempty"`
+	LastTerminationExitCode *int32 `json:"lastTerminationExitCode,omitempty"`
+}
+
+// InspectPodOptions controls what InspectPod collects; nil uses the defaults.
+type InspectPodOptions struct {
+	// MaxEvents keeps only the most recent events; 0 keeps all of them.
+	MaxEvents int
 }
 
 // ToJSON converts the InspectPodResult to a JSON string.
@@ -25,17 +63,29 @@ func (r *InspectPodResult) ToJSON() (string, error) {
 	return string(data), nil
 }
 
-// InspectPod retrieves comprehensive information about a pod including its parent, metrics, and logs.
-func (c *Client) InspectPod(ctx context.Context, clusterID, namespace, podName string) (*InspectPodResult, error) {
+// InspectPod retrieves comprehensive information about a pod including its parent, metrics, logs, and events.
+func (c *Client) InspectPod(ctx context.Context, clusterID, namespace, podName string, opts *InspectPodOptions) (*InspectPodResult, error) {
+	if opts == nil {
+		opts = &InspectPodOptions{}
+	}
+
 	pod, err := c.GetResource(ctx, clusterID, "pod", namespace, podName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod: %w", err)
 	}
 
 	result := &InspectPodResult{
-		Pod:    pod,
-		Parent: c.findPodParent(ctx, clusterID, namespace, pod),
-		Logs:   make(map[string]string),
+		Pod:        pod,
+		Parent:     c.findPodParent(ctx, clusterID, namespace, pod),
+		Logs:       make(map[string]string),
+		Events:     []corev1.Event{},
+		Containers: []ContainerStatusSummary{},
+	}
+
+	var typed corev1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(pod.UnstructuredContent(), &typed); err == nil {
+		result.Containers = SummarizeContainerStatuses(&typed)
+		result.LikelyProblem = DiagnosePod(&typed, result.Containers)
 	}
 
 	// Get pod metrics (ignore error as metrics-server might not be installed)
@@ -47,9 +97,159 @@ func (c *Client) InspectPod(ctx context.Context, clusterID, namespace, podName s
 		result.Logs = logs
 	}
 
+	// Get events involving the pod (ignore error as events are best-effort context)
+	if events, err := c.GetEvents(ctx, clusterID, namespace, podName, "Pod", nil); err == nil {
+		result.Events = newestEvents(events, opts.MaxEvents)
+	}
+
 	return result, nil
 }
 
+// newestEvents sorts events newest first and keeps at most max of them (all if max <= 0).
+func newestEvents(events []corev1.Event, max int) []corev1.Event {
+	sort.SliceStable(events, func(i, j int) bool {
+		return latestEventTime(events[i]).After(latestEventTime(events[j]))
+	})
+	if max > 0 && len(events) > max {
+		events = events[:max]
+	}
+	return events
+}
+
+// latestEventTime returns when an event was last seen, falling back through
+// the older timestamp fields for events that do not set LastTimestamp.
+func latestEventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// SummarizeContainerStatuses flattens the container statuses of a pod in start
+// order: init containers, app containers, then ephemeral containers.
+func SummarizeContainerStatuses(pod *corev1.Pod) []ContainerStatusSummary {
+	summaries := make([]ContainerStatusSummary, 0,
+		len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses)+len(pod.Status.EphemeralContainerStatuses))
+	for _, cs := range pod.Status.InitContainerStatuses {
+		summaries = append(summaries, summarizeContainerStatus(cs, ContainerTypeInit))
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		summaries = append(summaries, summarizeContainerStatus(cs, ContainerTypeApp))
+	}
+	for _, cs := range pod.Status.EphemeralContainerStatuses {
+		summaries = append(summaries, summarizeContainerStatus(cs, ContainerTypeEphemeral))
+	}
+	return summaries
+}
+
+func summarizeContainerStatus(cs corev1.ContainerStatus, containerType ContainerType) ContainerStatusSummary {
+	summary := ContainerStatusSummary{
+		Name:         cs.Name,
+		Type:         containerType,
+		Ready:        cs.Ready,
+		RestartCount: cs.RestartCount,
+	}
+
+	switch {
+	case cs.State.Waiting != nil:
+		summary.State = ContainerStateWaiting
+		summary.Reason = cs.State.Waiting.Reason
+		summary.Message = cs.State.Waiting.Message
+	case cs.State.Terminated != nil:
+		summary.State = ContainerStateTerminated
+		summary.Reason = cs.State.Terminated.Reason
+		summary.Message = cs.State.Terminated.Message
+		exitCode := cs.State.Terminated.ExitCode
+		summary.ExitCode = &exitCode
+	case cs.State.Running != nil:
+		summary.State = ContainerStateRunning
+	}
+
+	if last := cs.LastTerminationState.Terminated; last != nil {
+		summary.LastTerminationReason = last.Reason
+		exitCode := last.ExitCode
+		summary.LastTerminationExitCode = &exitCode
+	}
+	return summary
+}
+
+// DiagnosePod derives a short description of the most likely reason a pod is
+// unhealthy from its phase, scheduling condition and container states. It
+// returns an empty string when nothing looks wrong.
+func DiagnosePod(pod *corev1.Pod, containers []ContainerStatusSummary) string {
+	if pod.Status.Phase == corev1.PodFailed && pod.Status.Reason != "" {
+		return fmt.Sprintf("pod failed: %s", pod.Status.Reason)
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+			if cond.Message != "" {
+				return fmt.Sprintf("pod cannot be scheduled: %s", cond.Message)
+			}
+			return "pod cannot be scheduled"
+		}
+	}
+
+	// Waiting and failed containers are the strongest signals; init containers
+	// come first in the slice, so a pod stuck in Init reports its init container.
+	// Ephemeral debug containers do not affect pod health and are skipped.
+	for _, c := range containers {
+		if c.Type == ContainerTypeEphemeral {
+			continue
+		}
+		if c.State == ContainerStateWaiting && !isTransientWaitingReason(c.Reason) {
+			return fmt.Sprintf("%s in %s%s", describeContainer(c), c.Reason, lastExitDetail(c))
+		}
+		if c.State == ContainerStateTerminated && c.ExitCode != nil && *c.ExitCode != 0 {
+			return fmt.Sprintf("%s terminated: %s, exit code %d", describeContainer(c), emptyAs(c.Reason, "Error"), *c.ExitCode)
+		}
+	}
+	for _, c := range containers {
+		if c.Type != ContainerTypeEphemeral && c.RestartCount > 0 && c.LastTerminationExitCode != nil && *c.LastTerminationExitCode != 0 {
+			return fmt.Sprintf("%s restarted %d times, last terminated: %s, exit code %d",
+				describeContainer(c), c.RestartCount, emptyAs(c.LastTerminationReason, "Error"), *c.LastTerminationExitCode)
+		}
+	}
+	for _, c := range containers {
+		if c.Type == ContainerTypeApp && c.State == ContainerStateRunning && !c.Ready {
+			return fmt.Sprintf("%s is running but not ready", describeContainer(c))
+		}
+	}
+	return ""
+}
+
+// isTransientWaitingReason reports whether a waiting reason is part of normal startup.
+func isTransientWaitingReason(reason string) bool {
+	return reason == "" || reason == "ContainerCreating" || reason == "PodInitializing"
+}
+
+func describeContainer(c ContainerStatusSummary) string {
+	if c.Type != ContainerTypeApp {
+		return string(c.Type) + " container " + c.Name
+	}
+	return "container " + c.Name
+}
+
+// lastExitDetail appends the previous exit code, which explains crash loops.
+func lastExitDetail(c ContainerStatusSummary) string {
+	if c.LastTerminationExitCode == nil {
+		return ""
+	}
+	return fmt.Sprintf(", exit code %d", *c.LastTerminationExitCode)
+}
+
+func emptyAs(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
+
 // findPodParent finds the parent workload (Deployment/StatefulSet/DaemonSet/Job) of a pod.
 func (c *Client) findPodParent(ctx context.Context, clusterID, namespace string, pod *unstructured.Unstructured) *unstructured.Unstructured {
 	ownerRefs, found, _ := unstructured.NestedSlice(pod.Object, "metadata", "ownerReferences")
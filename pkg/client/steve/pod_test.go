@@ -3,10 +3,13 @@ package steve
 import (
 	"context"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
@@ -21,7 +24,7 @@ func newUnstructured(apiVersion, kind, namespace, name string) *unstructured.Uns
 
 func TestFindReplicaSetParent_OnlyDeploymentIsParent(t *testing.T) {
 	ctx := context.Background()
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	deployment := newUnstructured("apps/v1", "Deployment", "default", "my-deploy")
 	replicaSet := newUnstructured("apps/v1", "ReplicaSet", "default", "my-rs")
@@ -55,7 +58,7 @@ func TestFindReplicaSetParent_OnlyDeploymentIsParent(t *testing.T) {
 
 func TestFindPodParent_ReplicaSetOwnedByDeployment(t *testing.T) {
 	ctx := context.Background()
-	client := NewClient("https://example.com", "token", "", "", false)
+	client := NewClient("https://example.com", "token", "", "", false, 0)
 
 	deployment := newUnstructured("apps/v1", "Deployment", "default", "my-deploy")
 	replicaSet := newUnstructured("apps/v1", "ReplicaSet", "default", "my-rs")
@@ -76,3 +79,207 @@ func TestFindPodParent_ReplicaSetOwnedByDeployment(t *testing.T) {
 		t.Fatalf("expected Deployment/my-deploy, got %s/%s", parent.GetKind(), parent.GetName())
 	}
 }
+
+func TestNewestEvents(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := func(name string, last, eventTime time.Time) corev1.Event {
+		e := corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if !last.IsZero() {
+			e.LastTimestamp = metav1.NewTime(last)
+		}
+		if !eventTime.IsZero() {
+			e.EventTime = metav1.NewMicroTime(eventTime)
+		}
+		return e
+	}
+	newEvents := func() []corev1.Event {
+		return []corev1.Event{
+			event("oldest", base, time.Time{}),
+			event("newest", base.Add(3*time.Minute), time.Time{}),
+			event("micro", time.Time{}, base.Add(2*time.Minute)),
+			event("middle", base.Add(time.Minute), time.Time{}),
+		}
+	}
+
+	tests := []struct {
+		name string
+		max  int
+		want []string
+	}{
+		{name: "all", max: 0, want: []string{"newest", "micro", "middle", "oldest"}},
+		{name: "truncated", max: 2, want: []string{"newest", "micro"}},
+		{name: "max above count", max: 10, want: []string{"newest", "micro", "middle", "oldest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newestEvents(newEvents(), tt.max)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events, want %d", len(got), len(tt.want))
+			}
+			for i, e := range got {
+				if e.Name != tt.want[i] {
+					t.Errorf("event[%d] = %s, want %s", i, e.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDiagnosePod(t *testing.T) {
+	waiting := func(name, reason string, lastExit int32) corev1.ContainerStatus {
+		cs := corev1.ContainerStatus{
+			Name:  name,
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+		}
+		if lastExit != 0 {
+			cs.RestartCount = 4
+			cs.LastTerminationState = corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: lastExit},
+			}
+		}
+		return cs
+	}
+	running := func(name string, ready bool) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  name,
+			Ready: ready,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		pod  corev1.Pod
+		want string
+	}{
+		{
+			name: "healthy",
+			pod:  corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{running("app", true)}}},
+			want: "",
+		},
+		{
+			name: "crash loop",
+			pod:  corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{waiting("app", "CrashLoopBackOff", 1)}}},
+			want: "container app in CrashLoopBackOff, exit code 1",
+		},
+		{
+			name: "image pull",
+			pod:  corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{waiting("app", "ImagePullBackOff", 0)}}},
+			want: "container app in ImagePullBackOff",
+		},
+		{
+			name: "stuck in init",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{waiting("migrate", "CrashLoopBackOff", 2)},
+				ContainerStatuses:     []corev1.ContainerStatus{waiting("app", "PodInitializing", 0)},
+			}},
+			want: "init container migrate in CrashLoopBackOff, exit code 2",
+		},
+		{
+			name: "restarted after oom",
+			pod: corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:                 "app",
+				Ready:                true,
+				RestartCount:         3,
+				State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			}}}},
+			want: "container app restarted 3 times, last terminated: OOMKilled, exit code 137",
+		},
+		{
+			name: "not ready",
+			pod:  corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{running("app", false)}}},
+			want: "container app is running but not ready",
+		},
+		{
+			name: "unschedulable",
+			pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending, Conditions: []corev1.PodCondition{{
+				Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Message: "0/3 nodes are available",
+			}}}},
+			want: "pod cannot be scheduled: 0/3 nodes are available",
+		},
+		{
+			name: "evicted",
+			pod:  corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"}},
+			want: "pod failed: Evicted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiagnosePod(&tt.pod, SummarizeContainerStatuses(&tt.pod)); got != tt.want {
+				t.Errorf("DiagnosePod() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeContainerStatuses(t *testing.T) {
+	pod := &corev1.Pod{Status: corev1.PodStatus{
+		InitContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "init",
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+		}},
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name:                 "app",
+			RestartCount:         2,
+			State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+		}},
+	}}
+
+	got := SummarizeContainerStatuses(pod)
+	if len(got) != 2 {
+		t.Fatalf("got %d summaries, want 2", len(got))
+	}
+	if got[0].Type != ContainerTypeInit || got[0].State != ContainerStateTerminated || got[0].ExitCode == nil || *got[0].ExitCode != 0 {
+		t.Errorf("unexpected init summary: %+v", got[0])
+	}
+	app := got[1]
+	if app.Type != ContainerTypeApp || app.State != ContainerStateWaiting || app.Reason != "CrashLoopBackOff" || app.RestartCount != 2 {
+		t.Errorf("unexpected app summary: %+v", app)
+	}
+	if app.LastTerminationReason != "Error" || app.LastTerminationExitCode == nil || *app.LastTerminationExitCode != 1 {
+		t.Errorf("unexpected last termination: %+v", app)
+	}
+}
+
+func TestInspectPod_EphemeralContainer(t *testing.T) {
+	pod := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "app", Ready: true,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "debugger",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			}},
+		},
+	}
+	client := NewClient("https://example.com", "token", "", "", false, 0)
+	client.dynamicClients["cluster"] = fake.NewSimpleDynamicClient(scheme.Scheme, pod)
+	client.clientsets["cluster"] = k8sfake.NewSimpleClientset()
+
+	result, err := client.InspectPod(context.Background(), "cluster", "default", "web", nil)
+	if err != nil {
+		t.Fatalf("InspectPod() unexpected error: %v", err)
+	}
+	if _, ok := result.Logs["ephemeral:debugger"]; !ok {
+		t.Errorf("expected ephemeral container logs, got keys %v", result.Logs)
+	}
+	if len(result.Containers) != 2 || result.Containers[1].Type != ContainerTypeEphemeral {
+		t.Errorf("expected app and ephemeral container summaries, got %+v", result.Containers)
+	}
+	if result.LikelyProblem != "" {
+		t.Errorf("a failed debug container should not be reported as a problem, got %q", result.LikelyProblem)
+	}
+}
@@ -0,0 +1,32 @@
+package steve
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// clusterRateLimiter is the token bucket for one cluster. It reports a wait
+// that cannot finish before the context deadline as a timeout naming the cluster.
+type clusterRateLimiter struct {
+	flowcontrol.RateLimiter
+	clusterID string
+	qps       float32
+}
+
+func newClusterRateLimiter(clusterID string, qps float32, burst int) *clusterRateLimiter {
+	return &clusterRateLimiter{
+		RateLimiter: flowcontrol.NewTokenBucketRateLimiter(qps, max(burst, 1)),
+		clusterID:   clusterID,
+		qps:         qps,
+	}
+}
+
+// Wait blocks until a request may be sent or ctx ends.
+func (l *clusterRateLimiter) Wait(ctx context.Context) error {
+	if err := l.RateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("request to cluster %s %w waiting for the rate limit of %g requests/s: %w", l.clusterID, ErrTimeout, l.qps, err)
+	}
+	return nil
+}
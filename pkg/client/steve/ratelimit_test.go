@@ -0,0 +1,71 @@
+package steve
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateRestConfig_RateLimiter(t *testing.T) {
+	t.Run("shared per cluster", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+		client.RateLimitQPS, client.RateLimitBurst = 10, 20
+
+		first, err := client.getRestConfig("c-a")
+		if err != nil {
+			t.Fatalf("failed to get REST config: %v", err)
+		}
+		again, err := client.getRestConfig("c-a")
+		if err != nil {
+			t.Fatalf("failed to get REST config: %v", err)
+		}
+		other, err := client.getRestConfig("c-b")
+		if err != nil {
+			t.Fatalf("failed to get REST config: %v", err)
+		}
+
+		if first.RateLimiter == nil {
+			t.Fatal("expected a rate limiter")
+		}
+		if first.RateLimiter != again.RateLimiter {
+			t.Error("expected copies of a cluster's config to share its rate limiter")
+		}
+		if first.RateLimiter == other.RateLimiter {
+			t.Error("expected each cluster to have its own rate limiter")
+		}
+		if qps := first.RateLimiter.QPS(); qps != 10 {
+			t.Errorf("QPS = %g, want 10", qps)
+		}
+	})
+
+	t.Run("zero QPS keeps client-go defaults", func(t *testing.T) {
+		client := NewClient("https://example.com", "token", "", "", false, 0)
+		restConfig, err := client.getRestConfig("c-a")
+		if err != nil {
+			t.Fatalf("failed to get REST config: %v", err)
+		}
+		if restConfig.RateLimiter != nil {
+			t.Error("expected no rate limiter when RateLimitQPS is zero")
+		}
+	})
+}
+
+func TestClusterRateLimiter_Wait(t *testing.T) {
+	limiter := newClusterRateLimiter("c-abc", 1, 1)
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("expected the burst to allow the first request, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := limiter.Wait(ctx)
+	if err == nil {
+		t.Fatal("expected the throttled request to fail before the deadline")
+	}
+	if !errors.Is(err, ErrTimeout) || !strings.Contains(err.Error(), "request to cluster c-abc timed out waiting for the rate limit of 1 requests/s") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -2,6 +2,8 @@ package steve
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -14,7 +16,9 @@ func (c *Client) GetResource(ctx context.Context, clusterID, kind, namespace, na
 	if err != nil {
 		return nil, err
 	}
-	return ri.Get(ctx, name, metav1.GetOptions{})
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.Unstructured, error) {
+		return ri.Get(ctx, name, metav1.GetOptions{})
+	})
 }
 
 // ListResources lists Kubernetes resources matching the provided parameters.
@@ -35,27 +39,65 @@ func (c *Client) ListResources(ctx context.Context, clusterID, kind, namespace s
 		if opts.Limit > 0 {
 			listOpts.Limit = opts.Limit
 		}
+		listOpts.Continue = opts.Continue
 	}
-	return ri.List(ctx, listOpts)
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.UnstructuredList, error) {
+		return ri.List(ctx, listOpts)
+	})
 }
 
-// CreateResource creates a new Kubernetes resource.
-func (c *Client) CreateResource(ctx context.Context, clusterID string, resource *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+// CreateResource creates a new Kubernetes resource. With dryRun the server
+// validates and returns the object without persisting it.
+func (c *Client) CreateResource(ctx context.Context, clusterID string, resource *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error) {
 	kind := KindWithAPIVersion(resource.GetAPIVersion(), resource.GetKind())
 	ri, err := c.getResourceInterfaceByKind(clusterID, kind, resource.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
-	return ri.Create(ctx, resource, metav1.CreateOptions{})
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.Unstructured, error) {
+		return ri.Create(ctx, resource, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
+	})
 }
 
-// PatchResource patches an existing Kubernetes resource using JSON patch.
-func (c *Client) PatchResource(ctx context.Context, clusterID, kind, namespace, name string, patch []byte) (*unstructured.Unstructured, error) {
+// PatchResource patches an existing Kubernetes resource. patchType selects JSON
+// patch, JSON merge patch, or strategic merge patch semantics.
+func (c *Client) PatchResource(ctx context.Context, clusterID, kind, namespace, name string, patchType types.PatchType, patch []byte) (*unstructured.Unstructured, error) {
 	ri, err := c.getResourceInterfaceByKind(clusterID, kind, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ri.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.Unstructured, error) {
+		return ri.Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	})
+}
+
+// ApplyResource creates or updates a Kubernetes resource using server-side apply.
+func (c *Client) ApplyResource(ctx context.Context, clusterID string, resource *unstructured.Unstructured, opts ApplyOptions) (*unstructured.Unstructured, error) {
+	kind := KindWithAPIVersion(resource.GetAPIVersion(), resource.GetKind())
+	ri, err := c.getResourceInterfaceByKind(clusterID, kind, resource.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resource.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource: %w", err)
+	}
+	return withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (*unstructured.Unstructured, error) {
+		return ri.Patch(ctx, resource.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+			FieldManager: opts.FieldManager,
+			Force:        &opts.Force,
+			DryRun:       dryRunOption(opts.DryRun),
+		})
+	})
+}
+
+// dryRunOption returns the DryRun request option value for the given flag.
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
 }
 
 // DeleteResource deletes a Kubernetes resource.
@@ -64,5 +106,8 @@ func (c *Client) DeleteResource(ctx context.Context, clusterID, kind, namespace,
 	if err != nil {
 		return err
 	}
-	return ri.Delete(ctx, name, metav1.DeleteOptions{})
+	_, err = withRequestTimeout(c, ctx, clusterID, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, ri.Delete(ctx, name, metav1.DeleteOptions{})
+	})
+	return err
 }
@@ -1,9 +1,11 @@
 package steve
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -88,7 +90,14 @@ func findPreferredGroupVersion(groups *metav1.APIGroupList, apiGroup string) (st
 	return "", false
 }
 
+// resourceMatcher reports whether an API resource is the one a name refers to.
+type resourceMatcher func(r metav1.APIResource, name string) bool
+
 func findAPIResourceGVR(groupVersion, resourceName string, resources []metav1.APIResource) (schema.GroupVersionResource, bool) {
+	return findMatchingAPIResourceGVR(groupVersion, resourceName, resources, matchesResourceName)
+}
+
+func findMatchingAPIResourceGVR(groupVersion, resourceName string, resources []metav1.APIResource, match resourceMatcher) (schema.GroupVersionResource, bool) {
 	gv, err := schema.ParseGroupVersion(groupVersion)
 	if err != nil {
 		return schema.GroupVersionResource{}, false
@@ -97,7 +106,7 @@ func findAPIResourceGVR(groupVersion, resourceName string, resources []metav1.AP
 		if strings.Contains(r.Name, "/") {
 			continue
 		}
-		if matchesResourceName(r, resourceName) {
+		if match(r, resourceName) {
 			return schema.GroupVersionResource{
 				Group:    gv.Group,
 				Version:  gv.Version,
@@ -140,12 +149,37 @@ func (c *Client) getResourceInterfaceByKind(clusterID, kind, namespace string) (
 	return c.getResourceInterface(clusterID, gvr, namespace)
 }
 
+// resolveGVR resolves kind to a GVR, caching the result per cluster so repeated
+// lookups skip API discovery. Kinds discovery could not find are cached briefly;
+// transport and permission errors are not cached.
 func (c *Client) resolveGVR(clusterID, kind string) (schema.GroupVersionResource, error) {
 	original := strings.TrimSpace(kind)
 	if original == "" {
-		return schema.GroupVersionResource{}, fmt.Errorf("unsupported resource kind: %s", kind)
+		return schema.GroupVersionResource{}, fmt.Errorf("%w: %s", ErrUnsupportedKind, kind)
+	}
+
+	if entry, ok := c.lookupDiscovery(clusterID, original); ok {
+		return entry.gvr, entry.err
+	}
+	gvr, err := c.discoverGVR(clusterID, original)
+	if err != nil {
+		if isKindNotDiscovered(err) {
+			c.storeDiscovery(clusterID, original, schema.GroupVersionResource{}, err)
+		}
+		return schema.GroupVersionResource{}, err
 	}
+	c.storeDiscovery(clusterID, original, gvr, nil)
+	return gvr, nil
+}
+
+// isKindNotDiscovered reports whether err means the kind does not exist in the
+// cluster, as opposed to discovery itself failing.
+func isKindNotDiscovered(err error) bool {
+	return errors.Is(err, errKindNotDiscovered) || apierrors.IsNotFound(err)
+}
 
+// discoverGVR resolves a trimmed kind through the built-in aliases, falling back to API discovery.
+func (c *Client) discoverGVR(clusterID, original string) (schema.GroupVersionResource, error) {
 	if apiVersion, apiKind, ok := parseAPIVersionKind(original); ok {
 		normalizedKind := strings.ToLower(apiKind)
 		if gvr, ok := GetGVR(normalizedKind); ok && gvrMatchesAPIVersion(gvr, apiVersion) {
@@ -153,7 +187,7 @@ func (c *Client) resolveGVR(clusterID, kind string) (schema.GroupVersionResource
 		}
 		gvr, err := c.discoverGVRForAPIVersionKind(clusterID, apiVersion, normalizedKind)
 		if err != nil {
-			return schema.GroupVersionResource{}, fmt.Errorf("unsupported resource kind: %s (%w)", original, err)
+			return schema.GroupVersionResource{}, fmt.Errorf("%w: %s (%w)", ErrUnsupportedKind, original, err)
 		}
 		return gvr, nil
 	}
@@ -170,9 +204,19 @@ func (c *Client) resolveGVR(clusterID, kind string) (schema.GroupVersionResource
 		}
 	}
 
-	gvr, err := c.discoverGVRByKind(clusterID, normalized)
+	gvr, err := c.discoverGVRByKind(clusterID, normalized, matchesResourceName)
+	if isKindNotDiscovered(err) {
+		// "Proxys" for a kind whose plural is "proxies": retry without the
+		// trailing "s", but only accept a resource whose singular name or kind
+		// is what remains.
+		if singular, ok := strings.CutSuffix(normalized, "s"); ok && singular != "" {
+			if singularGVR, singularErr := c.discoverGVRByKind(clusterID, singular, matchesSingularName); !isKindNotDiscovered(singularErr) {
+				gvr, err = singularGVR, singularErr
+			}
+		}
+	}
 	if err != nil {
-		return schema.GroupVersionResource{}, fmt.Errorf("unsupported resource kind: %s (%w)", original, err)
+		return schema.GroupVersionResource{}, fmt.Errorf("%w: %s (%w)", ErrUnsupportedKind, original, err)
 	}
 	return gvr, nil
 }
@@ -191,11 +235,16 @@ func (c *Client) discoverGVRForAPIVersionKind(clusterID, apiVersion, kind string
 	if gvr, ok := findAPIResourceGVR(apiVersion, kind, resourceList.APIResources); ok {
 		return gvr, nil
 	}
+	if singular, ok := strings.CutSuffix(kind, "s"); ok && singular != "" {
+		if gvr, ok := findMatchingAPIResourceGVR(apiVersion, singular, resourceList.APIResources, matchesSingularName); ok {
+			return gvr, nil
+		}
+	}
 
-	return schema.GroupVersionResource{}, fmt.Errorf("resource kind %s not found in %s", kind, apiVersion)
+	return schema.GroupVersionResource{}, fmt.Errorf("%w: %s in %s", errKindNotDiscovered, kind, apiVersion)
 }
 
-func (c *Client) discoverGVRByKind(clusterID, kind string) (schema.GroupVersionResource, error) {
+func (c *Client) discoverGVRByKind(clusterID, kind string, match resourceMatcher) (schema.GroupVersionResource, error) {
 	clientset, err := c.getClientset(clusterID)
 	if err != nil {
 		return schema.GroupVersionResource{}, fmt.Errorf("failed to create clientset: %w", err)
@@ -203,7 +252,7 @@ func (c *Client) discoverGVRByKind(clusterID, kind string) (schema.GroupVersionR
 
 	var matches []schema.GroupVersionResource
 	if resourceList, err := clientset.Discovery().ServerResourcesForGroupVersion("v1"); err == nil {
-		if gvr, ok := findAPIResourceGVR("v1", kind, resourceList.APIResources); ok {
+		if gvr, ok := findMatchingAPIResourceGVR("v1", kind, resourceList.APIResources, match); ok {
 			matches = appendUniqueGVR(matches, gvr)
 		}
 	}
@@ -219,14 +268,14 @@ func (c *Client) discoverGVRByKind(clusterID, kind string) (schema.GroupVersionR
 		if err != nil {
 			continue
 		}
-		if gvr, ok := findAPIResourceGVR(groupVersion, kind, resourceList.APIResources); ok {
+		if gvr, ok := findMatchingAPIResourceGVR(groupVersion, kind, resourceList.APIResources, match); ok {
 			matches = appendUniqueGVR(matches, gvr)
 		}
 	}
 
 	switch len(matches) {
 	case 0:
-		return schema.GroupVersionResource{}, fmt.Errorf("resource kind %s not found", kind)
+		return schema.GroupVersionResource{}, fmt.Errorf("%w: %s", errKindNotDiscovered, kind)
 	case 1:
 		return matches[0], nil
 	default:
@@ -271,7 +320,8 @@ func (c *Client) discoverDottedGVR(clusterID, dottedKind string) (schema.GroupVe
 }
 
 // matchesResourceName checks if an API resource matches the given name
-// by comparing against its singular name, plural name, or lowercased Kind.
+// by comparing against its singular name, plural name, lowercased Kind, or
+// one of its short names.
 func matchesResourceName(r metav1.APIResource, name string) bool {
 	name = strings.ToLower(strings.TrimSpace(name))
 	singularName := strings.ToLower(strings.TrimSpace(r.SingularName))
@@ -280,5 +330,20 @@ func matchesResourceName(r metav1.APIResource, name string) bool {
 	if singularName == "" {
 		singularName = kindName
 	}
-	return singularName == name || resourceName == name || kindName == name
+	if singularName == name || resourceName == name || kindName == name {
+		return true
+	}
+	for _, shortName := range r.ShortNames {
+		if strings.ToLower(shortName) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesSingularName checks if an API resource's singular name or Kind is
+// the given name.
+func matchesSingularName(r metav1.APIResource, name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.ToLower(r.SingularName) == name || strings.ToLower(r.Kind) == name
 }
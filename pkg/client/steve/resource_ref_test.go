@@ -100,13 +100,17 @@ func TestMatchesResourceName(t *testing.T) {
 		Name:         "apps",
 		SingularName: "app",
 		Kind:         "App",
+		ShortNames:   []string{"ap"},
 	}
 
-	for _, name := range []string{"app", "apps", "App"} {
+	for _, name := range []string{"app", "apps", "App", "ap", "AP"} {
 		if !matchesResourceName(resource, name) {
 			t.Fatalf("matchesResourceName(%q) = false, want true", name)
 		}
 	}
+	if matchesResourceName(resource, "a") {
+		t.Fatal(`matchesResourceName("a") = true, want false`)
+	}
 }
 
 func TestFindAPIResourceGVRByAPIVersionAndKind(t *testing.T) {
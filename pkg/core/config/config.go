@@ -4,7 +4,9 @@ package config
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -25,6 +27,13 @@ type StaticConfig struct {
 	RancherAccessKey   string `mapstructure:"rancher_access_key"`
 	RancherSecretKey   string `mapstructure:"rancher_secret_key"`
 	RancherTLSInsecure bool   `mapstructure:"rancher_tls_insecure"`
+	// RancherRequestTimeout caps each Kubernetes API request; 0 disables it
+	RancherRequestTimeout time.Duration `mapstructure:"rancher_request_timeout"`
+	// RancherRateLimitQPS and RancherRateLimitBurst limit Kubernetes API requests per cluster
+	RancherRateLimitQPS   float64 `mapstructure:"rancher_rate_limit_qps"`
+	RancherRateLimitBurst int     `mapstructure:"rancher_rate_limit_burst"`
+	// RancherProjectCacheTTL is how long project lists and namespace projects are reused; 0 disables the caches
+	RancherProjectCacheTTL time.Duration `mapstructure:"rancher_project_cache_ttl"`
 
 	// Security configuration
 	ReadOnly           bool `mapstructure:"read_only"`
@@ -37,16 +46,33 @@ type StaticConfig struct {
 	EnableContainerExec         bool   `mapstructure:"enable_container_exec"`
 	MaxFileSize                 string `mapstructure:"max_file_size"`
 
+	// Credential configuration
+	EnableClusterKubeconfig bool `mapstructure:"enable_cluster_kubeconfig"`
+	// KubeconfigMaxTTL caps the token lifetime cluster_kubeconfig may request; 0 uses the tool's default cap
+	KubeconfigMaxTTL time.Duration `mapstructure:"kubeconfig_max_ttl"`
+
 	// Output configuration
 	ListOutput    string   `mapstructure:"list_output"`
 	OutputFilters []string `mapstructure:"output_filters"`
+	TimeDisplay   string   `mapstructure:"time_display"`
+	// TableAutoWidth sizes table columns to their widest value, up to TableMaxColumnWidth runes
+	TableAutoWidth      bool `mapstructure:"table_auto_width"`
+	TableMaxColumnWidth int  `mapstructure:"table_max_column_width"`
+
+	// AuditLog is the sink for audit records of tool calls: "stderr" or a file path; empty disables auditing
+	AuditLog string `mapstructure:"audit_log"`
 
 	// Toolset configuration
-	Toolsets      []string `mapstructure:"toolsets"`
-	EnabledTools  []string `mapstructure:"enabled_tools"`
-	DisabledTools []string `mapstructure:"disabled_tools"`
+	Toolsets []string `mapstructure:"toolsets"`
+	// DisabledToolsets are removed from Toolsets (or from all toolsets when Toolsets is empty)
+	DisabledToolsets []string `mapstructure:"disabled_toolsets"`
+	EnabledTools     []string `mapstructure:"enabled_tools"`
+	DisabledTools    []string `mapstructure:"disabled_tools"`
 }
 
+// KnownToolsets lists the toolset names accepted by toolsets and disabled_toolsets.
+var KnownToolsets = []string{"kubernetes", "rancher"}
+
 // Validate validates the configuration
 func (c *StaticConfig) Validate() error {
 	// Validate port
@@ -69,7 +95,42 @@ func (c *StaticConfig) Validate() error {
 		return fmt.Errorf("list_output must be one of: table, yaml, json, got %s", c.ListOutput)
 	}
 
+	// Validate time display
+	switch strings.ToLower(c.TimeDisplay) {
+	case "", "absolute", "relative":
+	default:
+		return fmt.Errorf("time_display must be one of: absolute, relative, got %s", c.TimeDisplay)
+	}
+
+	if c.KubeconfigMaxTTL < 0 {
+		return fmt.Errorf("kubeconfig_max_ttl must not be negative, got %s", c.KubeconfigMaxTTL)
+	}
+
+	if c.TableMaxColumnWidth < 0 {
+		return fmt.Errorf("table_max_column_width must not be negative, got %d", c.TableMaxColumnWidth)
+	}
+
+	// Validate toolset names
+	if err := validateToolsetNames("toolsets", c.Toolsets); err != nil {
+		return err
+	}
+	if err := validateToolsetNames("disabled_toolsets", c.DisabledToolsets); err != nil {
+		return err
+	}
+
 	// Validate Rancher configuration
+	if c.RancherRequestTimeout < 0 {
+		return fmt.Errorf("rancher_request_timeout must not be negative, got %s", c.RancherRequestTimeout)
+	}
+	if c.RancherRateLimitQPS < 0 {
+		return fmt.Errorf("rancher_rate_limit_qps must not be negative, got %g", c.RancherRateLimitQPS)
+	}
+	if c.RancherRateLimitQPS > 0 && c.RancherRateLimitBurst < 1 {
+		return fmt.Errorf("rancher_rate_limit_burst must be at least 1 when rancher_rate_limit_qps is set, got %d", c.RancherRateLimitBurst)
+	}
+	if c.RancherProjectCacheTTL < 0 {
+		return fmt.Errorf("rancher_project_cache_ttl must not be negative, got %s", c.RancherProjectCacheTTL)
+	}
 	if c.RancherServerURL != "" {
 		if !strings.HasPrefix(c.RancherServerURL, "http://") && !strings.HasPrefix(c.RancherServerURL, "https://") {
 			return fmt.Errorf("rancher_server_url must start with http:// or https://, got %s", c.RancherServerURL)
@@ -91,6 +152,16 @@ func (c *StaticConfig) Validate() error {
 	return nil
 }
 
+// validateToolsetNames rejects names that are not in KnownToolsets.
+func validateToolsetNames(key string, names []string) error {
+	for _, name := range names {
+		if !slices.Contains(KnownToolsets, name) {
+			return fmt.Errorf("%s contains unknown toolset %q, valid toolsets are: %s", key, name, strings.Join(KnownToolsets, ", "))
+		}
+	}
+	return nil
+}
+
 // LoadConfig loads configuration from file and environment variables using Viper
 // Priority: command-line flags > environment variables > config file > defaults
 func LoadConfig(configPath string) (*StaticConfig, error) {
@@ -1,6 +1,10 @@
 package config
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestValidate_Port(t *testing.T) {
 	t.Run("valid port", func(t *testing.T) {
@@ -71,6 +75,116 @@ func TestValidate_ListOutput(t *testing.T) {
 	})
 }
 
+func TestValidate_TimeDisplay(t *testing.T) {
+	for _, display := range []string{"", "absolute", "relative", "Relative"} {
+		c := &StaticConfig{Port: 8080, ListOutput: "json", TimeDisplay: display}
+		if err := c.Validate(); err != nil {
+			t.Errorf("time_display %q: expected valid, got: %v", display, err)
+		}
+	}
+	c := &StaticConfig{Port: 8080, ListOutput: "json", TimeDisplay: "ago"}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for invalid time_display")
+	}
+}
+
+func TestValidate_TableMaxColumnWidth(t *testing.T) {
+	for _, width := range []int{0, 40} {
+		c := &StaticConfig{Port: 8080, ListOutput: "json", TableMaxColumnWidth: width}
+		if err := c.Validate(); err != nil {
+			t.Errorf("table_max_column_width %d: expected valid, got: %v", width, err)
+		}
+	}
+	c := &StaticConfig{Port: 8080, ListOutput: "json", TableMaxColumnWidth: -1}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for negative table_max_column_width")
+	}
+}
+
+func TestValidate_RancherRequestTimeout(t *testing.T) {
+	for _, timeout := range []time.Duration{0, 30 * time.Second} {
+		c := &StaticConfig{Port: 8080, ListOutput: "json", RancherRequestTimeout: timeout}
+		if err := c.Validate(); err != nil {
+			t.Errorf("rancher_request_timeout %s: expected valid, got: %v", timeout, err)
+		}
+	}
+	c := &StaticConfig{Port: 8080, ListOutput: "json", RancherRequestTimeout: -time.Second}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for negative rancher_request_timeout")
+	}
+}
+
+func TestValidate_RancherProjectCacheTTL(t *testing.T) {
+	for _, ttl := range []time.Duration{0, 30 * time.Second} {
+		c := &StaticConfig{Port: 8080, ListOutput: "json", RancherProjectCacheTTL: ttl}
+		if err := c.Validate(); err != nil {
+			t.Errorf("rancher_project_cache_ttl %s: expected valid, got: %v", ttl, err)
+		}
+	}
+	c := &StaticConfig{Port: 8080, ListOutput: "json", RancherProjectCacheTTL: -time.Second}
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for negative rancher_project_cache_ttl")
+	}
+}
+
+func TestValidate_RancherRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		qps     float64
+		burst   int
+		wantErr string
+	}{
+		{name: "unset"},
+		{name: "valid", qps: 10, burst: 20},
+		{name: "negative qps", qps: -1, wantErr: "rancher_rate_limit_qps must not be negative"},
+		{name: "zero burst", qps: 5, wantErr: "rancher_rate_limit_burst must be at least 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &StaticConfig{Port: 8080, ListOutput: "json", RancherRateLimitQPS: tt.qps, RancherRateLimitBurst: tt.burst}
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_Toolsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		toolsets []string
+		disabled []string
+		wantErr  string
+	}{
+		{name: "default"},
+		{name: "known toolsets", toolsets: []string{"kubernetes", "rancher"}, disabled: []string{"rancher"}},
+		{name: "typo in toolsets", toolsets: []string{"kubernetes", "ranchr"}, wantErr: `toolsets contains unknown toolset "ranchr", valid toolsets are: kubernetes, rancher`},
+		{name: "typo in disabled_toolsets", disabled: []string{"k8s"}, wantErr: `disabled_toolsets contains unknown toolset "k8s"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &StaticConfig{Port: 8080, ListOutput: "json", Toolsets: tt.toolsets, DisabledToolsets: tt.disabled}
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected valid, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestValidate_RancherAuth(t *testing.T) {
 	t.Run("no rancher config is valid", func(t *testing.T) {
 		c := &StaticConfig{Port: 8080, ListOutput: "json"}
@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// AuditSinkStderr selects standard error as the audit log sink.
+const AuditSinkStderr = "stderr"
+
+// NewAuditLogger returns a JSON logger for audit records and the closer for
+// its sink. sink is AuditSinkStderr or a file path, which is created if
+// needed and appended to. Records are written without a level, so the
+// log level does not filter them, and stdio mode does not silence them.
+func NewAuditLogger(sink string) (zerolog.Logger, io.Closer, error) {
+	if sink == AuditSinkStderr {
+		return newAuditLogger(os.Stderr), nopCloser{}, nil
+	}
+
+	file, err := os.OpenFile(sink, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return zerolog.Nop(), nil, fmt.Errorf("failed to open audit log %s: %w", sink, err)
+	}
+	return newAuditLogger(file), file, nil
+}
+
+func newAuditLogger(output io.Writer) zerolog.Logger {
+	return zerolog.New(output).With().Timestamp().Str("log", "audit").Logger()
+}
+
+// nopCloser keeps stderr open when the audit logger is closed.
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
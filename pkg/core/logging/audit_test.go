@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewAuditLogger_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	for _, tool := range []string{"first", "second"} {
+		logger, closer, err := NewAuditLogger(path)
+		if err != nil {
+			t.Fatalf("failed to create audit logger: %v", err)
+		}
+		logger.Log().Str("tool", tool).Msg("tool call")
+		if err := closer.Close(); err != nil {
+			t.Fatalf("failed to close audit log: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"tool":"first"`) || !strings.Contains(lines[1], `"log":"audit"`) {
+		t.Errorf("expected two appended audit records, got:\n%s", data)
+	}
+}
+
+func TestNewAuditLogger_SurvivesStdioMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	SetStdioMode(true)
+	t.Cleanup(func() { stdioMode = false })
+
+	logger, closer, err := NewAuditLogger(path)
+	if err != nil {
+		t.Fatalf("failed to create audit logger: %v", err)
+	}
+	logger.Log().Msg("tool call")
+	_ = closer.Close()
+
+	if data, _ := os.ReadFile(path); len(data) == 0 {
+		t.Error("expected audit records to be written in stdio mode")
+	}
+}
+
+func TestNewAuditLogger_InvalidPath(t *testing.T) {
+	if _, _, err := NewAuditLogger(filepath.Join(t.TempDir(), "missing", "audit.log")); err == nil {
+		t.Fatal("expected an error for a path in a missing directory")
+	}
+}
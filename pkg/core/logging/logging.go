@@ -20,8 +20,8 @@ var (
 func SetStdioMode(enabled bool) {
 	stdioMode = enabled
 	if enabled {
-		// Disable all logging in stdio mode
-		zerolog.SetGlobalLevel(zerolog.Disabled)
+		// Disable all logging in stdio mode. The global level is left alone so
+		// an audit logger with its own sink keeps working.
 		log.Logger = zerolog.Nop()
 	}
 }
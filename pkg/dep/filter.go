@@ -0,0 +1,102 @@
+package dep
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// KindFilter selects the kinds shown in a dependency graph. Kinds are matched
+// case-insensitively against the object kind (e.g. "service" matches Service).
+type KindFilter struct {
+	// Include, when set, keeps only these kinds.
+	Include []string
+	// Exclude hides these kinds.
+	Exclude []string
+}
+
+// IsEmpty reports whether the filter keeps every kind.
+func (f KindFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+func (f KindFilter) keeps(kind string) bool {
+	if len(f.Include) > 0 && !containsKind(f.Include, kind) {
+		return false
+	}
+	return !containsKind(f.Exclude, kind)
+}
+
+func containsKind(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterKinds prunes a resolved graph to the kinds kept by filter. The root is
+// always kept. A hidden node's descendants are attached to its nearest kept
+// ancestor, carrying the relationships of the edge that reached them, so the
+// traversal still crosses hidden intermediate nodes. Nodes are copied; the
+// input result is not modified.
+func FilterKinds(result *Result, filter KindFilter, depsIsDependencies bool) *Result {
+	if result == nil || filter.IsEmpty() || result.NodeMap[result.RootUID] == nil {
+		return result
+	}
+
+	kept := func(uid types.UID) bool {
+		node := result.NodeMap[uid]
+		return node != nil && (uid == result.RootUID || filter.keeps(node.Kind))
+	}
+
+	nodeMap := NodeMap{}
+	for uid, node := range result.NodeMap {
+		if !kept(uid) {
+			continue
+		}
+		deps := map[types.UID]RelationshipSet{}
+		collectKeptDeps(result.NodeMap, node, depsIsDependencies, kept, deps, map[types.UID]bool{uid: true})
+		delete(deps, uid)
+
+		clone := *node
+		if depsIsDependencies {
+			clone.Dependencies = deps
+		} else {
+			clone.Dependents = deps
+		}
+		nodeMap[uid] = &clone
+	}
+
+	return &Result{
+		NodeMap:         nodeMap,
+		RootUID:         result.RootUID,
+		MaxDepthReached: result.MaxDepthReached,
+	}
+}
+
+// collectKeptDeps adds the kept deps of node to deps, descending through
+// hidden nodes. seen guards against cycles among hidden nodes.
+func collectKeptDeps(nodeMap NodeMap, node *Node, depsIsDependencies bool, kept func(types.UID) bool, deps map[types.UID]RelationshipSet, seen map[types.UID]bool) {
+	for uid, rels := range node.GetDeps(depsIsDependencies) {
+		child, ok := nodeMap[uid]
+		if !ok {
+			continue
+		}
+		if kept(uid) {
+			if _, exists := deps[uid]; !exists {
+				deps[uid] = RelationshipSet{}
+			}
+			for r := range rels {
+				deps[uid][r] = struct{}{}
+			}
+			continue
+		}
+		if seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		collectKeptDeps(nodeMap, child, depsIsDependencies, kept, deps, seen)
+	}
+}
@@ -0,0 +1,125 @@
+package dep
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// newFilterTestResult builds Deployment -> ReplicaSet -> Pod -> {ConfigMap, Node}
+// in the dependents direction.
+func newFilterTestResult() *Result {
+	nodes := NodeMap{}
+	for _, n := range []struct {
+		uid  types.UID
+		kind string
+	}{
+		{"deploy", "Deployment"},
+		{"rs", "ReplicaSet"},
+		{"pod", "Pod"},
+		{"cm", "ConfigMap"},
+		{"node", "Node"},
+	} {
+		u := &unstructured.Unstructured{}
+		u.SetName(string(n.uid))
+		nodes[n.uid] = &Node{
+			Unstructured: u,
+			UID:          n.uid,
+			Kind:         n.kind,
+			Name:         string(n.uid),
+			Dependencies: map[types.UID]RelationshipSet{},
+			Dependents:   map[types.UID]RelationshipSet{},
+		}
+	}
+	nodes["deploy"].AddDependent("rs", RelationshipControllerRef)
+	nodes["rs"].AddDependent("pod", RelationshipControllerRef)
+	nodes["pod"].AddDependent("cm", RelationshipPodVolume)
+	nodes["pod"].AddDependent("node", RelationshipPodNode)
+	return &Result{NodeMap: nodes, RootUID: "deploy"}
+}
+
+func dependentUIDs(n *Node) []string {
+	uids := make([]string, 0, len(n.Dependents))
+	for uid := range n.Dependents {
+		uids = append(uids, string(uid))
+	}
+	sort.Strings(uids)
+	return uids
+}
+
+func TestFilterKinds(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter KindFilter
+		// want maps each kept node to its sorted dependents.
+		want map[types.UID][]string
+	}{
+		{
+			name:   "exclude reattaches descendants",
+			filter: KindFilter{Exclude: []string{"replicaset"}},
+			want: map[types.UID][]string{
+				"deploy": {"pod"},
+				"pod":    {"cm", "node"},
+				"cm":     {},
+				"node":   {},
+			},
+		},
+		{
+			name:   "include crosses hidden intermediates",
+			filter: KindFilter{Include: []string{"ConfigMap"}},
+			want: map[types.UID][]string{
+				"deploy": {"cm"},
+				"cm":     {},
+			},
+		},
+		{
+			name:   "root is kept even when excluded",
+			filter: KindFilter{Exclude: []string{"Deployment", "ReplicaSet", "Pod"}},
+			want: map[types.UID][]string{
+				"deploy": {"cm", "node"},
+				"cm":     {},
+				"node":   {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := newFilterTestResult()
+			got := FilterKinds(input, tt.filter, false)
+
+			if len(got.NodeMap) != len(tt.want) {
+				t.Fatalf("kept %d nodes, want %d", len(got.NodeMap), len(tt.want))
+			}
+			for uid, wantDeps := range tt.want {
+				node, ok := got.NodeMap[uid]
+				if !ok {
+					t.Fatalf("node %s missing", uid)
+				}
+				if deps := dependentUIDs(node); !reflect.DeepEqual(deps, wantDeps) {
+					t.Errorf("dependents of %s = %v, want %v", uid, deps, wantDeps)
+				}
+			}
+			if deps := dependentUIDs(input.NodeMap["deploy"]); !reflect.DeepEqual(deps, []string{"rs"}) {
+				t.Errorf("input graph was modified: deploy dependents = %v", deps)
+			}
+		})
+	}
+}
+
+func TestFilterKinds_CarriesRelationshipsOfReachingEdge(t *testing.T) {
+	got := FilterKinds(newFilterTestResult(), KindFilter{Include: []string{"node"}}, false)
+	rels := got.NodeMap["deploy"].Dependents["node"].List()
+	if !reflect.DeepEqual(rels, []string{string(RelationshipPodNode)}) {
+		t.Errorf("relationships = %v, want [%s]", rels, RelationshipPodNode)
+	}
+}
+
+func TestFilterKinds_EmptyFilterReturnsInput(t *testing.T) {
+	input := newFilterTestResult()
+	if got := FilterKinds(input, KindFilter{}, false); got != input {
+		t.Error("expected the input result to be returned unchanged")
+	}
+}
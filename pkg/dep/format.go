@@ -13,7 +13,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// cycleMarker annotates a tree node that is one of its own ancestors.
+const cycleMarker = "(cycle)"
+
 // FormatTree renders the dependency result as a kube-lineage-style tree string.
+// Nodes that close a cycle are marked "(cycle)" and not expanded again, and a
+// trailing note says when the traversal was cut short by maxDepth.
 func FormatTree(result *Result, depsIsDependencies bool) string {
 	if result == nil || len(result.NodeMap) == 0 {
 		return "No dependency data found"
@@ -28,13 +33,19 @@ func FormatTree(result *Result, depsIsDependencies bool) string {
 	fmt.Fprintf(&b, "%-12s %-50s %-8s %-12s %-6s %s\n",
 		"NAMESPACE", "NAME", "READY", "STATUS", "AGE", "RELATIONSHIPS")
 
-	printTreeNode(&b, result.NodeMap, rootNode, result.RootUID, depsIsDependencies, "", true, true, nil, map[types.UID]bool{})
+	printTreeNode(&b, result.NodeMap, rootNode, result.RootUID, depsIsDependencies, "", true, true, nil, map[types.UID]bool{}, map[types.UID]bool{})
+
+	if result.MaxDepthReached {
+		b.WriteString("\n# maxDepth reached: resources beyond the depth limit are not shown\n")
+	}
 
 	return b.String()
 }
 
 // printTreeNode recursively prints a node and its children in tree format.
-func printTreeNode(b *strings.Builder, nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencies bool, prefix string, isRoot, isLast bool, rels RelationshipSet, visited map[types.UID]bool) {
+// ancestors holds the nodes on the path from the root; visited holds every node
+// already expanded anywhere in the tree.
+func printTreeNode(b *strings.Builder, nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencies bool, prefix string, isRoot, isLast bool, rels RelationshipSet, visited, ancestors map[types.UID]bool) {
 	if node == nil {
 		return
 	}
@@ -49,6 +60,10 @@ func printTreeNode(b *strings.Builder, nodeMap NodeMap, node *Node, uid types.UI
 	if len(rels) > 0 {
 		relStr = fmt.Sprintf("[%s]", strings.Join(rels.List(), " "))
 	}
+	cycle := ancestors[uid]
+	if cycle {
+		relStr += " " + cycleMarker
+	}
 
 	connector := ""
 	if !isRoot {
@@ -69,11 +84,13 @@ func printTreeNode(b *strings.Builder, nodeMap NodeMap, node *Node, uid types.UI
 		relStr,
 	)
 
-	// Stop recursion on cycle
-	if visited[uid] {
+	// Stop recursion on cycles and on nodes already expanded elsewhere
+	if cycle || visited[uid] {
 		return
 	}
 	visited[uid] = true
+	ancestors[uid] = true
+	defer delete(ancestors, uid)
 
 	deps := node.GetDeps(depsIsDependencies)
 	children := sortedChildren(nodeMap, deps, uid)
@@ -87,48 +104,65 @@ func printTreeNode(b *strings.Builder, nodeMap NodeMap, node *Node, uid types.UI
 				childPrefix += "│   "
 			}
 		}
-		printTreeNode(b, nodeMap, child, child.UID, depsIsDependencies, childPrefix, false, childIsLast, deps[child.UID], visited)
+		printTreeNode(b, nodeMap, child, child.UID, depsIsDependencies, childPrefix, false, childIsLast, deps[child.UID], visited, ancestors)
 	}
 }
 
 // JSONNode represents a node in JSON output format.
 type JSONNode struct {
-	Kind          string      `json:"kind"`
-	Namespace     string      `json:"namespace,omitempty"`
-	Name          string      `json:"name"`
-	Ready         string      `json:"ready,omitempty"`
-	Status        string      `json:"status,omitempty"`
-	Age           string      `json:"age,omitempty"`
-	Relationships []string    `json:"relationships,omitempty"`
-	Children      []*JSONNode `json:"children,omitempty"`
+	Kind          string   `json:"kind"`
+	Namespace     string   `json:"namespace,omitempty"`
+	Name          string   `json:"name"`
+	Ready         string   `json:"ready,omitempty"`
+	Status        string   `json:"status,omitempty"`
+	Age           string   `json:"age,omitempty"`
+	Relationships []string `json:"relationships,omitempty"`
+	// Cycle marks a node that is one of its own ancestors; it is not expanded again.
+	Cycle    bool        `json:"cycle,omitempty"`
+	Children []*JSONNode `json:"children,omitempty"`
+}
+
+// JSONResult is the top-level JSON output: the tree and how it was traversed.
+type JSONResult struct {
+	// Root is nil when there is nothing to show.
+	Root *JSONNode `json:"root"`
+	// MaxDepthReached reports that traversal was cut short by maxDepth.
+	MaxDepthReached bool `json:"maxDepthReached"`
 }
 
 // FormatJSON renders the dependency result as a nested JSON structure.
 func FormatJSON(result *Result, depsIsDependencies bool) (string, error) {
-	if result == nil || len(result.NodeMap) == 0 {
-		return "[]", nil
-	}
-
-	rootNode := result.NodeMap[result.RootUID]
-	if rootNode == nil {
-		return "[]", nil
+	out := JSONResult{}
+	if result != nil {
+		out.MaxDepthReached = result.MaxDepthReached
+		if rootNode := result.NodeMap[result.RootUID]; rootNode != nil {
+			out.Root = buildJSONTree(result.NodeMap, rootNode, result.RootUID, depsIsDependencies, nil, map[types.UID]bool{}, map[types.UID]bool{})
+		}
 	}
 
-	jsonTree := buildJSONTree(result.NodeMap, rootNode, result.RootUID, depsIsDependencies, nil, map[types.UID]bool{})
-
-	data, err := json.MarshalIndent(jsonTree, "", "  ")
+	data, err := json.MarshalIndent(out, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 	return string(data), nil
 }
 
-// buildJSONTree recursively builds the JSON tree structure.
-func buildJSONTree(nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencies bool, rels RelationshipSet, visited map[types.UID]bool) *JSONNode {
+// buildJSONTree recursively builds the JSON tree structure. ancestors and
+// visited play the same roles as in printTreeNode.
+func buildJSONTree(nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencies bool, rels RelationshipSet, visited, ancestors map[types.UID]bool) *JSONNode {
 	if node == nil {
 		return nil
 	}
 
+	if ancestors[uid] {
+		return &JSONNode{
+			Kind:          node.Kind,
+			Namespace:     node.Namespace,
+			Name:          node.Name,
+			Relationships: rels.List(),
+			Cycle:         true,
+		}
+	}
 	if visited[uid] {
 		return &JSONNode{
 			Kind:      node.Kind,
@@ -137,6 +171,8 @@ func buildJSONTree(nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencie
 		}
 	}
 	visited[uid] = true
+	ancestors[uid] = true
+	defer delete(ancestors, uid)
 
 	jn := &JSONNode{
 		Kind:      node.Kind,
@@ -153,7 +189,7 @@ func buildJSONTree(nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencie
 
 	deps := node.GetDeps(depsIsDependencies)
 	for _, child := range sortedChildren(nodeMap, deps, uid) {
-		if childJSON := buildJSONTree(nodeMap, child, child.UID, depsIsDependencies, deps[child.UID], visited); childJSON != nil {
+		if childJSON := buildJSONTree(nodeMap, child, child.UID, depsIsDependencies, deps[child.UID], visited, ancestors); childJSON != nil {
 			jn.Children = append(jn.Children, childJSON)
 		}
 	}
@@ -163,14 +199,23 @@ func buildJSONTree(nodeMap NodeMap, node *Node, uid types.UID, depsIsDependencie
 
 // getNodeReady extracts the ready status from a node.
 func getNodeReady(n *Node) string {
-	content := n.UnstructuredContent()
+	return ResourceReady(n.Kind, n.UnstructuredContent())
+}
 
+// ResourceReady returns the ready column of an object of kind: ready/total
+// replicas or containers for workloads and pods, else its Ready condition
+// status, or "-" when there is none.
+func ResourceReady(kind string, content map[string]interface{}) string {
 	// Kind-specific ready display
-	switch n.Kind {
+	switch kind {
 	case "Deployment", "ReplicaSet", "StatefulSet":
 		replicas := getNestedInt64(content, "status", "replicas")
 		readyReplicas := getNestedInt64(content, "status", "readyReplicas")
 		return fmt.Sprintf("%d/%d", readyReplicas, replicas)
+	case "DaemonSet":
+		desired := getNestedInt64(content, "status", "desiredNumberScheduled")
+		ready := getNestedInt64(content, "status", "numberReady")
+		return fmt.Sprintf("%d/%d", ready, desired)
 	case "Pod":
 		containerStatuses, found, _ := unstructuredv1.NestedSlice(content, "status", "containerStatuses")
 		if found {
@@ -210,9 +255,13 @@ func getNodeReady(n *Node) string {
 
 // getNodeStatus extracts the status string from a node.
 func getNodeStatus(n *Node) string {
-	content := n.UnstructuredContent()
+	return ResourceStatus(n.Kind, n.UnstructuredContent())
+}
 
-	switch n.Kind {
+// ResourceStatus returns the status column of an object of kind: the phase
+// of a pod or the Ready reason of a node, else "".
+func ResourceStatus(kind string, content map[string]interface{}) string {
+	switch kind {
 	case "Pod":
 		phase, found, _ := unstructuredv1.NestedString(content, "status", "phase")
 		if found {
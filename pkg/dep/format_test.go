@@ -1,6 +1,8 @@
 package dep
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -102,6 +104,21 @@ func TestGetNodeReady(t *testing.T) {
 		}
 	})
 
+	t.Run("daemonset with scheduled pods", func(t *testing.T) {
+		content := map[string]interface{}{
+			"status": map[string]interface{}{
+				"desiredNumberScheduled": int64(4),
+				"numberReady":            int64(3),
+			},
+		}
+		u := &unstructured.Unstructured{}
+		u.SetUnstructuredContent(content)
+		node := &Node{Unstructured: u, Kind: "DaemonSet"}
+		if got := getNodeReady(node); got != "3/4" {
+			t.Fatalf("expected '3/4', got %q", got)
+		}
+	})
+
 	t.Run("ready condition fallback", func(t *testing.T) {
 		content := map[string]interface{}{
 			"status": map[string]interface{}{
@@ -291,8 +308,8 @@ func TestFormatJSON(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if got != "[]" {
-			t.Fatalf("expected '[]', got %q", got)
+		if want := "{\n  \"root\": null,\n  \"maxDepthReached\": false\n}"; got != want {
+			t.Fatalf("expected %q, got %q", want, got)
 		}
 	})
 
@@ -302,8 +319,8 @@ func TestFormatJSON(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if got != "[]" {
-			t.Fatalf("expected '[]', got %q", got)
+		if want := "{\n  \"root\": null,\n  \"maxDepthReached\": false\n}"; got != want {
+			t.Fatalf("expected %q, got %q", want, got)
 		}
 	})
 
@@ -346,3 +363,74 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+// newCycleResult builds a -> b -> a, the shape of an ownerReference loop.
+func newCycleResult() *Result {
+	newTestNode := func(uid types.UID, name string) *Node {
+		u := &unstructured.Unstructured{}
+		u.SetName(name)
+		u.SetNamespace("default")
+		return &Node{
+			Unstructured: u,
+			UID:          uid,
+			Kind:         "ConfigMap",
+			Namespace:    "default",
+			Name:         name,
+			Dependencies: map[types.UID]RelationshipSet{},
+			Dependents:   map[types.UID]RelationshipSet{},
+		}
+	}
+	a, b := newTestNode("a", "cm-a"), newTestNode("b", "cm-b")
+	a.AddDependent("b", RelationshipOwnerRef)
+	b.AddDependent("a", RelationshipOwnerRef)
+	return &Result{NodeMap: NodeMap{"a": a, "b": b}, RootUID: "a"}
+}
+
+func TestFormatTree_MarksCycles(t *testing.T) {
+	result := newCycleResult()
+	result.MaxDepthReached = true
+
+	got := FormatTree(result, false)
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	// header, cm-a, cm-b, cm-a (cycle), blank, note
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines, got %d:\n%s", len(lines), got)
+	}
+	if !strings.Contains(lines[3], "cm-a") || !strings.HasSuffix(lines[3], cycleMarker) {
+		t.Errorf("expected the repeated root to be marked as a cycle, got %q", lines[3])
+	}
+	if strings.Contains(lines[1], cycleMarker) || strings.Contains(lines[2], cycleMarker) {
+		t.Errorf("only the repeated node should be marked:\n%s", got)
+	}
+	if !strings.Contains(lines[5], "maxDepth reached") {
+		t.Errorf("expected a maxDepth note, got %q", lines[5])
+	}
+}
+
+func TestFormatJSON_MarksCycles(t *testing.T) {
+	result := newCycleResult()
+	result.MaxDepthReached = true
+
+	got, err := FormatJSON(result, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var out JSONResult
+	if err := json.Unmarshal([]byte(got), &out); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if !out.MaxDepthReached {
+		t.Errorf("expected maxDepthReached in the result, got %s", got)
+	}
+	root := out.Root
+	if root == nil || root.Cycle {
+		t.Fatalf("expected a root that is not marked as a cycle, got %s", got)
+	}
+	if len(root.Children) != 1 || len(root.Children[0].Children) != 1 {
+		t.Fatalf("expected a -> b -> a, got %s", got)
+	}
+	repeated := root.Children[0].Children[0]
+	if repeated.Name != "cm-a" || !repeated.Cycle || len(repeated.Children) != 0 {
+		t.Errorf("expected cm-a marked as a cycle without children, got %+v", repeated)
+	}
+}
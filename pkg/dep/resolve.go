@@ -55,6 +55,9 @@ type resourceKindSpec struct {
 type Result struct {
 	NodeMap NodeMap
 	RootUID types.UID
+	// MaxDepthReached reports that traversal stopped at MaxDepth while some
+	// nodes still had relationships leading outside the graph.
+	MaxDepthReached bool
 }
 
 // ResolveOptions controls the scan scope and traversal budget.
@@ -63,6 +66,9 @@ type ResolveOptions struct {
 	MaxDepth          int
 	ScanNamespace     string
 	MaxScannedObjects int
+	// OwnerReferencesOnly follows ownerReference edges alone, skipping the
+	// label selector, volume, RBAC, event and other semantic relationships.
+	OwnerReferencesOnly bool
 }
 
 // Resolve resolves the dependency/dependent graph for a Kubernetes resource.
@@ -97,16 +103,19 @@ func Resolve(ctx context.Context, client steve.ResourceReader, clusterID, rootKi
 	globalMapByUID, globalMapByKey := buildNodeMaps(allObjects)
 
 	populateOwnerReferences(globalMapByUID)
-	populateSemanticRelationships(globalMapByUID, globalMapByKey)
+	if !options.OwnerReferencesOnly {
+		populateSemanticRelationships(globalMapByUID, globalMapByKey)
+	}
 
-	nodeMap, err := traverseGraph(root.GetUID(), options.Direction, options.MaxDepth, globalMapByUID)
+	nodeMap, maxDepthReached, err := traverseGraph(root.GetUID(), options.Direction, options.MaxDepth, globalMapByUID)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Result{
-		NodeMap: nodeMap,
-		RootUID: root.GetUID(),
+		NodeMap:         nodeMap,
+		RootUID:         root.GetUID(),
+		MaxDepthReached: maxDepthReached,
 	}, nil
 }
 
@@ -179,11 +188,12 @@ func populateSemanticRelationships(byUID map[types.UID]*Node, byKey map[ObjectRe
 }
 
 // traverseGraph performs a breadth-first traversal starting from rootUID and
-// returns the visited NodeMap. It honors maxDepth when positive.
-func traverseGraph(rootUID types.UID, direction string, maxDepth int, globalMapByUID map[types.UID]*Node) (NodeMap, error) {
+// returns the visited NodeMap. It honors maxDepth when positive and reports
+// whether the limit left any relationships unexplored.
+func traverseGraph(rootUID types.UID, direction string, maxDepth int, globalMapByUID map[types.UID]*Node) (NodeMap, bool, error) {
 	rootNode := globalMapByUID[rootUID]
 	if rootNode == nil {
-		return nil, fmt.Errorf("root resource not found in graph")
+		return nil, false, fmt.Errorf("root resource not found in graph")
 	}
 
 	nodeMap := NodeMap{rootUID: rootNode}
@@ -201,7 +211,7 @@ func traverseGraph(rootUID types.UID, direction string, maxDepth int, globalMapB
 		if uid == "" {
 			depth++
 			if maxDepth > 0 && depth >= uint(maxDepth) {
-				break
+				return nodeMap, hasUnexploredDeps(uidQueue, visited, nodeMap, depsIsDependencies), nil
 			}
 			uidQueue = append(uidQueue, "") // next depth sentinel
 			continue
@@ -235,7 +245,27 @@ func traverseGraph(rootUID types.UID, direction string, maxDepth int, globalMapB
 		}
 	}
 
-	return nodeMap, nil
+	return nodeMap, false, nil
+}
+
+// hasUnexploredDeps reports whether any node left unexpanded in the queue has
+// relationships to nodes outside nodeMap.
+func hasUnexploredDeps(uidQueue []types.UID, visited map[types.UID]struct{}, nodeMap NodeMap, depsIsDependencies bool) bool {
+	for _, uid := range uidQueue {
+		if _, ok := visited[uid]; ok || uid == "" {
+			continue
+		}
+		node := nodeMap[uid]
+		if node == nil {
+			continue
+		}
+		for depUID := range node.GetDeps(depsIsDependencies) {
+			if _, ok := nodeMap[depUID]; !ok {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // applyRelationships applies the extracted relationship map to the node and global maps.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -79,6 +80,50 @@ func TestResolve_DefaultsToDependents(t *testing.T) {
 	}
 }
 
+func TestResolve_OwnerReferencesOnly(t *testing.T) {
+	deployment := newResolveTestObject("apps/v1", "Deployment", "default", "web", "deploy-uid")
+	replicaSet := newResolveTestObject("apps/v1", "ReplicaSet", "default", "web-abc", "rs-uid")
+	replicaSet.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "deploy-uid"}})
+	pod := newResolveTestObject("v1", "Pod", "default", "web-abc-1", "pod-uid")
+	pod.SetLabels(map[string]string{"app": "web"})
+	pod.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", UID: "rs-uid"}})
+	service := newResolveTestObject("v1", "Service", "default", "web", "svc-uid")
+	service.Object["spec"] = map[string]interface{}{"selector": map[string]interface{}{"app": "web"}}
+
+	reader := newResolveTestReader(deployment)
+	reader.listResponses["replicaset"] = &unstructured.UnstructuredList{Items: []unstructured.Unstructured{replicaSet}}
+	reader.listResponses["pod"] = &unstructured.UnstructuredList{Items: []unstructured.Unstructured{pod}}
+	reader.listResponses["service"] = &unstructured.UnstructuredList{Items: []unstructured.Unstructured{service}}
+
+	tests := []struct {
+		name        string
+		ownersOnly  bool
+		wantService bool
+	}{
+		{name: "all relationships", ownersOnly: false, wantService: true},
+		{name: "owner references only", ownersOnly: true, wantService: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Resolve(context.Background(), reader, "c1", "deployment", "default", "web", ResolveOptions{
+				MaxDepth:            10,
+				OwnerReferencesOnly: tt.ownersOnly,
+			})
+			if err != nil {
+				t.Fatalf("Resolve() returned unexpected error: %v", err)
+			}
+			for _, uid := range []types.UID{"deploy-uid", "rs-uid", "pod-uid"} {
+				if _, ok := result.NodeMap[uid]; !ok {
+					t.Errorf("expected %s in the tree", uid)
+				}
+			}
+			if _, ok := result.NodeMap["svc-uid"]; ok != tt.wantService {
+				t.Errorf("service in tree = %v, want %v", ok, tt.wantService)
+			}
+		})
+	}
+}
+
 type resolveTestReader struct {
 	root                *unstructured.Unstructured
 	listResponses       map[string]*unstructured.UnstructuredList
@@ -108,7 +153,7 @@ func (r *resolveTestReader) ListResources(_ context.Context, _ string, kind, nam
 	return &unstructured.UnstructuredList{}, nil
 }
 
-func (r *resolveTestReader) GetEvents(context.Context, string, string, string, string) ([]corev1.Event, error) {
+func (r *resolveTestReader) GetEvents(context.Context, string, string, string, string, *steve.ListOptions) ([]corev1.Event, error) {
 	return nil, nil
 }
 
@@ -125,3 +170,42 @@ func newResolveTestObject(apiVersion, kind, namespace, name string, uid types.UI
 		},
 	}
 }
+
+func TestTraverseGraph_ReportsMaxDepthReached(t *testing.T) {
+	chain := func() map[types.UID]*Node {
+		nodes := map[types.UID]*Node{}
+		for _, uid := range []types.UID{"a", "b", "c"} {
+			obj := newResolveTestObject("v1", "ConfigMap", "default", string(uid), uid)
+			nodes[uid] = newNode(&obj)
+		}
+		nodes["a"].AddDependent("b", RelationshipOwnerRef)
+		nodes["b"].AddDependent("c", RelationshipOwnerRef)
+		return nodes
+	}
+
+	tests := []struct {
+		name      string
+		maxDepth  int
+		wantNodes int
+		wantHit   bool
+	}{
+		{"unlimited", 0, 3, false},
+		{"limit covers graph", 3, 3, false},
+		{"limit exactly at leaves", 2, 3, false},
+		{"limit cuts graph", 1, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeMap, hit, err := traverseGraph("a", "dependents", tt.maxDepth, chain())
+			if err != nil {
+				t.Fatalf("traverseGraph() error = %v", err)
+			}
+			if len(nodeMap) != tt.wantNodes {
+				t.Errorf("len(nodeMap) = %d, want %d", len(nodeMap), tt.wantNodes)
+			}
+			if hit != tt.wantHit {
+				t.Errorf("maxDepthReached = %v, want %v", hit, tt.wantHit)
+			}
+		})
+	}
+}
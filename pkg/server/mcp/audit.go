@@ -0,0 +1,82 @@
+package mcp
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// auditedParams are the parameters whose values identify the target of a tool
+// call and are recorded in audit records. Other parameters, which can carry
+// patch bodies, manifests, file content or commands, are recorded by name only.
+var auditedParams = []string{
+	paramutil.ParamCluster,
+	paramutil.ParamNamespace,
+	paramutil.ParamKind,
+	paramutil.ParamAPIVersion,
+	paramutil.ParamName,
+}
+
+// auditRecord describes one tool call. It is captured before the handler runs,
+// so parameters injected by configureTool are not recorded.
+type auditRecord struct {
+	tool        string
+	write       bool
+	destructive bool
+	targets     map[string]string
+	names       []string
+	args        []string
+	start       time.Time
+}
+
+func newAuditRecord(tool toolset.ServerTool, arguments map[string]interface{}) *auditRecord {
+	record := &auditRecord{
+		tool:        tool.Tool.Name,
+		write:       !isReadOnlyTool(tool),
+		destructive: tool.Annotations.DestructiveHint != nil && *tool.Annotations.DestructiveHint,
+		targets:     make(map[string]string),
+		args:        toolArgumentKeys(arguments),
+		start:       time.Now(),
+	}
+	for _, key := range auditedParams {
+		if value, ok := arguments[key].(string); ok && value != "" {
+			record.targets[key] = value
+		}
+	}
+	if names, ok := arguments[paramutil.ParamNames].([]interface{}); ok {
+		for _, name := range names {
+			if s, ok := name.(string); ok {
+				record.names = append(record.names, s)
+			}
+		}
+	}
+	return record
+}
+
+// log writes the record with the outcome of the call.
+func (r *auditRecord) log(logger *zerolog.Logger, err error) {
+	event := logger.Log().
+		Str("tool", r.tool).
+		Bool("write", r.write).
+		Bool("destructive", r.destructive)
+	for _, key := range auditedParams {
+		if value, ok := r.targets[key]; ok {
+			event = event.Str(key, value)
+		}
+	}
+	if len(r.names) > 0 {
+		event = event.Str(paramutil.ParamNames, strings.Join(r.names, ","))
+	}
+	event = event.
+		Strs("args", r.args).
+		Bool("success", err == nil).
+		Dur("duration", time.Since(r.start))
+	if err != nil {
+		event = event.Str("error", err.Error())
+	}
+	event.Msg("tool call")
+}
@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rs/zerolog"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+)
+
+func TestToolHandlerWritesAuditRecord(t *testing.T) {
+	var buf bytes.Buffer
+	auditLog := zerolog.New(&buf)
+	s := &Server{auditLog: &auditLog}
+
+	tests := []struct {
+		name       string
+		tool       toolset.ServerTool
+		arguments  map[string]interface{}
+		want       map[string]interface{}
+		wantAbsent []string
+	}{
+		{
+			name: "failed write",
+			tool: toolset.ServerTool{
+				Tool:        mcp.Tool{Name: "kubernetes_patch"},
+				Annotations: toolset.ToolAnnotations{ReadOnlyHint: boolPtr(false)},
+				Handler: func(context.Context, interface{}, map[string]interface{}) (string, error) {
+					return "", errors.New("permission denied")
+				},
+			},
+			arguments: map[string]interface{}{
+				"cluster": "c1", "namespace": "default", "kind": "secret", "name": "db",
+				"patch": `{"stringData":{"password":"hunter2"}}`,
+			},
+			want: map[string]interface{}{
+				"tool": "kubernetes_patch", "cluster": "c1", "namespace": "default", "kind": "secret", "name": "db",
+				"write": true, "destructive": false, "success": false, "error": "permission denied",
+			},
+			wantAbsent: []string{"hunter2", "stringData"},
+		},
+		{
+			name: "successful read of several names",
+			tool: toolset.ServerTool{
+				Tool:        mcp.Tool{Name: "kubernetes_get"},
+				Annotations: toolset.ToolAnnotations{ReadOnlyHint: boolPtr(true)},
+				Handler: func(_ context.Context, _ interface{}, params map[string]interface{}) (string, error) {
+					params["showSensitiveData"] = true
+					return "ok", nil
+				},
+			},
+			arguments: map[string]interface{}{"cluster": "c1", "kind": "pod", "names": []interface{}{"a", "b"}},
+			want: map[string]interface{}{
+				"tool": "kubernetes_get", "names": "a,b", "write": false, "success": true,
+			},
+			wantAbsent: []string{"showSensitiveData", `"error"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf.Reset()
+			handler := s.makeToolHandler(tt.tool)
+			if _, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tt.arguments}}); err != nil {
+				t.Fatalf("tool handler returned error: %v", err)
+			}
+
+			line := buf.String()
+			var record map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				t.Fatalf("audit record is not JSON: %q", line)
+			}
+			for key, want := range tt.want {
+				if record[key] != want {
+					t.Errorf("record[%q] = %v, want %v (record %s)", key, record[key], want, line)
+				}
+			}
+			if _, ok := record["duration"]; !ok {
+				t.Errorf("expected a duration in %s", line)
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(line, absent) {
+					t.Errorf("audit record leaked %q: %s", absent, line)
+				}
+			}
+		})
+	}
+}
+
+func TestToolHandlerWithoutAuditLog(t *testing.T) {
+	s := &Server{}
+	handler := s.makeToolHandler(toolset.ServerTool{
+		Tool: mcp.Tool{Name: "test_tool"},
+		Handler: func(context.Context, interface{}, map[string]interface{}) (string, error) {
+			return "ok", nil
+		},
+	})
+	if _, err := handler(context.Background(), mcp.CallToolRequest{}); err != nil {
+		t.Fatalf("tool handler returned error: %v", err)
+	}
+}
@@ -323,3 +323,17 @@ func TestExecToolReadOnlyExcluded(t *testing.T) {
 		}
 	}
 }
+
+// TestCredentialToolEnabled tests that cluster_kubeconfig is registered only
+// when explicitly enabled, since it mints Rancher tokens.
+func TestCredentialToolEnabled(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		server := &Server{configuration: &Configuration{StaticConfig: &config.StaticConfig{EnableClusterKubeconfig: enabled}}}
+		if got := server.credentialToolEnabled("cluster_kubeconfig"); got != enabled {
+			t.Errorf("credentialToolEnabled(cluster_kubeconfig) = %v with EnableClusterKubeconfig = %v", got, enabled)
+		}
+		if !server.credentialToolEnabled("cluster_list") {
+			t.Error("credentialToolEnabled(cluster_list) = false, want other tools unaffected")
+		}
+	}
+}
@@ -5,11 +5,15 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/zerolog"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
@@ -18,6 +22,7 @@ import (
 	"github.com/futuretea/rancher-mcp-server/pkg/core/version"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/kubernetes"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	rancherToolset "github.com/futuretea/rancher-mcp-server/pkg/toolset/rancher"
 )
 
@@ -36,9 +41,13 @@ type Server struct {
 	configuration  *Configuration
 	server         *server.MCPServer
 	enabledTools   []string
+	toolsetNames   []string
 	normanClient   *norman.Client
 	steveClient    *steve.Client
 	combinedClient *toolset.CombinedClient
+	// auditLog records every tool call when an audit sink is configured
+	auditLog    *zerolog.Logger
+	auditCloser io.Closer
 }
 
 // NewServer creates a new MCP server with the given configuration
@@ -56,6 +65,10 @@ func NewServer(configuration Configuration) (*Server, error) {
 		server.WithLogging(),
 	)
 
+	// Timestamps in tool output follow the configured display mode
+	paramutil.SetTimeDisplay(configuration.TimeDisplay)
+	paramutil.SetTableAutoWidth(configuration.TableAutoWidth, configuration.TableMaxColumnWidth)
+
 	// Initialize Norman client (for Rancher v3 API)
 	normanClient, err := norman.NewClient(configuration.StaticConfig)
 	if err != nil {
@@ -73,7 +86,11 @@ func NewServer(configuration Configuration) (*Server, error) {
 			configuration.RancherAccessKey,
 			configuration.RancherSecretKey,
 			configuration.RancherTLSInsecure,
+			steve.DefaultDiscoveryCacheTTL,
 		)
+		steveClient.RequestTimeout = configuration.RancherRequestTimeout
+		steveClient.RateLimitQPS = float32(configuration.RancherRateLimitQPS)
+		steveClient.RateLimitBurst = configuration.RancherRateLimitBurst
 		logging.Info("Steve client initialized for Kubernetes resources")
 	}
 
@@ -88,8 +105,18 @@ func NewServer(configuration Configuration) (*Server, error) {
 		},
 	}
 
+	if configuration.AuditLog != "" {
+		auditLog, closer, err := logging.NewAuditLogger(configuration.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		s.auditLog, s.auditCloser = &auditLog, closer
+		logging.Info("Audit logging of tool calls to %s", configuration.AuditLog)
+	}
+
 	// Register tools
 	if err := s.registerTools(); err != nil {
+		s.Close()
 		return nil, err
 	}
 
@@ -98,8 +125,10 @@ func NewServer(configuration Configuration) (*Server, error) {
 
 // registerTools registers all available tools based on configuration
 func (s *Server) registerTools() error {
-	available := s.availableToolsets()
-	enabled := s.enabledToolsets(available)
+	enabled, err := s.enabledToolsets(s.availableToolsets())
+	if err != nil {
+		return err
+	}
 
 	if err := validateUniqueToolNames(enabled, s.combinedClient); err != nil {
 		return err
@@ -109,6 +138,16 @@ func (s *Server) registerTools() error {
 		if err := s.registerToolset(ts); err != nil {
 			return err
 		}
+		s.toolsetNames = append(s.toolsetNames, ts.GetName())
+	}
+	sort.Strings(s.toolsetNames)
+
+	// server_status is registered by the server itself, independent of
+	// toolsets and runtime capabilities, so it can diagnose both.
+	if s.shouldEnableTool(serverStatusToolName) {
+		if err := s.registerTool(s.configureTool(s.serverStatusTool())); err != nil {
+			return fmt.Errorf("failed to register tool %s: %w", serverStatusToolName, err)
+		}
 	}
 
 	logging.Info("Capability summary: %s", s.capabilitySummary())
@@ -127,24 +166,34 @@ func (s *Server) availableToolsets() map[string]toolset.Toolset {
 	}
 }
 
-// enabledToolsets selects the toolsets that should be registered.
-// If no toolsets are configured, all available toolsets are used.
-func (s *Server) enabledToolsets(available map[string]toolset.Toolset) []toolset.Toolset {
-	if len(s.configuration.Toolsets) == 0 {
-		enabled := make([]toolset.Toolset, 0, len(available))
-		for _, ts := range available {
-			enabled = append(enabled, ts)
+// enabledToolsets selects the toolsets that should be registered, sorted by
+// name. If no toolsets are configured, all available toolsets are used.
+// Disabled toolsets are removed from the selection. Unknown names are an
+// error rather than being ignored, so a typo cannot silently expose or hide tools.
+func (s *Server) enabledToolsets(available map[string]toolset.Toolset) ([]toolset.Toolset, error) {
+	names := s.configuration.Toolsets
+	if len(names) == 0 {
+		names = make([]string, 0, len(available))
+		for name := range available {
+			names = append(names, name)
+		}
+	}
+	for _, name := range append(slices.Clone(names), s.configuration.DisabledToolsets...) {
+		if _, exists := available[name]; !exists {
+			return nil, fmt.Errorf("unknown toolset %q", name)
 		}
-		return enabled
 	}
 
-	enabled := make([]toolset.Toolset, 0, len(s.configuration.Toolsets))
-	for _, name := range s.configuration.Toolsets {
-		if ts, exists := available[name]; exists {
-			enabled = append(enabled, ts)
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+	enabled := make([]toolset.Toolset, 0, len(names))
+	for _, name := range names {
+		if slices.Contains(s.configuration.DisabledToolsets, name) {
+			logging.Info("Skipping disabled toolset %s", name)
+			continue
 		}
+		enabled = append(enabled, available[name])
 	}
-	return enabled
+	return enabled, nil
 }
 
 // registerToolset registers all tools from a single toolset.
@@ -163,9 +212,13 @@ func (s *Server) registerToolset(ts toolset.Toolset) error {
 	return nil
 }
 
-// shouldRegisterTool reports whether a tool passes capability, container-operation,
-// and enablement checks.
+// shouldRegisterTool reports whether a tool passes read-only, capability,
+// container-operation, and enablement checks.
 func (s *Server) shouldRegisterTool(tool toolset.ServerTool) bool {
+	if s.configuration.ReadOnly && !isReadOnlyTool(tool) {
+		logging.Info("Skipping tool %s: server is running in read-only mode", tool.Tool.Name)
+		return false
+	}
 	if allowed, reason := s.capabilityAllowsTool(tool); !allowed {
 		logging.Info("Skipping tool %s: %s", tool.Tool.Name, reason)
 		return false
@@ -173,9 +226,19 @@ func (s *Server) shouldRegisterTool(tool toolset.ServerTool) bool {
 	if !s.containerOperationEnabled(tool.Tool.Name) {
 		return false
 	}
+	if !s.credentialToolEnabled(tool.Tool.Name) {
+		return false
+	}
 	return s.shouldEnableTool(tool.Tool.Name)
 }
 
+// isReadOnlyTool reports whether a tool is annotated as read-only. Tools without
+// a ReadOnlyHint are treated as writes, so read-only mode hides them until they
+// declare otherwise. Tools that mint or reveal credentials are writes too.
+func isReadOnlyTool(tool toolset.ServerTool) bool {
+	return tool.Annotations.ReadOnlyHint != nil && *tool.Annotations.ReadOnlyHint
+}
+
 // containerOperationEnabled reports whether a container operation tool is enabled
 // by configuration. Non-container tools are always enabled.
 func (s *Server) containerOperationEnabled(toolName string) bool {
@@ -191,6 +254,15 @@ func (s *Server) containerOperationEnabled(toolName string) bool {
 	}
 }
 
+// credentialToolEnabled reports whether a tool that mints credentials is
+// enabled by configuration. Other tools are always enabled.
+func (s *Server) credentialToolEnabled(toolName string) bool {
+	if toolName == "cluster_kubeconfig" {
+		return s.configuration.EnableClusterKubeconfig
+	}
+	return true
+}
+
 // shouldEnableTool determines if a tool should be enabled based on configuration
 func (s *Server) shouldEnableTool(toolName string) bool {
 	// Check if tool is explicitly disabled
@@ -221,6 +293,11 @@ func (s *Server) configureTool(tool toolset.ServerTool) toolset.ServerTool {
 		Tool:        tool.Tool,
 		Annotations: tool.Annotations,
 		Handler: func(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+			// Read-only mode applies to every toolset, not only to the handlers that check it
+			if s.configuration.ReadOnly && !isReadOnlyTool(tool) {
+				return "", paramutil.ErrReadOnlyMode
+			}
+
 			// Inject default output format if not specified
 			if _, hasOutput := params["output"]; !hasOutput && s.configuration.ListOutput != "" {
 				params["output"] = s.configuration.ListOutput
@@ -246,6 +323,13 @@ func (s *Server) configureTool(tool toolset.ServerTool) toolset.ServerTool {
 				params["maxFileSize"] = kubernetes.DefaultMaxFileSize
 			}
 
+			// Inject the token lifetime cap for cluster_kubeconfig, overriding any caller value
+			maxTTL := s.configuration.KubeconfigMaxTTL
+			if maxTTL <= 0 {
+				maxTTL = rancherToolset.DefaultKubeconfigMaxTTL
+			}
+			params[paramutil.ParamMaxTTLSeconds] = int64(maxTTL / time.Second)
+
 			// Admin policy: if show_sensitive_data is disabled, force mask regardless of per-call param
 			if !s.configuration.ShowSensitiveData {
 				params["showSensitiveData"] = false
@@ -287,7 +371,15 @@ func (s *Server) makeToolHandler(tool toolset.ServerTool) server.ToolHandlerFunc
 			}
 		}
 
+		var record *auditRecord
+		if s.auditLog != nil {
+			record = newAuditRecord(tool, params)
+		}
+
 		result, err := tool.Handler(ctx, s.combinedClient, params)
+		if record != nil {
+			record.log(s.auditLog, err)
+		}
 		return NewTextResult(result, err), nil
 	})
 }
@@ -352,7 +444,11 @@ func (s *Server) IsHealthy() bool {
 // Close cleans up the server resources
 func (s *Server) Close() {
 	logging.Info("Closing MCP server")
-	// Nothing to clean up for now
+	if s.auditCloser != nil {
+		if err := s.auditCloser.Close(); err != nil {
+			logging.Warn("Failed to close audit log: %v", err)
+		}
+	}
 }
 
 // NewTextResult creates a standardized text result for tool responses
@@ -1,6 +1,8 @@
 package mcp
 
 import (
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/core/config"
@@ -160,3 +162,70 @@ func TestGetHealthStatusWithoutRancherConfig(t *testing.T) {
 		t.Fatalf("expected kubernetes capability to be unconfigured and unavailable, got %+v", kubernetesStatus)
 	}
 }
+
+func TestAvailableToolsetsMatchKnownToolsets(t *testing.T) {
+	server := &Server{configuration: &Configuration{StaticConfig: &config.StaticConfig{}}}
+	available := server.availableToolsets()
+
+	names := make([]string, 0, len(available))
+	for name := range available {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if strings.Join(names, ",") != strings.Join(config.KnownToolsets, ",") {
+		t.Fatalf("available toolsets %v do not match config.KnownToolsets %v", names, config.KnownToolsets)
+	}
+}
+
+func TestEnabledToolsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		toolsets []string
+		disabled []string
+		want     string
+		wantErr  string
+	}{
+		{name: "all by default", want: "kubernetes,rancher"},
+		{name: "explicit selection", toolsets: []string{"rancher", "kubernetes", "rancher"}, want: "kubernetes,rancher"},
+		{name: "disable from default", disabled: []string{"rancher"}, want: "kubernetes"},
+		{name: "disable from selection", toolsets: []string{"kubernetes"}, disabled: []string{"kubernetes"}, want: ""},
+		{name: "unknown toolset", toolsets: []string{"kubernetes", "networking"}, wantErr: `unknown toolset "networking"`},
+		{name: "unknown disabled toolset", disabled: []string{"config"}, wantErr: `unknown toolset "config"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &Server{configuration: &Configuration{StaticConfig: &config.StaticConfig{
+				Toolsets:         tt.toolsets,
+				DisabledToolsets: tt.disabled,
+			}}}
+			enabled, err := server.enabledToolsets(server.availableToolsets())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			names := make([]string, 0, len(enabled))
+			for _, ts := range enabled {
+				names = append(names, ts.GetName())
+			}
+			if got := strings.Join(names, ","); got != tt.want {
+				t.Errorf("enabled toolsets = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewServerWithDisabledToolset(t *testing.T) {
+	server, err := NewServer(Configuration{StaticConfig: &config.StaticConfig{DisabledToolsets: []string{"kubernetes"}}})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	defer server.Close()
+
+	assertToolsAbsent(t, server.GetEnabledTools(), "kubernetes_diff")
+	assertToolsPresent(t, server.GetEnabledTools(), serverStatusToolName)
+}
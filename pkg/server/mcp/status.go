@@ -0,0 +1,199 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/core/version"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+const (
+	// serverStatusToolName is the self-diagnostic tool registered by the server itself.
+	serverStatusToolName = "server_status"
+	// maxStatusProbeClusters caps how many clusters server_status tries
+	// before reporting the Steve API as unreachable.
+	maxStatusProbeClusters = 3
+	// localClusterID is probed when the cluster list is unavailable.
+	localClusterID = "local"
+)
+
+// ServerStatus is the preflight report returned by server_status.
+type ServerStatus struct {
+	Version string `json:"version"`
+	// ServerURL keeps only its scheme unless show_sensitive_data is enabled.
+	ServerURL string `json:"serverURL,omitempty"`
+	// Credentials names the configured authentication with secrets masked.
+	Credentials        string                      `json:"credentials,omitempty"`
+	ReadOnly           bool                        `json:"readOnly"`
+	DisableDestructive bool                        `json:"disableDestructive"`
+	Capabilities       map[string]CapabilityStatus `json:"capabilities"`
+	Management         ConnectivityCheck           `json:"management"`
+	Steve              ConnectivityCheck           `json:"steve"`
+	Toolsets           []string                    `json:"toolsets"`
+	Tools              int                         `json:"tools"`
+}
+
+// ConnectivityCheck is the result of one live call against Rancher.
+type ConnectivityCheck struct {
+	Reachable bool `json:"reachable"`
+	// Clusters is the number of clusters visible to the management API.
+	Clusters int `json:"clusters,omitempty"`
+	// Cluster is the cluster the Steve API was reached through.
+	Cluster string `json:"cluster,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// clusterLister lists the clusters visible to the management API.
+type clusterLister interface {
+	ListClusters(ctx context.Context) ([]norman.Cluster, error)
+}
+
+// serverStatusTool returns the server_status tool. It needs no Rancher
+// configuration so it can explain why other tools are missing.
+func (s *Server) serverStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        serverStatusToolName,
+			Description: "Check the MCP server's Rancher connectivity: whether the Rancher management and Kubernetes (Steve) clients are configured and reachable, the configured server URL (masked unless the server shows sensitive data) with masked credentials, and the registered toolsets. Use it to debug \"client not configured\" or connection errors.",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					paramutil.ParamFormat: map[string]any{
+						"type":        "string",
+						"description": "Output format: json, yaml",
+						"enum":        []string{paramutil.FormatJSON, paramutil.FormatYAML},
+						"default":     paramutil.FormatJSON,
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: func(ctx context.Context, _ interface{}, params map[string]interface{}) (string, error) {
+			status := s.serverStatus(ctx)
+			if paramutil.ExtractFormat(params) == paramutil.FormatYAML {
+				return paramutil.FormatAsYAML(status)
+			}
+			return paramutil.FormatAsJSON(status)
+		},
+	}
+}
+
+// serverStatus collects the static configuration and runs the live checks.
+func (s *Server) serverStatus(ctx context.Context) *ServerStatus {
+	status := &ServerStatus{
+		Version:      version.Version,
+		Capabilities: s.capabilityStatuses(),
+		Toolsets:     s.toolsetNames,
+		Tools:        len(s.enabledTools),
+	}
+	if s.configuration != nil && s.configuration.StaticConfig != nil {
+		cfg := s.configuration.StaticConfig
+		status.ServerURL = cfg.RancherServerURL
+		if !cfg.ShowSensitiveData {
+			status.ServerURL = maskedServerURL(cfg.RancherServerURL)
+		}
+		status.Credentials = maskedCredentials(cfg.RancherToken, cfg.RancherAccessKey, cfg.RancherSecretKey)
+		status.ReadOnly = cfg.ReadOnly
+		status.DisableDestructive = cfg.DisableDestructive
+	}
+
+	var clusters clusterLister
+	if status.Capabilities["rancher"].Available {
+		clusters = s.normanClient
+	}
+	var reader steve.ResourceReader
+	if status.Capabilities["kubernetes"].Available {
+		reader = s.steveClient
+	}
+	status.Management, status.Steve = checkConnectivity(ctx, clusters, reader)
+	return status
+}
+
+// checkConnectivity lists clusters through the management API, then lists
+// namespaces through the Steve API on up to maxStatusProbeClusters of them,
+// stopping at the first that answers. Without a cluster list the local
+// cluster is tried. A nil client is reported as not configured.
+func checkConnectivity(ctx context.Context, clusters clusterLister, reader steve.ResourceReader) (ConnectivityCheck, ConnectivityCheck) {
+	management := ConnectivityCheck{Error: "rancher management client not configured"}
+	probe := []string{localClusterID}
+	if clusters != nil {
+		list, err := clusters.ListClusters(ctx)
+		if err != nil {
+			management.Error = err.Error()
+		} else {
+			management = ConnectivityCheck{Reachable: true, Clusters: len(list)}
+			probe = probe[:0]
+			for _, c := range list {
+				probe = append(probe, c.ID)
+			}
+		}
+	}
+
+	steveCheck := ConnectivityCheck{Error: "kubernetes client not configured"}
+	if reader == nil {
+		return management, steveCheck
+	}
+	if len(probe) == 0 {
+		steveCheck.Error = "no clusters visible to the configured credentials"
+		return management, steveCheck
+	}
+
+	var failures []string
+	for _, clusterID := range probe[:min(len(probe), maxStatusProbeClusters)] {
+		if _, err := reader.ListResources(ctx, clusterID, "namespace", "", &steve.ListOptions{Limit: 1}); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", clusterID, err))
+			continue
+		}
+		return management, ConnectivityCheck{Reachable: true, Cluster: clusterID}
+	}
+	steveCheck.Error = strings.Join(failures, "; ")
+	return management, steveCheck
+}
+
+// maskedCredentials describes the configured authentication without exposing
+// secrets. Rancher tokens have the form "token-xxxxx:secret"; the token name
+// before the colon is kept so the token can be identified in Rancher.
+func maskedCredentials(token, accessKey, secretKey string) string {
+	switch {
+	case token != "":
+		if name, _, found := strings.Cut(token, ":"); found {
+			return "token " + name + ":****"
+		}
+		return "token " + maskSecret(token)
+	case accessKey != "" && secretKey != "":
+		return "access key " + accessKey + " with secret key ****"
+	default:
+		return ""
+	}
+}
+
+// maskedServerURL keeps the scheme of a server URL and masks the rest, which
+// can name internal hosts or carry credentials.
+func maskedServerURL(serverURL string) string {
+	if serverURL == "" {
+		return ""
+	}
+	if u, err := url.Parse(serverURL); err == nil && u.Scheme != "" {
+		return u.Scheme + "://****"
+	}
+	return "****"
+}
+
+// maskSecret keeps the first four characters of a secret long enough to
+// still hide most of it.
+func maskSecret(secret string) string {
+	if len(secret) <= 8 {
+		return "****"
+	}
+	return secret[:4] + "****"
+}
@@ -3,15 +3,19 @@ package mcp
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/core/config"
 	"github.com/futuretea/rancher-mcp-server/pkg/core/logging"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestToolArgumentKeys(t *testing.T) {
@@ -73,6 +77,32 @@ func TestToolHandlerLogsArgumentKeysOnly(t *testing.T) {
 	}
 }
 
+func TestToolHandlerPassesRequestContext(t *testing.T) {
+	type ctxKey struct{}
+
+	s := &Server{}
+	var handlerCtx context.Context
+	handler := s.makeToolHandler(toolset.ServerTool{
+		Tool: mcp.Tool{Name: "test_tool"},
+		Handler: func(ctx context.Context, _ interface{}, _ map[string]interface{}) (string, error) {
+			handlerCtx = ctx
+			return "ok", nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "request"))
+	if _, err := handler(ctx, mcp.CallToolRequest{}); err != nil {
+		t.Fatalf("tool handler returned error: %v", err)
+	}
+	if handlerCtx == nil || handlerCtx.Value(ctxKey{}) != "request" {
+		t.Fatal("expected the request context to reach the tool handler")
+	}
+	cancel()
+	if handlerCtx.Err() == nil {
+		t.Fatal("expected cancelling the request to cancel the handler context")
+	}
+}
+
 func TestValidateUniqueToolNamesRejectsDuplicateNames(t *testing.T) {
 	duplicateTool := toolset.ServerTool{
 		Tool: mcp.Tool{Name: "duplicate_tool"},
@@ -106,3 +136,39 @@ func (s staticToolset) GetDescription() string {
 func (s staticToolset) GetTools(_ interface{}) []toolset.ServerTool {
 	return s.tools
 }
+
+func TestReadOnlyModeAppliesToAllToolsets(t *testing.T) {
+	handler := func(_ context.Context, _ interface{}, _ map[string]interface{}) (string, error) {
+		return "ok", nil
+	}
+	readTool := toolset.ServerTool{Tool: mcp.Tool{Name: "other_read"}, Annotations: toolset.ToolAnnotations{ReadOnlyHint: boolPtr(true)}, Handler: handler}
+	writeTool := toolset.ServerTool{Tool: mcp.Tool{Name: "other_write"}, Annotations: toolset.ToolAnnotations{ReadOnlyHint: boolPtr(false)}, Handler: handler}
+	unannotatedTool := toolset.ServerTool{Tool: mcp.Tool{Name: "other_unannotated"}, Handler: handler}
+
+	for _, readOnly := range []bool{true, false} {
+		t.Run(fmt.Sprintf("readOnly=%v", readOnly), func(t *testing.T) {
+			s := &Server{
+				configuration: &Configuration{StaticConfig: &config.StaticConfig{ReadOnly: readOnly}},
+				server:        server.NewMCPServer("test", "0.0.0"),
+			}
+			if err := s.registerToolset(staticToolset{name: "other", tools: []toolset.ServerTool{readTool, writeTool, unannotatedTool}}); err != nil {
+				t.Fatalf("failed to register toolset: %v", err)
+			}
+
+			if readOnly {
+				assertToolsPresent(t, s.GetEnabledTools(), "other_read")
+				assertToolsAbsent(t, s.GetEnabledTools(), "other_write", "other_unannotated")
+			} else {
+				assertToolsPresent(t, s.GetEnabledTools(), "other_read", "other_write", "other_unannotated")
+			}
+
+			_, err := s.configureTool(writeTool).Handler(context.Background(), nil, map[string]interface{}{})
+			if readOnly != errors.Is(err, paramutil.ErrReadOnlyMode) {
+				t.Errorf("write handler error = %v with readOnly=%v", err, readOnly)
+			}
+			if _, err := s.configureTool(readTool).Handler(context.Background(), nil, map[string]interface{}{}); err != nil {
+				t.Errorf("read handler returned error: %v", err)
+			}
+		})
+	}
+}
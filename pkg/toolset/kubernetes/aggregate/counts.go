@@ -0,0 +1,149 @@
+package aggregate
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+)
+
+const (
+	// DefaultCountConcurrency is the number of kinds listed in parallel
+	DefaultCountConcurrency = 5
+	// countPageSize bounds the items fetched per list call, so large kinds
+	// are counted page by page instead of in one response
+	countPageSize = 500
+)
+
+// DefaultCountKinds are the kinds counted when none are requested
+var DefaultCountKinds = []string{
+	"pod", "deployment", "statefulset", "daemonset", "replicaset", "job", "cronjob",
+	"service", "ingress", "configmap", "secret", "persistentvolumeclaim", "serviceaccount",
+}
+
+// CountAnalyzer counts resources per kind and namespace
+type CountAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewCountAnalyzer creates a new resource count analyzer
+func NewCountAnalyzer(client steve.ResourceReader) *CountAnalyzer {
+	return &CountAnalyzer{client: client}
+}
+
+// Analyze lists every kind concurrently and keeps only the counts. Kinds that
+// cannot be listed are reported in Errors instead of failing the analysis.
+func (a *CountAnalyzer) Analyze(ctx context.Context, p CountParams) (*CountResult, error) {
+	kinds := p.Kinds
+	if len(kinds) == 0 {
+		kinds = DefaultCountKinds
+	}
+	concurrency := p.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultCountConcurrency
+	}
+
+	// Each worker writes to its own slot so the result does not depend on timing.
+	perKind := make([]map[string]int, len(kinds))
+	errs := make([]error, len(kinds))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, kind := range kinds {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int, kind string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			perKind[i], errs[i] = a.countKind(ctx, p.Cluster, kind, p.Namespace, p.ByNamespace)
+		}(i, kind)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &CountResult{Items: []CountItem{}}
+	for i, kind := range kinds {
+		if errs[i] != nil {
+			result.Errors = append(result.Errors, CountError{Kind: kind, Error: errs[i].Error()})
+			continue
+		}
+		result.Items = append(result.Items, countItems(kind, perKind[i], p.ByNamespace)...)
+	}
+	for _, item := range result.Items {
+		result.Total += item.Count
+	}
+	sortCountItems(result.Items)
+	return result, nil
+}
+
+// countKind returns the number of resources of a kind per namespace, with
+// cluster-scoped resources under "". Pages are discarded once counted.
+// Without byNamespace only the total matters, so it lists a single item and
+// adds the server's remainingItemCount, falling back to paging through the
+// rest when the server does not report it.
+func (a *CountAnalyzer) countKind(ctx context.Context, cluster, kind, namespace string, byNamespace bool) (map[string]int, error) {
+	counts := map[string]int{}
+	opts := &steve.ListOptions{Limit: countPageSize}
+	if !byNamespace {
+		opts.Limit = 1
+	}
+	for {
+		list, err := a.client.ListResources(ctx, cluster, kind, namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			counts[item.GetNamespace()]++
+		}
+		if list.GetContinue() == "" {
+			return counts, nil
+		}
+		if remaining := list.GetRemainingItemCount(); !byNamespace && remaining != nil {
+			counts[""] += int(*remaining)
+			return counts, nil
+		}
+		opts = &steve.ListOptions{Limit: countPageSize, Continue: list.GetContinue()}
+	}
+}
+
+// countItems turns the per-namespace counts of a kind into one item, or one
+// item per namespace when byNamespace is set. A kind without resources is
+// still reported with a zero count.
+func countItems(kind string, counts map[string]int, byNamespace bool) []CountItem {
+	if !byNamespace || len(counts) == 0 {
+		total := 0
+		for _, n := range counts {
+			total += n
+		}
+		return []CountItem{{Kind: kind, Count: total}}
+	}
+	items := make([]CountItem, 0, len(counts))
+	for namespace, n := range counts {
+		items = append(items, CountItem{Kind: kind, Namespace: namespace, Count: n})
+	}
+	return items
+}
+
+// sortCountItems orders items by count, highest first, then by kind and namespace
+func sortCountItems(items []CountItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Namespace < b.Namespace
+	})
+}
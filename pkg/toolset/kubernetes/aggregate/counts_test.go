@@ -0,0 +1,210 @@
+package aggregate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeCountResource(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+// forbiddenReader fails to list one kind.
+type forbiddenReader struct {
+	*fake.Client
+	kind string
+}
+
+func (r *forbiddenReader) ListResources(ctx context.Context, cluster, kind, namespace string, opts *steve.ListOptions) (*unstructured.UnstructuredList, error) {
+	if kind == r.kind {
+		return nil, steve.ErrForbidden
+	}
+	return r.Client.ListResources(ctx, cluster, kind, namespace, opts)
+}
+
+// pagedReader returns one item per page.
+type pagedReader struct {
+	*fake.Client
+	pages int
+}
+
+func (r *pagedReader) ListResources(ctx context.Context, cluster, kind, namespace string, opts *steve.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := r.Client.ListResources(ctx, cluster, kind, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.pages++
+	offset := 0
+	if opts.Continue != "" {
+		offset = len(opts.Continue)
+	}
+	page := &unstructured.UnstructuredList{Items: list.Items[offset : offset+1]}
+	if offset+1 < len(list.Items) {
+		page.SetContinue(strings.Repeat("x", offset+1))
+	}
+	return page, nil
+}
+
+// remainingReader returns the first item of each list with the number of
+// items left in remainingItemCount, and records the requested limits.
+type remainingReader struct {
+	*fake.Client
+	limits []int64
+}
+
+func (r *remainingReader) ListResources(ctx context.Context, cluster, kind, namespace string, opts *steve.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := r.Client.ListResources(ctx, cluster, kind, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.limits = append(r.limits, opts.Limit)
+	if len(list.Items) <= 1 {
+		return list, nil
+	}
+	remaining := int64(len(list.Items) - 1)
+	page := &unstructured.UnstructuredList{Items: list.Items[:1]}
+	page.SetContinue("next")
+	page.SetRemainingItemCount(&remaining)
+	return page, nil
+}
+
+func TestCountAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	for _, r := range []*unstructured.Unstructured{
+		makeCountResource("Pod", "web", "a"),
+		makeCountResource("Pod", "web", "b"),
+		makeCountResource("Pod", "db", "c"),
+		makeCountResource("Service", "web", "web"),
+		makeCountResource("Secret", "web", "token"),
+	} {
+		client.AddResource(r)
+	}
+
+	t.Run("per kind", func(t *testing.T) {
+		result, err := NewCountAnalyzer(client).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"service", "pod", "configmap"}})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		want := []CountItem{{Kind: "pod", Count: 3}, {Kind: "service", Count: 1}, {Kind: "configmap", Count: 0}}
+		if !reflect.DeepEqual(result.Items, want) || result.Total != 4 {
+			t.Errorf("Analyze() = %+v total %d, want %+v total 4", result.Items, result.Total, want)
+		}
+	})
+
+	t.Run("by namespace", func(t *testing.T) {
+		result, err := NewCountAnalyzer(client).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"pod", "service"}, ByNamespace: true})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		want := []CountItem{
+			{Kind: "pod", Namespace: "web", Count: 2},
+			{Kind: "pod", Namespace: "db", Count: 1},
+			{Kind: "service", Namespace: "web", Count: 1},
+		}
+		if !reflect.DeepEqual(result.Items, want) {
+			t.Errorf("Analyze() = %+v, want %+v", result.Items, want)
+		}
+	})
+
+	t.Run("kinds that cannot be listed are reported", func(t *testing.T) {
+		reader := &forbiddenReader{Client: client, kind: "secret"}
+		result, err := NewCountAnalyzer(reader).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"pod", "secret"}})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		if len(result.Items) != 1 || result.Items[0].Kind != "pod" {
+			t.Errorf("items = %+v, want only pods", result.Items)
+		}
+		if len(result.Errors) != 1 || result.Errors[0].Kind != "secret" || !strings.Contains(result.Errors[0].Error, "permission denied") {
+			t.Errorf("errors = %+v, want secret forbidden", result.Errors)
+		}
+	})
+
+	t.Run("pages are followed", func(t *testing.T) {
+		reader := &pagedReader{Client: client}
+		result, err := NewCountAnalyzer(reader).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"pod"}})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		if result.Total != 3 || reader.pages != 3 {
+			t.Errorf("total = %d over %d pages, want 3 over 3", result.Total, reader.pages)
+		}
+	})
+
+	t.Run("remaining item count avoids paging", func(t *testing.T) {
+		reader := &remainingReader{Client: client}
+		result, err := NewCountAnalyzer(reader).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"pod"}})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		if result.Total != 3 || !reflect.DeepEqual(reader.limits, []int64{1}) {
+			t.Errorf("total = %d with limits %v, want 3 with one list of limit 1", result.Total, reader.limits)
+		}
+	})
+
+	t.Run("by namespace pages through full lists", func(t *testing.T) {
+		reader := &pagedReader{Client: client}
+		result, err := NewCountAnalyzer(reader).Analyze(context.Background(), CountParams{Cluster: "c1", Kinds: []string{"pod"}, ByNamespace: true})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		if len(result.Items) != 2 || result.Total != 3 || reader.pages != 3 {
+			t.Errorf("items = %+v total %d over %d pages, want 2 namespaces, 3 over 3 pages", result.Items, result.Total, reader.pages)
+		}
+	})
+
+	t.Run("default kinds", func(t *testing.T) {
+		result, err := NewCountAnalyzer(client).Analyze(context.Background(), CountParams{Cluster: "c1"})
+		if err != nil {
+			t.Fatalf("Analyze() error: %v", err)
+		}
+		if len(result.Items) != len(DefaultCountKinds) || result.Total != 5 {
+			t.Errorf("got %d items total %d, want %d items total 5", len(result.Items), result.Total, len(DefaultCountKinds))
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := NewCountAnalyzer(client).Analyze(ctx, CountParams{Cluster: "c1"}); !errors.Is(err, context.Canceled) {
+			t.Errorf("Analyze() error = %v, want context.Canceled", err)
+		}
+	})
+}
+
+func TestFormatResult_TableCount(t *testing.T) {
+	result := &CountResult{
+		Items:  []CountItem{{Kind: "pod", Namespace: "web", Count: 2}, {Kind: "node", Count: 1}},
+		Total:  3,
+		Errors: []CountError{{Kind: "secret", Error: "permission denied"}},
+	}
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"KIND", "NAMESPACE", "COUNT", "web", "3 resources in total", "could not count secret: permission denied"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output missing %q:\n%s", want, out)
+		}
+	}
+
+	out, err = FormatResult(&CountResult{Items: []CountItem{{Kind: "pod", Count: 2}}, Total: 2}, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	if strings.Contains(out, "NAMESPACE") {
+		t.Errorf("per-kind table should not have a namespace column:\n%s", out)
+	}
+}
@@ -0,0 +1,178 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Job outcomes reported for the most recent run of a CronJob
+const (
+	JobOutcomeSucceeded = "Succeeded"
+	JobOutcomeFailed    = "Failed"
+	JobOutcomeRunning   = "Running"
+)
+
+// CronJobAnalyzer reports CronJob schedules and the outcome of their Jobs
+type CronJobAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewCronJobAnalyzer creates a new CronJob analyzer
+func NewCronJobAnalyzer(client steve.ResourceReader) *CronJobAnalyzer {
+	return &CronJobAnalyzer{client: client}
+}
+
+// Analyze lists CronJobs and correlates them with the Jobs they own
+func (a *CronJobAnalyzer) Analyze(ctx context.Context, p CronJobParams) (*CronJobResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "cronjob", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+
+	// Jobs are listed without the label selector: it targets the CronJobs, and
+	// the Jobs they spawn carry the job template's labels instead.
+	jobList, err := a.client.ListResources(ctx, p.Cluster, "job", p.Namespace, &steve.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	jobsByOwner := groupJobsByCronJob(jobList.Items)
+
+	items := make([]CronJobItem, 0, len(list.Items))
+	attention := 0
+	for _, obj := range list.Items {
+		item := extractCronJobItem(obj, jobsByOwner[obj.GetNamespace()+"/"+obj.GetName()])
+		if item.Warning != "" {
+			attention++
+		}
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	total := len(items)
+	limit := ClampLimit(p.Limit)
+	truncated := total > limit
+	if truncated {
+		items = items[:limit]
+	}
+
+	return &CronJobResult{
+		Items:     items,
+		Truncated: truncated,
+		Total:     total,
+		Attention: attention,
+	}, nil
+}
+
+// groupJobsByCronJob indexes Jobs by the "namespace/name" of their owning CronJob
+func groupJobsByCronJob(jobs []unstructured.Unstructured) map[string][]unstructured.Unstructured {
+	result := make(map[string][]unstructured.Unstructured)
+	for _, job := range jobs {
+		for _, ref := range job.GetOwnerReferences() {
+			if ref.Kind == "CronJob" {
+				key := job.GetNamespace() + "/" + ref.Name
+				result[key] = append(result[key], job)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// extractCronJobItem extracts a CronJobItem from an unstructured CronJob and its Jobs
+func extractCronJobItem(obj unstructured.Unstructured, jobs []unstructured.Unstructured) CronJobItem {
+	item := CronJobItem{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	item.Schedule, _, _ = unstructured.NestedString(obj.Object, "spec", "schedule")
+	item.Suspend, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+	active, _, _ := unstructured.NestedSlice(obj.Object, "status", "active")
+	item.Active = len(active)
+	item.LastScheduleTime = nestedTime(obj.Object, "status", "lastScheduleTime")
+	item.LastSuccessfulTime = nestedTime(obj.Object, "status", "lastSuccessfulTime")
+
+	var lastCreated time.Time
+	for _, job := range jobs {
+		outcome := jobOutcome(job)
+		switch outcome {
+		case JobOutcomeSucceeded:
+			item.JobsSucceeded++
+		case JobOutcomeFailed:
+			item.JobsFailed++
+		}
+		if created := job.GetCreationTimestamp().Time; item.LastRun == "" || created.After(lastCreated) {
+			lastCreated = created
+			item.LastRun = outcome
+		}
+	}
+
+	item.Warning = deriveCronJobWarning(item)
+
+	creationTime := obj.GetCreationTimestamp()
+	if !creationTime.IsZero() {
+		item.CreatedAt = creationTime.Time
+		item.Age = formatAge(creationTime.Time)
+	}
+
+	return item
+}
+
+// jobOutcome derives a Job's outcome from its Complete and Failed conditions
+func jobOutcome(job unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(job.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["status"] != "True" {
+			continue
+		}
+		switch m["type"] {
+		case "Failed":
+			return JobOutcomeFailed
+		case "Complete":
+			return JobOutcomeSucceeded
+		}
+	}
+	return JobOutcomeRunning
+}
+
+// deriveCronJobWarning flags CronJobs that need attention
+func deriveCronJobWarning(item CronJobItem) string {
+	switch {
+	case item.LastRun == JobOutcomeFailed:
+		return "last run failed"
+	case item.Suspend:
+		return "suspended"
+	default:
+		return ""
+	}
+}
+
+// nestedTime parses an RFC 3339 timestamp field, returning nil if it is absent or invalid
+func nestedTime(obj map[string]interface{}, fields ...string) *time.Time {
+	s, found, _ := unstructured.NestedString(obj, fields...)
+	if !found || s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,141 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeCronJob(name, namespace string, suspend bool, active int) *unstructured.Unstructured {
+	activeRefs := make([]interface{}, active)
+	for i := range activeRefs {
+		activeRefs[i] = map[string]interface{}{"kind": "Job", "name": name + "-active"}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "CronJob",
+		"metadata": map[string]interface{}{"name": name, "namespace": namespace},
+		"spec": map[string]interface{}{
+			"schedule": "*/5 * * * *",
+			"suspend":  suspend,
+		},
+		"status": map[string]interface{}{
+			"active":             activeRefs,
+			"lastScheduleTime":   "2026-01-01T10:00:00Z",
+			"lastSuccessfulTime": "2026-01-01T09:55:00Z",
+		},
+	}}
+}
+
+func makeCronJobJob(name, namespace, owner, created, conditionType string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Job",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         namespace,
+			"creationTimestamp": created,
+			"ownerReferences": []interface{}{
+				map[string]interface{}{"apiVersion": "batch/v1", "kind": "CronJob", "name": owner, "uid": "uid-" + owner},
+			},
+		},
+	}}
+	if conditionType != "" {
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+			map[string]interface{}{"type": conditionType, "status": "True"},
+		}, "status", "conditions")
+	}
+	return obj
+}
+
+func TestJobOutcome(t *testing.T) {
+	tests := []struct {
+		condition string
+		want      string
+	}{
+		{condition: "Complete", want: JobOutcomeSucceeded},
+		{condition: "Failed", want: JobOutcomeFailed},
+		{condition: "", want: JobOutcomeRunning},
+	}
+	for _, tt := range tests {
+		job := makeCronJobJob("j", "default", "backup", "2026-01-01T10:00:00Z", tt.condition)
+		if got := jobOutcome(*job); got != tt.want {
+			t.Errorf("jobOutcome(%q) = %q, want %q", tt.condition, got, tt.want)
+		}
+	}
+}
+
+func TestExtractCronJobItem(t *testing.T) {
+	jobs := []unstructured.Unstructured{
+		*makeCronJobJob("backup-1", "default", "backup", "2026-01-01T09:50:00Z", "Complete"),
+		*makeCronJobJob("backup-3", "default", "backup", "2026-01-01T10:00:00Z", "Failed"),
+		*makeCronJobJob("backup-2", "default", "backup", "2026-01-01T09:55:00Z", "Complete"),
+	}
+
+	item := extractCronJobItem(*makeCronJob("backup", "default", false, 1), jobs)
+
+	if item.Schedule != "*/5 * * * *" || item.Suspend || item.Active != 1 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if item.LastScheduleTime == nil || item.LastScheduleTime.Hour() != 10 {
+		t.Errorf("lastScheduleTime = %v, want 10:00", item.LastScheduleTime)
+	}
+	if item.JobsSucceeded != 2 || item.JobsFailed != 1 {
+		t.Errorf("succeeded/failed = %d/%d, want 2/1", item.JobsSucceeded, item.JobsFailed)
+	}
+	if item.LastRun != JobOutcomeFailed || item.Warning != "last run failed" {
+		t.Errorf("lastRun = %q, warning = %q, want the newest job's failure", item.LastRun, item.Warning)
+	}
+}
+
+func TestDeriveCronJobWarning(t *testing.T) {
+	tests := []struct {
+		name string
+		item CronJobItem
+		want string
+	}{
+		{name: "healthy", item: CronJobItem{LastRun: JobOutcomeSucceeded}, want: ""},
+		{name: "never run", item: CronJobItem{}, want: ""},
+		{name: "suspended", item: CronJobItem{Suspend: true, LastRun: JobOutcomeSucceeded}, want: "suspended"},
+		{name: "failed", item: CronJobItem{LastRun: JobOutcomeFailed}, want: "last run failed"},
+		{name: "failed and suspended", item: CronJobItem{Suspend: true, LastRun: JobOutcomeFailed}, want: "last run failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deriveCronJobWarning(tt.item); got != tt.want {
+				t.Errorf("deriveCronJobWarning() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronJobAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeCronJob("report", "default", true, 0))
+	client.AddResource(makeCronJob("backup", "default", false, 0))
+	client.AddResource(makeCronJobJob("backup-1", "default", "backup", "2026-01-01T09:50:00Z", "Complete"))
+	client.AddResource(makeCronJobJob("report-1", "default", "report", "2026-01-01T09:50:00Z", "Complete"))
+
+	result, err := NewCronJobAnalyzer(client).Analyze(context.Background(), CronJobParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+	if result.Total != 2 || result.Attention != 1 {
+		t.Fatalf("total = %d, attention = %d, want 2 and 1", result.Total, result.Attention)
+	}
+	if result.Items[0].Name != "backup" || result.Items[0].JobsSucceeded != 1 {
+		t.Errorf("first item = %+v, want backup with one succeeded job", result.Items[0])
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, want := range []string{"LAST SCHEDULE", "*/5 * * * *", "suspended", "1 of 2 CronJobs"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Deployment health verdicts
+const (
+	DeploymentHealthy      = "Healthy"
+	DeploymentScaledToZero = "ScaledToZero"
+	DeploymentDegraded     = "Degraded"
+	DeploymentUnavailable  = "Unavailable"
+	DeploymentStalled      = "Stalled"
+	DeploymentFailed       = "Failed"
+)
+
+// DeploymentHealthAnalyzer flags Deployments whose replicas or rollout lag their spec
+type DeploymentHealthAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewDeploymentHealthAnalyzer creates a new deployment health analyzer
+func NewDeploymentHealthAnalyzer(client steve.ResourceReader) *DeploymentHealthAnalyzer {
+	return &DeploymentHealthAnalyzer{client: client}
+}
+
+// Analyze lists Deployments and derives a health verdict for each
+func (a *DeploymentHealthAnalyzer) Analyze(ctx context.Context, p DeploymentHealthParams) (*DeploymentHealthResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "deployment", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	result := &DeploymentHealthResult{Deployments: len(list.Items)}
+	items := make([]DeploymentHealthItem, 0, len(list.Items))
+	for _, obj := range list.Items {
+		item := extractDeploymentHealthItem(obj)
+		if !item.Healthy() {
+			result.Unhealthy++
+		} else if p.UnhealthyOnly {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	// Unhealthy Deployments first, then by namespace and name
+	sort.SliceStable(items, func(i, j int) bool {
+		if hi, hj := items[i].Healthy(), items[j].Healthy(); hi != hj {
+			return hj
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	result.Total = len(items)
+	limit := ClampLimit(p.Limit)
+	result.Truncated = result.Total > limit
+	if result.Truncated {
+		items = items[:limit]
+	}
+	result.Items = items
+	return result, nil
+}
+
+// extractDeploymentHealthItem compares a Deployment's status counters with its spec.
+// A failed progress deadline outranks an unobserved generation, which outranks
+// missing replicas.
+func extractDeploymentHealthItem(obj unstructured.Unstructured) DeploymentHealthItem {
+	item := DeploymentHealthItem{
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Generation: obj.GetGeneration(),
+	}
+
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		desired = 1 // API default
+	}
+	item.Desired = int32(desired)
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	item.Ready = int32(ready)
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	item.Updated = int32(updated)
+	available, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+	item.Available = int32(available)
+	item.ObservedGeneration, _, _ = unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	item.Paused, _, _ = unstructured.NestedBool(obj.Object, "spec", "paused")
+
+	progressing, availableCond := deploymentConditions(obj)
+
+	switch {
+	case progressing != nil && progressing["reason"] == "ProgressDeadlineExceeded":
+		item.Health = DeploymentFailed
+		item.Message = fmt.Sprintf("progress deadline exceeded: %v", progressing["message"])
+	case item.ObservedGeneration < item.Generation:
+		item.Health = DeploymentStalled
+		item.Message = fmt.Sprintf("generation %d not observed by the controller (observed %d)", item.Generation, item.ObservedGeneration)
+	case item.Desired == 0:
+		item.Health = DeploymentScaledToZero
+	case item.Ready == 0:
+		item.Health = DeploymentUnavailable
+		item.Message = fmt.Sprintf("0 of %d replicas ready", item.Desired)
+	case item.Ready < item.Desired:
+		item.Health = DeploymentDegraded
+		item.Message = fmt.Sprintf("%d of %d replicas ready", item.Ready, item.Desired)
+	default:
+		item.Health = DeploymentHealthy
+	}
+	if item.Paused && item.Message != "" {
+		item.Message += " (rollout paused)"
+	}
+
+	// Date the discrepancy from when the Deployment became unavailable, or
+	// else from the last time its rollout changed state.
+	if !item.Healthy() {
+		if availableCond != nil && availableCond["status"] == "False" {
+			item.Since = nestedTime(availableCond, "lastTransitionTime")
+		}
+		if item.Since == nil && progressing != nil {
+			item.Since = nestedTime(progressing, "lastUpdateTime")
+			if item.Since == nil {
+				item.Since = nestedTime(progressing, "lastTransitionTime")
+			}
+		}
+	}
+
+	creationTime := obj.GetCreationTimestamp()
+	if !creationTime.IsZero() {
+		item.CreatedAt = creationTime.Time
+		item.Age = formatAge(creationTime.Time)
+	}
+	return item
+}
+
+// deploymentConditions returns the Progressing and Available conditions, nil when absent
+func deploymentConditions(obj unstructured.Unstructured) (progressing, available map[string]interface{}) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch condition["type"] {
+		case "Progressing":
+			progressing = condition
+		case "Available":
+			available = condition
+		}
+	}
+	return progressing, available
+}
+
+// Healthy reports whether the Deployment needs no attention
+func (i DeploymentHealthItem) Healthy() bool {
+	return i.Health == DeploymentHealthy || i.Health == DeploymentScaledToZero
+}
@@ -0,0 +1,146 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeDeployment(name string, generation, observed, desired, ready int64, conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "generation": generation},
+		"spec":       map[string]interface{}{"replicas": desired},
+		"status": map[string]interface{}{
+			"observedGeneration": observed,
+			"readyReplicas":      ready,
+			"updatedReplicas":    ready,
+			"availableReplicas":  ready,
+			"conditions":         conditions,
+		},
+	}}
+}
+
+func TestExtractDeploymentHealthItem(t *testing.T) {
+	unavailableSince := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+	progressedAt := time.Now().Add(-30 * time.Minute).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		wantHealth  string
+		wantMessage string
+		wantSince   *time.Time
+	}{
+		{
+			name:       "healthy",
+			obj:        makeDeployment("web", 2, 2, 3, 3),
+			wantHealth: DeploymentHealthy,
+		},
+		{
+			name:       "scaled to zero",
+			obj:        makeDeployment("web", 1, 1, 0, 0),
+			wantHealth: DeploymentScaledToZero,
+		},
+		{
+			name: "degraded dated from last progress",
+			obj: makeDeployment("web", 1, 1, 3, 1,
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Progressing", "status": "True", "lastUpdateTime": progressedAt.Format(time.RFC3339)},
+			),
+			wantHealth:  DeploymentDegraded,
+			wantMessage: "1 of 3 replicas ready",
+			wantSince:   &progressedAt,
+		},
+		{
+			name: "unavailable dated from availability loss",
+			obj: makeDeployment("web", 1, 1, 2, 0,
+				map[string]interface{}{"type": "Available", "status": "False", "lastTransitionTime": unavailableSince.Format(time.RFC3339)},
+				map[string]interface{}{"type": "Progressing", "status": "True", "lastUpdateTime": progressedAt.Format(time.RFC3339)},
+			),
+			wantHealth:  DeploymentUnavailable,
+			wantMessage: "0 of 2 replicas ready",
+			wantSince:   &unavailableSince,
+		},
+		{
+			name:        "generation not observed",
+			obj:         makeDeployment("web", 5, 4, 3, 3),
+			wantHealth:  DeploymentStalled,
+			wantMessage: "generation 5 not observed by the controller (observed 4)",
+		},
+		{
+			name: "progress deadline exceeded",
+			obj: makeDeployment("web", 3, 3, 3, 2,
+				map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": `ReplicaSet "web-abc" has timed out progressing.`},
+			),
+			wantHealth:  DeploymentFailed,
+			wantMessage: `progress deadline exceeded: ReplicaSet "web-abc" has timed out progressing.`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := extractDeploymentHealthItem(*tt.obj)
+			if item.Health != tt.wantHealth || item.Message != tt.wantMessage {
+				t.Errorf("verdict = %s %q, want %s %q", item.Health, item.Message, tt.wantHealth, tt.wantMessage)
+			}
+			switch {
+			case tt.wantSince == nil && item.Since != nil:
+				t.Errorf("since = %v, want unset", item.Since)
+			case tt.wantSince != nil && (item.Since == nil || !item.Since.Equal(*tt.wantSince)):
+				t.Errorf("since = %v, want %v", item.Since, tt.wantSince)
+			}
+		})
+	}
+}
+
+func TestDeploymentHealthAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeDeployment("api", 1, 1, 2, 2))
+	client.AddResource(makeDeployment("worker", 1, 1, 3, 1))
+	client.AddResource(makeDeployment("cache", 2, 1, 1, 1))
+
+	result, err := NewDeploymentHealthAnalyzer(client).Analyze(context.Background(), DeploymentHealthParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Deployments != 3 || result.Unhealthy != 2 || result.Total != 3 {
+		t.Fatalf("result = %+v", result)
+	}
+	wantOrder := []string{"cache", "worker", "api"}
+	for i, item := range result.Items {
+		if item.Name != wantOrder[i] {
+			t.Errorf("item %d = %s, want %s (unhealthy first)", i, item.Name, wantOrder[i])
+		}
+	}
+
+	result, err = NewDeploymentHealthAnalyzer(client).Analyze(context.Background(), DeploymentHealthParams{Cluster: "c1", UnhealthyOnly: true})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Total != 2 || result.Deployments != 3 {
+		t.Fatalf("unhealthyOnly result = %+v", result)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"DESIRED", "HEALTH", "Stalled", "1 of 3 replicas ready", "2 of 3 deployments unhealthy"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatResult_TableDeploymentHealthAllHealthy(t *testing.T) {
+	out, err := FormatResult(&DeploymentHealthResult{Deployments: 4}, "table")
+	if err != nil || out != "All 4 deployments are healthy\n" {
+		t.Errorf("table = %q, %v", out, err)
+	}
+}
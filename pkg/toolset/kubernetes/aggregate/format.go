@@ -7,6 +7,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"gopkg.in/yaml.v3"
 )
 
@@ -29,6 +30,28 @@ func FormatResult(v interface{}, format string) (string, error) {
 			return formatSummaryAsTable(r), nil
 		case *EventResult:
 			return formatEventAsTable(r), nil
+		case *PVCResult:
+			return formatPVCAsTable(r), nil
+		case *HPAResult:
+			return formatHPAAsTable(r), nil
+		case *CronJobResult:
+			return formatCronJobAsTable(r), nil
+		case *NetworkPolicyResult:
+			return formatNetworkPolicyAsTable(r), nil
+		case *QuotaResult:
+			return formatQuotaAsTable(r), nil
+		case *ImageResult:
+			return formatImageAsTable(r), nil
+		case *CountResult:
+			return formatCountAsTable(r), nil
+		case *UnhealthyPodResult:
+			return formatUnhealthyPodsAsTable(r), nil
+		case *DeploymentHealthResult:
+			return formatDeploymentHealthAsTable(r), nil
+		case *PDBResult:
+			return formatPDBAsTable(r), nil
+		case *ServiceHealthResult:
+			return formatServiceHealthAsTable(r), nil
 		default:
 			return "", fmt.Errorf("unsupported result type for table format: %T", v)
 		}
@@ -43,9 +66,13 @@ func FormatResult(v interface{}, format string) (string, error) {
 
 // --- Table formatting helpers ---
 
+// tableBuilder collects the rows of a table and writes them in fixed-width
+// columns, or in columns sized to their widest value when auto-sized tables
+// are enabled.
 type tableBuilder struct {
 	formats []string
 	headers []string
+	rows    [][]interface{}
 }
 
 func newTableBuilder(format, header string) *tableBuilder {
@@ -62,20 +89,52 @@ func (tb *tableBuilder) addColumn(format string, headers ...string) {
 	tb.headers = append(tb.headers, headers...)
 }
 
-func (tb *tableBuilder) writeHeader(b *strings.Builder) {
-	fmt.Fprintf(b, strings.Join(tb.formats, " ")+"\n", toAnySlice(tb.headers)...)
+func (tb *tableBuilder) addRow(values []interface{}) {
+	tb.rows = append(tb.rows, values)
 }
 
-func (tb *tableBuilder) writeSeparator(b *strings.Builder) {
+// write writes the header, a separator and the rows added so far.
+func (tb *tableBuilder) write(b *strings.Builder) {
+	formats := tb.formats
+	if autoWidth, _ := paramutil.TableAutoWidth(); autoWidth {
+		formats = tb.autoWidthFormats()
+	}
+	line := strings.Join(formats, " ") + "\n"
+
 	separators := make([]string, len(tb.headers))
 	for i, h := range tb.headers {
-		separators[i] = strings.Repeat("-", len(h))
+		separators[i] = strings.Repeat("-", utf8.RuneCountInString(h))
+	}
+	fmt.Fprintf(b, line, toAnySlice(tb.headers)...)
+	fmt.Fprintf(b, line, toAnySlice(separators)...)
+	for _, row := range tb.rows {
+		fmt.Fprintf(b, line, row...)
 	}
-	fmt.Fprintf(b, strings.Join(tb.formats, " ")+"\n", toAnySlice(separators)...)
 }
 
-func (tb *tableBuilder) writeRow(b *strings.Builder, values []interface{}) {
-	fmt.Fprintf(b, strings.Join(tb.formats, " ")+"\n", values...)
+// autoWidthFormats pads every column but the last to its widest header or value.
+func (tb *tableBuilder) autoWidthFormats() []string {
+	widths := make([]int, len(tb.headers))
+	for i, h := range tb.headers {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for _, row := range tb.rows {
+		for i, v := range row {
+			if n := utf8.RuneCountInString(fmt.Sprint(v)); i < len(widths) && n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	formats := make([]string, len(widths))
+	for i, w := range widths {
+		if i == len(widths)-1 {
+			formats[i] = "%s"
+			continue
+		}
+		formats[i] = fmt.Sprintf("%%-%ds", w)
+	}
+	return formats
 }
 
 func toAnySlice(ss []string) []any {
@@ -86,7 +145,12 @@ func toAnySlice(ss []string) []any {
 	return result
 }
 
+// truncate shortens s to maxLen runes, or to the maximum column width when
+// auto-sized tables are enabled, so columns only grow as far as needed.
 func truncate(s string, maxLen int) string {
+	if autoWidth, maxWidth := paramutil.TableAutoWidth(); autoWidth {
+		maxLen = maxWidth
+	}
 	if utf8.RuneCountInString(s) <= maxLen {
 		return s
 	}
@@ -105,6 +169,14 @@ func formatCPU(val int64) string {
 	return fmt.Sprintf("%.2fc", float64(val)/1000)
 }
 
+// formatPercent formats a percentage, or "-" when it was not computed
+func formatPercent(val *float64) string {
+	if val == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *val)
+}
+
 // formatMemory formats memory value (bytes) to string
 func formatMemory(val int64) string {
 	const (
@@ -124,24 +196,12 @@ func formatMemory(val int64) string {
 	}
 }
 
+// formatAge returns the compact age of t, or "" when t is unset
 func formatAge(t time.Time) string {
 	if t.IsZero() {
 		return ""
 	}
-	d := time.Since(t)
-	if d < 0 {
-		d = -d
-	}
-	if d < time.Minute {
-		return fmt.Sprintf("%ds", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd", int(d.Hours()/24))
+	return paramutil.HumanizeAge(t)
 }
 
 // --- Top table ---
@@ -150,6 +210,9 @@ func formatTopAsTable(r *TopResult) string {
 	if len(r.Items) == 0 {
 		return "No resources found"
 	}
+	if r.Kind == "node" {
+		return formatNodeTopAsTable(r)
+	}
 	var b strings.Builder
 
 	tb := newTableBuilder("%-40s", "NAME")
@@ -160,9 +223,6 @@ func formatTopAsTable(r *TopResult) string {
 	tb.addColumn("%-12s", "MEM.UTIL")
 	tb.addColumn("%-10s", "RESTARTS")
 
-	tb.writeHeader(&b)
-	tb.writeSeparator(&b)
-
 	for _, item := range r.Items {
 		row := []interface{}{
 			truncate(item.Name, 40),
@@ -173,8 +233,35 @@ func formatTopAsTable(r *TopResult) string {
 			formatMemory(item.MemUtil),
 			fmt.Sprintf("%d", item.Restarts),
 		}
-		tb.writeRow(&b, row)
+		tb.addRow(row)
 	}
+	tb.write(&b)
+
+	return b.String()
+}
+
+func formatNodeTopAsTable(r *TopResult) string {
+	var b strings.Builder
+
+	tb := newTableBuilder("%-40s", "NAME")
+	tb.addColumn("%-12s", "CPU.UTIL", "CPU.ALLOC")
+	tb.addColumn("%-8s", "CPU%")
+	tb.addColumn("%-12s", "MEM.UTIL", "MEM.ALLOC")
+	tb.addColumn("%-8s", "MEM%")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Name, 40),
+			formatCPU(item.CPUUtil),
+			formatCPU(item.CPULimit),
+			formatPercent(item.CPUPercent),
+			formatMemory(item.MemUtil),
+			formatMemory(item.MemLimit),
+			formatPercent(item.MemPercent),
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
 
 	return b.String()
 }
@@ -196,8 +283,17 @@ func formatWorkloadAsTable(r *WorkloadResult) string {
 	tb.addColumn("%-10s", "AGE")
 	tb.addColumn("%-10s", "STATUS")
 
-	tb.writeHeader(&b)
-	tb.writeSeparator(&b)
+	// Containers are only set when requested; show them as name=image pairs
+	withContainers := false
+	for _, item := range r.Items {
+		if len(item.Containers) > 0 {
+			withContainers = true
+			break
+		}
+	}
+	if withContainers {
+		tb.addColumn("%s", "CONTAINERS")
+	}
 
 	for _, item := range r.Items {
 		row := []interface{}{
@@ -210,8 +306,16 @@ func formatWorkloadAsTable(r *WorkloadResult) string {
 			item.Age,
 			item.Status,
 		}
-		tb.writeRow(&b, row)
+		if withContainers {
+			images := make([]string, len(item.Containers))
+			for i, c := range item.Containers {
+				images[i] = c.Name + "=" + c.Image
+			}
+			row = append(row, strings.Join(images, ", "))
+		}
+		tb.addRow(row)
 	}
+	tb.write(&b)
 
 	return b.String()
 }
@@ -231,9 +335,6 @@ func formatSummaryAsTable(r *SummaryResult) string {
 	tb.addColumn("%-12s", "MEM.REQ")
 	tb.addColumn("%-12s", "MEM.LIM")
 
-	tb.writeHeader(&b)
-	tb.writeSeparator(&b)
-
 	for _, item := range r.Items {
 		row := []interface{}{
 			truncate(item.Group, 25),
@@ -243,8 +344,9 @@ func formatSummaryAsTable(r *SummaryResult) string {
 			formatMemory(item.MemReq),
 			formatMemory(item.MemLimit),
 		}
-		tb.writeRow(&b, row)
+		tb.addRow(row)
 	}
+	tb.write(&b)
 
 	return b.String()
 }
@@ -263,9 +365,6 @@ func formatEventAsTable(r *EventResult) string {
 	tb.addColumn("%-8s", "COUNT")
 	tb.addColumn("%-15s", "LAST_SEEN")
 
-	tb.writeHeader(&b)
-	tb.writeSeparator(&b)
-
 	for _, item := range r.Items {
 		row := []interface{}{
 			truncate(item.Reason, 25),
@@ -274,8 +373,520 @@ func formatEventAsTable(r *EventResult) string {
 			fmt.Sprintf("%d", item.Count),
 			formatAge(item.LastSeen),
 		}
-		tb.writeRow(&b, row)
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	return b.String()
+}
+
+// --- PVC table ---
+
+func formatPVCAsTable(r *PVCResult) string {
+	if len(r.Items) == 0 {
+		return "No persistentvolumeclaims found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-40s", "NAME")
+	tb.addColumn("%-15s", "NAMESPACE")
+	tb.addColumn("%-10s", "STATUS")
+	tb.addColumn("%-12s", "REQUESTED", "CAPACITY")
+	tb.addColumn("%-20s", "STORAGECLASS")
+	tb.addColumn("%-40s", "VOLUME")
+	tb.addColumn("%-12s", "ACCESS")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%s", "WARNING")
+
+	for _, item := range r.Items {
+		capacity := "-"
+		if item.Capacity > 0 {
+			capacity = formatMemory(item.Capacity)
+		}
+		row := []interface{}{
+			truncate(item.Name, 40),
+			truncate(item.Namespace, 15),
+			item.Phase,
+			formatMemory(item.Requested),
+			capacity,
+			truncate(emptyDash(item.StorageClass), 20),
+			truncate(emptyDash(item.Volume), 40),
+			emptyDash(strings.Join(abbreviateAccessModes(item.AccessModes), ",")),
+			item.Age,
+			item.Warning,
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	if r.Pending > 0 {
+		fmt.Fprintf(&b, "\n%d of %d PVCs are Pending\n", r.Pending, r.Total)
+	}
+	return b.String()
+}
+
+// emptyDash returns "-" for empty strings
+func emptyDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// yesNo renders a boolean table cell
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// --- HPA table ---
+
+func formatHPAAsTable(r *HPAResult) string {
+	if len(r.Items) == 0 {
+		return "No horizontalpodautoscalers found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-30s", "NAME")
+	tb.addColumn("%-15s", "NAMESPACE")
+	tb.addColumn("%-30s", "REFERENCE")
+	tb.addColumn("%-30s", "TARGETS")
+	tb.addColumn("%-8s", "MINPODS", "MAXPODS", "REPLICAS")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%s", "CONDITIONS")
+
+	for _, item := range r.Items {
+		targets := make([]string, 0, len(item.Metrics))
+		for _, m := range item.Metrics {
+			current := m.Current
+			if current == "" {
+				current = "<unknown>"
+			}
+			targets = append(targets, fmt.Sprintf("%s: %s/%s", m.Name, current, m.Target))
+		}
+		row := []interface{}{
+			truncate(item.Name, 30),
+			truncate(item.Namespace, 15),
+			truncate(item.Target, 30),
+			emptyDash(strings.Join(targets, ", ")),
+			fmt.Sprintf("%d", item.MinReplicas),
+			fmt.Sprintf("%d", item.MaxReplicas),
+			fmt.Sprintf("%d", item.CurrentReplicas),
+			item.Age,
+			strings.Join(hpaScalingIssues(item.Conditions), ", "),
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	return b.String()
+}
+
+// --- CronJob table ---
+
+func formatCronJobAsTable(r *CronJobResult) string {
+	if len(r.Items) == 0 {
+		return "No cronjobs found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-30s", "NAME")
+	tb.addColumn("%-15s", "NAMESPACE")
+	tb.addColumn("%-15s", "SCHEDULE")
+	tb.addColumn("%-8s", "SUSPEND", "ACTIVE")
+	tb.addColumn("%-14s", "LAST SCHEDULE", "LAST SUCCESS")
+	tb.addColumn("%-10s", "SUCCEEDED", "FAILED", "LAST RUN")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%s", "WARNING")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Name, 30),
+			truncate(item.Namespace, 15),
+			truncate(item.Schedule, 15),
+			fmt.Sprintf("%t", item.Suspend),
+			fmt.Sprintf("%d", item.Active),
+			formatOptionalAge(item.LastScheduleTime),
+			formatOptionalAge(item.LastSuccessfulTime),
+			fmt.Sprintf("%d", item.JobsSucceeded),
+			fmt.Sprintf("%d", item.JobsFailed),
+			emptyDash(item.LastRun),
+			item.Age,
+			item.Warning,
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	if r.Attention > 0 {
+		fmt.Fprintf(&b, "\n%d of %d CronJobs are suspended or failed their last run\n", r.Attention, r.Total)
+	}
+	return b.String()
+}
+
+// formatOptionalAge renders an optional timestamp as an age, or "-" when unset
+func formatOptionalAge(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return formatAge(*t)
+}
+
+// --- NetworkPolicy table ---
+
+func formatNetworkPolicyAsTable(r *NetworkPolicyResult) string {
+	var b strings.Builder
+
+	if len(r.Items) == 0 {
+		b.WriteString("No networkpolicies found\n")
+	} else {
+		tb := newTableBuilder("%-30s", "NAME")
+		tb.addColumn("%-15s", "NAMESPACE")
+		tb.addColumn("%-30s", "POD-SELECTOR")
+		tb.addColumn("%-16s", "POLICY-TYPES")
+		tb.addColumn("%-8s", "INGRESS", "EGRESS")
+		tb.addColumn("%-16s", "DEFAULT-DENY")
+		tb.addColumn("%s", "AGE")
+
+		for _, item := range r.Items {
+			row := []interface{}{
+				truncate(item.Name, 30),
+				truncate(item.Namespace, 15),
+				truncate(item.PodSelector, 30),
+				strings.Join(item.PolicyTypes, ","),
+				fmt.Sprintf("%d", item.IngressRules),
+				fmt.Sprintf("%d", item.EgressRules),
+				emptyDash(strings.Join(item.DefaultDeny, ",")),
+				item.Age,
+			}
+			tb.addRow(row)
+		}
+		tb.write(&b)
+	}
+
+	if len(r.Coverage) > 0 {
+		b.WriteString("\nPods not selected by any NetworkPolicy:\n")
+		for _, c := range r.Coverage {
+			if c.Policies == 0 {
+				fmt.Fprintf(&b, "  %s: %d pods, no policies (no isolation)\n", c.Namespace, c.Pods)
+			} else {
+				fmt.Fprintf(&b, "  %s: %d of %d pods unselected by %d policies\n", c.Namespace, c.UnselectedPods, c.Pods, c.Policies)
+			}
+		}
+	}
+	return b.String()
+}
+
+// --- Namespace quota table ---
+
+func formatQuotaAsTable(r *QuotaResult) string {
+	var b strings.Builder
+
+	if len(r.Quotas) == 0 {
+		fmt.Fprintf(&b, "No resourcequotas found in namespace %s\n", r.Namespace)
+	} else {
+		tb := newTableBuilder("%-30s", "QUOTA")
+		tb.addColumn("%-30s", "RESOURCE")
+		tb.addColumn("%-12s", "USED", "HARD")
+		tb.addColumn("%-8s", "USE%")
+		tb.addColumn("%s", "WARNING")
+
+		for _, item := range r.Quotas {
+			row := []interface{}{
+				truncate(item.Quota, 30),
+				truncate(item.Resource, 30),
+				emptyDash(item.Used),
+				item.Hard,
+				fmt.Sprintf("%.1f%%", item.Percent),
+				item.Warning,
+			}
+			tb.addRow(row)
+		}
+		tb.write(&b)
+
+		if r.Attention > 0 {
+			fmt.Fprintf(&b, "\n%d of %d quota entries are near or over their limit\n", r.Attention, len(r.Quotas))
+		}
+	}
+
+	if len(r.LimitRanges) > 0 {
+		b.WriteString("\n")
+		tb := newTableBuilder("%-30s", "LIMITRANGE")
+		tb.addColumn("%-22s", "TYPE")
+		tb.addColumn("%-20s", "RESOURCE")
+		tb.addColumn("%-10s", "MIN", "MAX")
+		tb.addColumn("%-16s", "DEFAULT-REQUEST")
+		tb.addColumn("%s", "DEFAULT")
+
+		for _, item := range r.LimitRanges {
+			row := []interface{}{
+				truncate(item.LimitRange, 30),
+				item.Type,
+				truncate(item.Resource, 20),
+				emptyDash(item.Min),
+				emptyDash(item.Max),
+				emptyDash(item.DefaultRequest),
+				emptyDash(item.Default),
+			}
+			tb.addRow(row)
+		}
+		tb.write(&b)
+	}
+	return b.String()
+}
+
+// --- Image inventory table ---
+
+func formatImageAsTable(r *ImageResult) string {
+	if len(r.Items) == 0 {
+		return "No container images found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-60s", "IMAGE")
+	tb.addColumn("%-6s", "PODS")
+	tb.addColumn("%-30s", "NAMESPACES")
+	tb.addColumn("%s", "WORKLOADS")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Image, 60),
+			fmt.Sprintf("%d", item.Pods),
+			truncate(strings.Join(item.Namespaces, ","), 30),
+			truncate(strings.Join(item.Workloads, ","), 80),
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	fmt.Fprintf(&b, "\n%d distinct images across %d pods", r.Total, r.Pods)
+	if r.Truncated {
+		fmt.Fprintf(&b, " (showing %d)", len(r.Items))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// --- Resource count table ---
+
+func formatCountAsTable(r *CountResult) string {
+	var b strings.Builder
+
+	byNamespace := false
+	for _, item := range r.Items {
+		if item.Namespace != "" {
+			byNamespace = true
+			break
+		}
+	}
+
+	if len(r.Items) > 0 {
+		tb := newTableBuilder("%-30s", "KIND")
+		if byNamespace {
+			tb.addColumn("%-30s", "NAMESPACE")
+		}
+		tb.addColumn("%s", "COUNT")
+
+		for _, item := range r.Items {
+			row := []interface{}{truncate(item.Kind, 30)}
+			if byNamespace {
+				row = append(row, truncate(emptyDash(item.Namespace), 30))
+			}
+			row = append(row, fmt.Sprintf("%d", item.Count))
+			tb.addRow(row)
+		}
+		tb.write(&b)
+		fmt.Fprintf(&b, "\n%d resources in total\n", r.Total)
+		if len(r.Errors) > 0 {
+			b.WriteString("\n")
+		}
+	}
+
+	for _, e := range r.Errors {
+		fmt.Fprintf(&b, "could not count %s: %s\n", e.Kind, e.Error)
+	}
+	return b.String()
+}
+
+// --- Unhealthy pods table ---
+
+func formatUnhealthyPodsAsTable(r *UnhealthyPodResult) string {
+	if len(r.Items) == 0 {
+		return fmt.Sprintf("No unhealthy pods found among %d pods\n", r.Pods)
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-20s", "NAMESPACE")
+	tb.addColumn("%-40s", "NAME")
+	tb.addColumn("%-18s", "STATUS")
+	tb.addColumn("%-20s", "CONTAINER")
+	tb.addColumn("%-9s", "RESTARTS")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%-60s", "PROBLEM")
+	tb.addColumn("%s", "EVENT")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Namespace, 20),
+			truncate(item.Name, 40),
+			item.Status,
+			truncate(emptyDash(item.Container), 20),
+			fmt.Sprintf("%d", item.Restarts),
+			formatAge(item.Created),
+			truncate(emptyDash(item.Problem), 60),
+			truncate(emptyDash(item.Event), 80),
+		}
+		tb.addRow(row)
 	}
+	tb.write(&b)
 
+	fmt.Fprintf(&b, "\n%d unhealthy of %d pods", r.Total, r.Pods)
+	if r.Truncated {
+		fmt.Fprintf(&b, " (showing %d)", len(r.Items))
+	}
+	b.WriteString("\n")
 	return b.String()
 }
+
+// --- Deployment health table ---
+
+func formatDeploymentHealthAsTable(r *DeploymentHealthResult) string {
+	if len(r.Items) == 0 {
+		if r.Deployments > 0 {
+			return fmt.Sprintf("All %d deployments are healthy\n", r.Deployments)
+		}
+		return "No deployments found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-20s", "NAMESPACE")
+	tb.addColumn("%-30s", "NAME")
+	tb.addColumn("%-10s", "DESIRED", "READY", "UPDATED", "AVAILABLE")
+	tb.addColumn("%-13s", "HEALTH")
+	tb.addColumn("%-6s", "SINCE", "AGE")
+	tb.addColumn("%s", "MESSAGE")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Namespace, 20),
+			truncate(item.Name, 30),
+			fmt.Sprintf("%d", item.Desired),
+			fmt.Sprintf("%d", item.Ready),
+			fmt.Sprintf("%d", item.Updated),
+			fmt.Sprintf("%d", item.Available),
+			item.Health,
+			formatOptionalAge(item.Since),
+			item.Age,
+			truncate(emptyDash(item.Message), 80),
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	fmt.Fprintf(&b, "\n%d of %d deployments unhealthy", r.Unhealthy, r.Deployments)
+	if r.Truncated {
+		fmt.Fprintf(&b, " (showing %d of %d)", len(r.Items), r.Total)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// --- PDB table ---
+
+func formatPDBAsTable(r *PDBResult) string {
+	if len(r.Items) == 0 {
+		return "No poddisruptionbudgets found"
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-30s", "NAME")
+	tb.addColumn("%-15s", "NAMESPACE")
+	tb.addColumn("%-15s", "MIN AVAILABLE", "MAX UNAVAILABLE")
+	tb.addColumn("%-9s", "HEALTHY", "DESIRED", "EXPECTED")
+	tb.addColumn("%-21s", "ALLOWED DISRUPTIONS")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%s", "WARNING")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Name, 30),
+			truncate(item.Namespace, 15),
+			emptyDash(item.MinAvailable),
+			emptyDash(item.MaxUnavailable),
+			fmt.Sprintf("%d", item.CurrentHealthy),
+			fmt.Sprintf("%d", item.DesiredHealthy),
+			fmt.Sprintf("%d", item.ExpectedPods),
+			fmt.Sprintf("%d", item.DisruptionsAllowed),
+			item.Age,
+			item.Warning,
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	if r.Blocking > 0 {
+		fmt.Fprintf(&b, "\n%d PodDisruptionBudgets allow no disruptions and will block node drains\n", r.Blocking)
+	}
+	return b.String()
+}
+
+// --- Service health table ---
+
+func formatServiceHealthAsTable(r *ServiceHealthResult) string {
+	if len(r.Items) == 0 {
+		if r.Services == 0 {
+			return "No services found"
+		}
+		return fmt.Sprintf("All %d services have all backends ready\n", r.Services)
+	}
+	var b strings.Builder
+
+	tb := newTableBuilder("%-20s", "NAMESPACE")
+	tb.addColumn("%-30s", "NAME")
+	tb.addColumn("%-13s", "TYPE")
+	tb.addColumn("%-10s", "BACKENDS")
+	tb.addColumn("%-6s", "READY")
+	tb.addColumn("%-9s", "DEGRADED")
+	tb.addColumn("%-6s", "AGE")
+	tb.addColumn("%s", "MESSAGE")
+
+	for _, item := range r.Items {
+		row := []interface{}{
+			truncate(item.Namespace, 20),
+			truncate(item.Name, 30),
+			serviceDisplayType(item),
+			serviceBackends(item),
+			yesNo(item.Ready),
+			yesNo(item.Degraded),
+			item.Age,
+			truncate(emptyDash(item.Message), 80),
+		}
+		tb.addRow(row)
+	}
+	tb.write(&b)
+
+	fmt.Fprintf(&b, "\n%d not ready, %d degraded of %d services", r.NotReady, r.Degraded, r.Services)
+	if r.Truncated {
+		fmt.Fprintf(&b, " (showing %d of %d)", len(r.Items), r.Total)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// serviceDisplayType marks headless services, whose backends are endpoints
+func serviceDisplayType(item ServiceHealthItem) string {
+	if item.Headless {
+		return "Headless"
+	}
+	return item.Type
+}
+
+// serviceBackends renders ready/total backends; ExternalName services have none
+func serviceBackends(item ServiceHealthItem) string {
+	if item.Readiness == ReadinessExternalName {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", item.ReadyBackends, item.TotalBackends)
+}
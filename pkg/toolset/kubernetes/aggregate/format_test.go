@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
 
 func TestFormatResult_JSON(t *testing.T) {
@@ -223,3 +225,61 @@ func TestTruncate(t *testing.T) {
 		t.Errorf("truncate long string = %s, want 'hello w...'", got)
 	}
 }
+
+func TestFormatResult_TableAutoWidth(t *testing.T) {
+	paramutil.SetTableAutoWidth(true, 50)
+	defer paramutil.SetTableAutoWidth(false, 0)
+
+	longName := "payments-api-" + strings.Repeat("x", 60)
+	result := &TopResult{
+		Items: []TopItem{
+			{Name: longName, Namespace: "pay", CPUReq: 100},
+			{Name: "web", Namespace: "default", CPUReq: 50},
+		},
+		Total: 2,
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(out, "\n")
+	// NAME is cut at the maximum width rather than the fixed 40 columns
+	want := truncate(longName, 50)
+	if len([]rune(want)) != 50 || !strings.HasPrefix(lines[2], want+" pay ") {
+		t.Errorf("expected name truncated to 50 runes, got %q", lines[2])
+	}
+	// NAMESPACE is sized to "default" instead of the fixed 15 columns
+	if !strings.Contains(lines[0], "NAMESPACE CPU.REQ ") {
+		t.Errorf("expected auto-sized header, got %q", lines[0])
+	}
+}
+
+func TestFormatResult_TableNodeTop(t *testing.T) {
+	half := 50.0
+	result := &TopResult{
+		Kind: "node",
+		Items: []TopItem{
+			{Name: "node-1", CPUUtil: 1900, CPULimit: 3800, CPUPercent: &half, MemUtil: 6 * 1024 * 1024 * 1024, MemLimit: 12 * 1024 * 1024 * 1024, MemPercent: &half},
+			{Name: "node-2", CPULimit: 3800, MemLimit: 12 * 1024 * 1024 * 1024},
+		},
+		Total: 2,
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"CPU.ALLOC", "CPU%", "MEM%", "node-1", "3.80c", "50.0%", "12.00Gi"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected node top table to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "RESTARTS") {
+		t.Errorf("node top table should not include pod columns, got:\n%s", out)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if last := lines[len(lines)-1]; !strings.HasPrefix(last, "node-2") || strings.Contains(last, "0.0%") || !strings.Contains(last, " - ") {
+		t.Errorf("expected node without metrics to show \"-\" percentages, got %q", last)
+	}
+}
@@ -0,0 +1,214 @@
+package aggregate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// hpaV1ConditionsAnnotation carries the v2 conditions on autoscaling/v1 objects.
+const hpaV1ConditionsAnnotation = "autoscaling.alpha.kubernetes.io/conditions"
+
+// HPAAnalyzer reports HorizontalPodAutoscaler status
+type HPAAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewHPAAnalyzer creates a new HPA analyzer
+func NewHPAAnalyzer(client steve.ResourceReader) *HPAAnalyzer {
+	return &HPAAnalyzer{client: client}
+}
+
+// Analyze lists HPAs and extracts their scaling state
+func (a *HPAAnalyzer) Analyze(ctx context.Context, p HPAParams) (*HPAResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "horizontalpodautoscaler", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list horizontalpodautoscalers: %w", err)
+	}
+
+	items := make([]HPAItem, 0, len(list.Items))
+	for _, obj := range list.Items {
+		items = append(items, extractHPAItem(obj))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	total := len(items)
+	limit := ClampLimit(p.Limit)
+	truncated := total > limit
+	if truncated {
+		items = items[:limit]
+	}
+
+	return &HPAResult{
+		Items:     items,
+		Truncated: truncated,
+		Total:     total,
+	}, nil
+}
+
+// extractHPAItem extracts an HPAItem from an autoscaling/v2 or autoscaling/v1 object.
+// The shape is probed rather than taken from apiVersion: v2 objects carry
+// spec.metrics, v1 objects spec.targetCPUUtilizationPercentage.
+func extractHPAItem(obj unstructured.Unstructured) HPAItem {
+	item := HPAItem{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	kind, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "kind")
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "name")
+	item.Target = kind + "/" + name
+
+	minReplicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "minReplicas")
+	if !found {
+		minReplicas = 1 // API default
+	}
+	item.MinReplicas = int32(minReplicas)
+	maxReplicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "maxReplicas")
+	item.MaxReplicas = int32(maxReplicas)
+	currentReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "currentReplicas")
+	item.CurrentReplicas = int32(currentReplicas)
+	desiredReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredReplicas")
+	item.DesiredReplicas = int32(desiredReplicas)
+
+	if metrics, found, _ := unstructured.NestedSlice(obj.Object, "spec", "metrics"); found {
+		currentMetrics, _, _ := unstructured.NestedSlice(obj.Object, "status", "currentMetrics")
+		item.Metrics = extractV2Metrics(metrics, currentMetrics)
+	} else if target, found, _ := unstructured.NestedInt64(obj.Object, "spec", "targetCPUUtilizationPercentage"); found {
+		metric := HPAMetric{Name: "cpu", Target: fmt.Sprintf("%d%%", target)}
+		if current, found, _ := unstructured.NestedInt64(obj.Object, "status", "currentCPUUtilizationPercentage"); found {
+			metric.Current = fmt.Sprintf("%d%%", current)
+		}
+		item.Metrics = []HPAMetric{metric}
+	}
+
+	if conditions, found, _ := unstructured.NestedSlice(obj.Object, "status", "conditions"); found {
+		item.Conditions = extractHPAConditions(conditions)
+	} else if raw := obj.GetAnnotations()[hpaV1ConditionsAnnotation]; raw != "" {
+		var conditions []interface{}
+		if err := json.Unmarshal([]byte(raw), &conditions); err == nil {
+			item.Conditions = extractHPAConditions(conditions)
+		}
+	}
+
+	creationTime := obj.GetCreationTimestamp()
+	if !creationTime.IsZero() {
+		item.CreatedAt = creationTime.Time
+		item.Age = formatAge(creationTime.Time)
+	}
+
+	return item
+}
+
+// extractV2Metrics pairs each autoscaling/v2 metric spec with its current value.
+func extractV2Metrics(specs, current []interface{}) []HPAMetric {
+	currentByKey := make(map[string]map[string]interface{})
+	for _, c := range current {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		metricType, name, source := hpaMetricSource(m)
+		if value, ok := source["current"].(map[string]interface{}); ok {
+			currentByKey[metricType+"/"+name] = value
+		}
+	}
+
+	metrics := make([]HPAMetric, 0, len(specs))
+	for _, s := range specs {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		metricType, name, source := hpaMetricSource(m)
+		metric := HPAMetric{Name: name, Type: metricType}
+		if target, ok := source["target"].(map[string]interface{}); ok {
+			metric.Target = formatHPAMetricValue(target)
+		}
+		if value, ok := currentByKey[metricType+"/"+name]; ok {
+			metric.Current = formatHPAMetricValue(value)
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
+
+// hpaMetricSource returns the metric type, name and type-specific source block
+// of a v2 metric spec or status. Resource metrics are named after the resource,
+// the other types after their metric.
+func hpaMetricSource(m map[string]interface{}) (metricType, name string, source map[string]interface{}) {
+	metricType, _ = m["type"].(string)
+	if metricType == "" {
+		return "", "", nil
+	}
+	key := strings.ToLower(metricType[:1]) + metricType[1:]
+	source, _ = m[key].(map[string]interface{})
+	if n, ok := source["name"].(string); ok {
+		name = n
+	} else if metric, ok := source["metric"].(map[string]interface{}); ok {
+		name, _ = metric["name"].(string)
+	}
+	return metricType, name, source
+}
+
+// formatHPAMetricValue renders a v2 MetricTarget or MetricValueStatus.
+func formatHPAMetricValue(v map[string]interface{}) string {
+	if u, ok := v["averageUtilization"]; ok {
+		return fmt.Sprintf("%v%%", u)
+	}
+	if s, ok := v["averageValue"]; ok {
+		return fmt.Sprintf("%v", s)
+	}
+	if s, ok := v["value"]; ok {
+		return fmt.Sprintf("%v", s)
+	}
+	return ""
+}
+
+// extractHPAConditions converts raw condition maps to HPAConditions.
+func extractHPAConditions(conditions []interface{}) []HPACondition {
+	result := make([]HPACondition, 0, len(conditions))
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cond := HPACondition{}
+		cond.Type, _ = m["type"].(string)
+		cond.Status, _ = m["status"].(string)
+		cond.Reason, _ = m["reason"].(string)
+		cond.Message, _ = m["message"].(string)
+		result = append(result, cond)
+	}
+	return result
+}
+
+// hpaScalingIssues lists the conditions that explain why an HPA is not scaling
+// freely: AbleToScale or ScalingActive not True, or ScalingLimited True.
+func hpaScalingIssues(conditions []HPACondition) []string {
+	var issues []string
+	for _, c := range conditions {
+		switch {
+		case (c.Type == "AbleToScale" || c.Type == "ScalingActive") && c.Status != "True",
+			c.Type == "ScalingLimited" && c.Status == "True":
+			issues = append(issues, c.Type+"="+c.Status+"("+c.Reason+")")
+		}
+	}
+	return issues
+}
@@ -0,0 +1,135 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeV2HPA() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v2",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"kind": "Deployment", "name": "web"},
+			"minReplicas":    int64(2),
+			"maxReplicas":    int64(10),
+			"metrics": []interface{}{
+				map[string]interface{}{
+					"type": "Resource",
+					"resource": map[string]interface{}{
+						"name":   "cpu",
+						"target": map[string]interface{}{"type": "Utilization", "averageUtilization": int64(80)},
+					},
+				},
+				map[string]interface{}{
+					"type": "Pods",
+					"pods": map[string]interface{}{
+						"metric": map[string]interface{}{"name": "requests_per_second"},
+						"target": map[string]interface{}{"type": "AverageValue", "averageValue": "100"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"currentReplicas": int64(10),
+			"desiredReplicas": int64(10),
+			"currentMetrics": []interface{}{
+				map[string]interface{}{
+					"type": "Resource",
+					"resource": map[string]interface{}{
+						"name":    "cpu",
+						"current": map[string]interface{}{"averageUtilization": int64(95), "averageValue": "950m"},
+					},
+				},
+			},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "AbleToScale", "status": "True", "reason": "ReadyForNewScale"},
+				map[string]interface{}{"type": "ScalingLimited", "status": "True", "reason": "TooManyReplicas", "message": "the desired replica count is more than the maximum replica count"},
+			},
+		},
+	}}
+}
+
+func TestExtractHPAItem_V2(t *testing.T) {
+	item := extractHPAItem(*makeV2HPA())
+
+	if item.Target != "Deployment/web" || item.MinReplicas != 2 || item.MaxReplicas != 10 || item.CurrentReplicas != 10 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if len(item.Metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %+v", item.Metrics)
+	}
+	if m := item.Metrics[0]; m.Name != "cpu" || m.Current != "95%" || m.Target != "80%" {
+		t.Errorf("cpu metric = %+v, want 95%%/80%%", m)
+	}
+	if m := item.Metrics[1]; m.Name != "requests_per_second" || m.Current != "" || m.Target != "100" {
+		t.Errorf("pods metric = %+v, want no current and target 100", m)
+	}
+	if issues := hpaScalingIssues(item.Conditions); len(issues) != 1 || issues[0] != "ScalingLimited=True(TooManyReplicas)" {
+		t.Errorf("scaling issues = %v, want ScalingLimited", issues)
+	}
+}
+
+func TestExtractHPAItem_V1(t *testing.T) {
+	obj := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata": map[string]interface{}{
+			"name":      "api",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				hpaV1ConditionsAnnotation: `[{"type":"ScalingActive","status":"False","reason":"FailedGetResourceMetric"}]`,
+			},
+		},
+		"spec": map[string]interface{}{
+			"scaleTargetRef":                 map[string]interface{}{"kind": "Deployment", "name": "api"},
+			"maxReplicas":                    int64(5),
+			"targetCPUUtilizationPercentage": int64(70),
+		},
+		"status": map[string]interface{}{
+			"currentReplicas":                 int64(1),
+			"currentCPUUtilizationPercentage": int64(40),
+		},
+	}}
+
+	item := extractHPAItem(obj)
+
+	if item.MinReplicas != 1 {
+		t.Errorf("minReplicas = %d, want default 1", item.MinReplicas)
+	}
+	if len(item.Metrics) != 1 || item.Metrics[0].Current != "40%" || item.Metrics[0].Target != "70%" {
+		t.Errorf("metrics = %+v, want cpu 40%%/70%%", item.Metrics)
+	}
+	if issues := hpaScalingIssues(item.Conditions); len(issues) != 1 || !strings.HasPrefix(issues[0], "ScalingActive=False") {
+		t.Errorf("scaling issues = %v, want ScalingActive=False from annotation", issues)
+	}
+}
+
+func TestHPAAnalyzer_AnalyzeTable(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeV2HPA())
+
+	result, err := NewHPAAnalyzer(client).Analyze(context.Background(), HPAParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+	if result.Total != 1 {
+		t.Fatalf("total = %d, want 1", result.Total)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, want := range []string{"Deployment/web", "cpu: 95%/80%", "requests_per_second: <unknown>/100", "ScalingLimited"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
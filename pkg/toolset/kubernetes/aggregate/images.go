@@ -0,0 +1,142 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// podTemplateHashLabel is the label the Deployment controller adds to the pods of a ReplicaSet
+const podTemplateHashLabel = "pod-template-hash"
+
+// ImageAnalyzer builds an inventory of the container images run by pods
+type ImageAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewImageAnalyzer creates a new image inventory analyzer
+func NewImageAnalyzer(client steve.ResourceReader) *ImageAnalyzer {
+	return &ImageAnalyzer{client: client}
+}
+
+// Analyze lists pods and deduplicates the images of their containers
+func (a *ImageAnalyzer) Analyze(ctx context.Context, p ImageParams) (*ImageResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	pods, err := a.client.ListResources(ctx, p.Cluster, "pod", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	items := collectImages(pods.Items)
+	sortImageItems(items)
+
+	total := len(items)
+	limit := ClampLimit(p.Limit)
+	truncated := total > limit
+	if truncated {
+		items = items[:limit]
+	}
+
+	return &ImageResult{
+		Items:     items,
+		Truncated: truncated,
+		Total:     total,
+		Pods:      len(pods.Items),
+	}, nil
+}
+
+// collectImages groups pods by the images of their init, regular and
+// ephemeral containers. A pod running an image in several containers is
+// counted once for it.
+func collectImages(pods []unstructured.Unstructured) []ImageItem {
+	type imageUsage struct {
+		pods       int
+		namespaces map[string]bool
+		workloads  map[string]bool
+	}
+	usage := map[string]*imageUsage{}
+
+	for _, pod := range pods {
+		workload := podWorkload(pod)
+		seen := map[string]bool{}
+		for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
+			containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", field)
+			for _, c := range containers {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				image, _, _ := unstructured.NestedString(container, "image")
+				if image == "" || seen[image] {
+					continue
+				}
+				seen[image] = true
+
+				u, ok := usage[image]
+				if !ok {
+					u = &imageUsage{namespaces: map[string]bool{}, workloads: map[string]bool{}}
+					usage[image] = u
+				}
+				u.pods++
+				u.namespaces[pod.GetNamespace()] = true
+				u.workloads[workload] = true
+			}
+		}
+	}
+
+	items := make([]ImageItem, 0, len(usage))
+	for image, u := range usage {
+		items = append(items, ImageItem{
+			Image:      image,
+			Pods:       u.pods,
+			Namespaces: sortedKeys(u.namespaces),
+			Workloads:  sortedKeys(u.workloads),
+		})
+	}
+	return items
+}
+
+// podWorkload names the workload that manages a pod as namespace/Kind/name.
+// Pods of a Deployment's ReplicaSet are attributed to the Deployment, and
+// pods without a controller to themselves.
+func podWorkload(pod unstructured.Unstructured) string {
+	kind, name := "Pod", pod.GetName()
+	for _, ref := range pod.GetOwnerReferences() {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		kind, name = ref.Kind, ref.Name
+		if hash := pod.GetLabels()[podTemplateHashLabel]; kind == "ReplicaSet" && hash != "" && strings.HasSuffix(name, "-"+hash) {
+			kind, name = "Deployment", strings.TrimSuffix(name, "-"+hash)
+		}
+		break
+	}
+	return pod.GetNamespace() + "/" + kind + "/" + name
+}
+
+// sortImageItems sorts images by pod count (most used first), then by name
+func sortImageItems(items []ImageItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Pods != items[j].Pods {
+			return items[i].Pods > items[j].Pods
+		}
+		return items[i].Image < items[j].Image
+	})
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
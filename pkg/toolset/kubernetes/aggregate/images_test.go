@@ -0,0 +1,110 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeImagePod(name, namespace, ownerKind, ownerName, hash string, images ...string) *unstructured.Unstructured {
+	containers := make([]interface{}, 0, len(images))
+	for i, image := range images {
+		containers = append(containers, map[string]interface{}{"name": fmt.Sprintf("c%d", i), "image": image})
+	}
+	metadata := map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+	}
+	if hash != "" {
+		metadata["labels"] = map[string]interface{}{podTemplateHashLabel: hash}
+	}
+	if ownerKind != "" {
+		metadata["ownerReferences"] = []interface{}{
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": ownerKind, "name": ownerName, "uid": "u-" + ownerName, "controller": true},
+		}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Pod",
+		"metadata": metadata,
+		"spec":     map[string]interface{}{"containers": containers},
+	}}
+}
+
+func TestPodWorkload(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *unstructured.Unstructured
+		want string
+	}{
+		{name: "deployment", pod: makeImagePod("web-7d9c-abcde", "prod", "ReplicaSet", "web-7d9c", "7d9c"), want: "prod/Deployment/web"},
+		{name: "bare replicaset", pod: makeImagePod("rs-xyz", "prod", "ReplicaSet", "rs", ""), want: "prod/ReplicaSet/rs"},
+		{name: "statefulset", pod: makeImagePod("db-0", "prod", "StatefulSet", "db", ""), want: "prod/StatefulSet/db"},
+		{name: "unowned", pod: makeImagePod("debug", "prod", "", "", ""), want: "prod/Pod/debug"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podWorkload(*tt.pod); got != tt.want {
+				t.Errorf("podWorkload() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeImagePod("web-7d9c-a", "prod", "ReplicaSet", "web-7d9c", "7d9c", "nginx:1.27", "envoy:1.30"))
+	client.AddResource(makeImagePod("web-7d9c-b", "prod", "ReplicaSet", "web-7d9c", "7d9c", "nginx:1.27", "envoy:1.30"))
+	client.AddResource(makeImagePod("api-0", "staging", "StatefulSet", "api", "", "nginx:1.27", "nginx:1.27"))
+	client.AddResource(makeImagePod("debug", "staging", "", "", "", "busybox:1.36"))
+
+	result, err := NewImageAnalyzer(client).Analyze(context.Background(), ImageParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+	if result.Total != 3 || result.Pods != 4 {
+		t.Errorf("total = %d, pods = %d, want 3 and 4", result.Total, result.Pods)
+	}
+
+	var order []string
+	for _, item := range result.Items {
+		order = append(order, item.Image)
+	}
+	if got := strings.Join(order, ","); got != "nginx:1.27,envoy:1.30,busybox:1.36" {
+		t.Errorf("order = %s, want nginx:1.27,envoy:1.30,busybox:1.36", got)
+	}
+
+	nginx := result.Items[0]
+	if nginx.Pods != 3 {
+		t.Errorf("nginx pods = %d, want 3 (a pod is counted once per image)", nginx.Pods)
+	}
+	if !reflect.DeepEqual(nginx.Namespaces, []string{"prod", "staging"}) {
+		t.Errorf("nginx namespaces = %v", nginx.Namespaces)
+	}
+	if !reflect.DeepEqual(nginx.Workloads, []string{"prod/Deployment/web", "staging/StatefulSet/api"}) {
+		t.Errorf("nginx workloads = %v", nginx.Workloads)
+	}
+
+	filtered, err := NewImageAnalyzer(client).Analyze(context.Background(), ImageParams{Cluster: "c1", Namespace: "staging", Limit: 1})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+	if filtered.Total != 2 || !filtered.Truncated || len(filtered.Items) != 1 {
+		t.Errorf("filtered result = %+v, want 2 images truncated to 1", filtered)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, want := range []string{"IMAGE", "WORKLOADS", "prod/Deployment/web", "3 distinct images across 4 pods"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// allPodsSelector is shown for NetworkPolicies with an empty pod selector
+const allPodsSelector = "<all pods>"
+
+// NetworkPolicyAnalyzer lists NetworkPolicies and analyzes namespace isolation
+type NetworkPolicyAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewNetworkPolicyAnalyzer creates a new NetworkPolicy analyzer
+func NewNetworkPolicyAnalyzer(client steve.ResourceReader) *NetworkPolicyAnalyzer {
+	return &NetworkPolicyAnalyzer{client: client}
+}
+
+// Analyze lists NetworkPolicies and, if requested, checks which pods they isolate
+func (a *NetworkPolicyAnalyzer) Analyze(ctx context.Context, p NetworkPolicyParams) (*NetworkPolicyResult, error) {
+	list, err := a.client.ListResources(ctx, p.Cluster, "networkpolicy", p.Namespace, &steve.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networkpolicies: %w", err)
+	}
+
+	policies := make([]networkingv1.NetworkPolicy, 0, len(list.Items))
+	items := make([]NetworkPolicyItem, 0, len(list.Items))
+	for _, obj := range list.Items {
+		var policy networkingv1.NetworkPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &policy); err != nil {
+			continue
+		}
+		policies = append(policies, policy)
+		items = append(items, extractNetworkPolicyItem(policy))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	result := &NetworkPolicyResult{Total: len(items)}
+
+	if p.CheckCoverage {
+		pods, err := a.client.ListResources(ctx, p.Cluster, "pod", p.Namespace, &steve.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods: %w", err)
+		}
+		result.Coverage = analyzeNetworkPolicyCoverage(policies, pods.Items)
+	}
+
+	limit := ClampLimit(p.Limit)
+	result.Truncated = len(items) > limit
+	if result.Truncated {
+		items = items[:limit]
+	}
+	result.Items = items
+
+	return result, nil
+}
+
+// extractNetworkPolicyItem summarizes a NetworkPolicy
+func extractNetworkPolicyItem(policy networkingv1.NetworkPolicy) NetworkPolicyItem {
+	item := NetworkPolicyItem{
+		Name:         policy.Name,
+		Namespace:    policy.Namespace,
+		PodSelector:  formatPodSelector(policy.Spec.PodSelector),
+		PolicyTypes:  effectivePolicyTypes(policy.Spec),
+		IngressRules: len(policy.Spec.Ingress),
+		EgressRules:  len(policy.Spec.Egress),
+	}
+
+	// A policy that selects every pod and allows nothing in a direction is a
+	// namespace-wide default deny for that direction.
+	if item.PodSelector == allPodsSelector {
+		if item.IngressRules == 0 && slices.Contains(item.PolicyTypes, string(networkingv1.PolicyTypeIngress)) {
+			item.DefaultDeny = append(item.DefaultDeny, string(networkingv1.PolicyTypeIngress))
+		}
+		if item.EgressRules == 0 && slices.Contains(item.PolicyTypes, string(networkingv1.PolicyTypeEgress)) {
+			item.DefaultDeny = append(item.DefaultDeny, string(networkingv1.PolicyTypeEgress))
+		}
+	}
+
+	if !policy.CreationTimestamp.IsZero() {
+		item.CreatedAt = policy.CreationTimestamp.Time
+		item.Age = formatAge(policy.CreationTimestamp.Time)
+	}
+
+	return item
+}
+
+// effectivePolicyTypes returns spec.policyTypes, applying the API default when it
+// is unset: Ingress always, plus Egress if the policy has egress rules.
+func effectivePolicyTypes(spec networkingv1.NetworkPolicySpec) []string {
+	if len(spec.PolicyTypes) > 0 {
+		types := make([]string, len(spec.PolicyTypes))
+		for i, t := range spec.PolicyTypes {
+			types[i] = string(t)
+		}
+		return types
+	}
+	types := []string{string(networkingv1.PolicyTypeIngress)}
+	if len(spec.Egress) > 0 {
+		types = append(types, string(networkingv1.PolicyTypeEgress))
+	}
+	return types
+}
+
+// formatPodSelector renders a pod selector in label selector syntax
+func formatPodSelector(selector metav1.LabelSelector) string {
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return "<invalid>"
+	}
+	if s.Empty() {
+		return allPodsSelector
+	}
+	return s.String()
+}
+
+// analyzeNetworkPolicyCoverage reports, per namespace with active pods, how many
+// pods are not selected by any NetworkPolicy. Namespaces without any policy are
+// completely unisolated. Namespaces where every pod is selected are omitted.
+func analyzeNetworkPolicyCoverage(policies []networkingv1.NetworkPolicy, pods []unstructured.Unstructured) []NamespaceCoverage {
+	selectors := make(map[string][]labels.Selector)
+	for _, policy := range policies {
+		s, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil {
+			continue
+		}
+		selectors[policy.Namespace] = append(selectors[policy.Namespace], s)
+	}
+
+	byNamespace := make(map[string]*NamespaceCoverage)
+	for _, pod := range pods {
+		phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+		if phase == "Succeeded" || phase == "Failed" {
+			continue
+		}
+		ns := pod.GetNamespace()
+		cov, ok := byNamespace[ns]
+		if !ok {
+			cov = &NamespaceCoverage{Namespace: ns, Policies: len(selectors[ns])}
+			byNamespace[ns] = cov
+		}
+		cov.Pods++
+		podLabels := labels.Set(pod.GetLabels())
+		if !slices.ContainsFunc(selectors[ns], func(s labels.Selector) bool { return s.Matches(podLabels) }) {
+			cov.UnselectedPods++
+		}
+	}
+
+	result := make([]NamespaceCoverage, 0, len(byNamespace))
+	for _, cov := range byNamespace {
+		if cov.UnselectedPods > 0 {
+			result = append(result, *cov)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Namespace < result[j].Namespace
+	})
+	return result
+}
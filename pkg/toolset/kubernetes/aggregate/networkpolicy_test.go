@@ -0,0 +1,102 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeNetworkPolicy(name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "NetworkPolicy",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"spec":       spec,
+	}}
+}
+
+func makeLabeledPod(name, namespace, phase string, podLabels map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Pod",
+		"metadata": map[string]interface{}{"name": name, "namespace": namespace, "labels": podLabels},
+		"status":   map[string]interface{}{"phase": phase},
+	}}
+}
+
+func TestNetworkPolicyAnalyzer_Items(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeNetworkPolicy("default-deny", "prod", map[string]interface{}{
+		"podSelector": map[string]interface{}{},
+		"policyTypes": []interface{}{"Ingress", "Egress"},
+	}))
+	client.AddResource(makeNetworkPolicy("allow-web", "prod", map[string]interface{}{
+		"podSelector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+		"ingress": []interface{}{
+			map[string]interface{}{"from": []interface{}{map[string]interface{}{"podSelector": map[string]interface{}{}}}},
+		},
+	}))
+
+	result, err := NewNetworkPolicyAnalyzer(client).Analyze(context.Background(), NetworkPolicyParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+	if result.Total != 2 || result.Coverage != nil {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	web := result.Items[0]
+	if web.Name != "allow-web" || web.PodSelector != "app=web" || web.IngressRules != 1 || len(web.DefaultDeny) != 0 {
+		t.Errorf("unexpected allow-web item: %+v", web)
+	}
+	if strings.Join(web.PolicyTypes, ",") != "Ingress" {
+		t.Errorf("policy types = %v, want defaulted [Ingress]", web.PolicyTypes)
+	}
+
+	deny := result.Items[1]
+	if deny.PodSelector != allPodsSelector || strings.Join(deny.DefaultDeny, ",") != "Ingress,Egress" {
+		t.Errorf("unexpected default-deny item: %+v", deny)
+	}
+}
+
+func TestNetworkPolicyAnalyzer_Coverage(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeNetworkPolicy("allow-web", "prod", map[string]interface{}{
+		"podSelector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+	}))
+	client.AddResource(makeLabeledPod("web-1", "prod", "Running", map[string]interface{}{"app": "web"}))
+	client.AddResource(makeLabeledPod("worker-1", "prod", "Running", map[string]interface{}{"app": "worker"}))
+	client.AddResource(makeLabeledPod("api-1", "dev", "Running", map[string]interface{}{"app": "api"}))
+	client.AddResource(makeLabeledPod("job-1", "batch", "Succeeded", nil))
+
+	result, err := NewNetworkPolicyAnalyzer(client).Analyze(context.Background(), NetworkPolicyParams{Cluster: "c1", CheckCoverage: true})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+
+	want := []NamespaceCoverage{
+		{Namespace: "dev", Policies: 0, Pods: 1, UnselectedPods: 1},
+		{Namespace: "prod", Policies: 1, Pods: 2, UnselectedPods: 1},
+	}
+	if len(result.Coverage) != len(want) {
+		t.Fatalf("coverage = %+v, want %+v", result.Coverage, want)
+	}
+	for i := range want {
+		if result.Coverage[i] != want[i] {
+			t.Errorf("coverage[%d] = %+v, want %+v", i, result.Coverage[i], want[i])
+		}
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, s := range []string{"allow-web", "app=web", "dev: 1 pods, no policies (no isolation)", "prod: 1 of 2 pods unselected"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected table to contain %q, got:\n%s", s, out)
+		}
+	}
+}
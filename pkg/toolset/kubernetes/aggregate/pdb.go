@@ -0,0 +1,111 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PDBAnalyzer reports PodDisruptionBudget status and which budgets block drains
+type PDBAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewPDBAnalyzer creates a new PDB analyzer
+func NewPDBAnalyzer(client steve.ResourceReader) *PDBAnalyzer {
+	return &PDBAnalyzer{client: client}
+}
+
+// Analyze lists PodDisruptionBudgets and flags those that allow no disruptions
+func (a *PDBAnalyzer) Analyze(ctx context.Context, p PDBParams) (*PDBResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "poddisruptionbudget", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets: %w", err)
+	}
+
+	result := &PDBResult{}
+	items := make([]PDBItem, 0, len(list.Items))
+	for _, obj := range list.Items {
+		var pdb policyv1.PodDisruptionBudget
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &pdb); err != nil {
+			continue
+		}
+		item := extractPDBItem(pdb)
+		if item.BlocksDrain {
+			result.Blocking++
+		} else if p.BlockingOnly {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	// Blocking budgets first, then by namespace and name
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].BlocksDrain != items[j].BlocksDrain {
+			return items[i].BlocksDrain
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	result.Total = len(items)
+	limit := ClampLimit(p.Limit)
+	result.Truncated = result.Total > limit
+	if result.Truncated {
+		items = items[:limit]
+	}
+	result.Items = items
+	return result, nil
+}
+
+// extractPDBItem summarizes a PodDisruptionBudget. A budget blocks drains when
+// it covers pods but allows no disruptions; one that selects no pods cannot
+// block an eviction.
+func extractPDBItem(pdb policyv1.PodDisruptionBudget) PDBItem {
+	item := PDBItem{
+		Name:               pdb.Name,
+		Namespace:          pdb.Namespace,
+		CurrentHealthy:     pdb.Status.CurrentHealthy,
+		DesiredHealthy:     pdb.Status.DesiredHealthy,
+		ExpectedPods:       pdb.Status.ExpectedPods,
+		DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+	}
+	if pdb.Spec.MinAvailable != nil {
+		item.MinAvailable = pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		item.MaxUnavailable = pdb.Spec.MaxUnavailable.String()
+	}
+	if pdb.Spec.Selector != nil {
+		item.Selector = formatPodSelector(*pdb.Spec.Selector)
+	}
+
+	switch {
+	case item.ExpectedPods == 0:
+		item.Warning = "selects no pods"
+	case item.DisruptionsAllowed == 0:
+		item.BlocksDrain = true
+		item.Warning = fmt.Sprintf("blocks drains: %d of %d pods healthy, %d required", item.CurrentHealthy, item.ExpectedPods, item.DesiredHealthy)
+		if c := meta.FindStatusCondition(pdb.Status.Conditions, policyv1.DisruptionAllowedCondition); c != nil && c.Reason != "" {
+			item.Warning += " (" + c.Reason + ")"
+		}
+	}
+
+	if !pdb.CreationTimestamp.IsZero() {
+		item.CreatedAt = pdb.CreationTimestamp.Time
+		item.Age = formatAge(pdb.CreationTimestamp.Time)
+	}
+	return item
+}
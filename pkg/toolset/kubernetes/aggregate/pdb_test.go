@@ -0,0 +1,88 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makePDB(name string, spec map[string]interface{}, expected, healthy, desired, allowed int64, reason string) *unstructured.Unstructured {
+	status := map[string]interface{}{
+		"expectedPods":       expected,
+		"currentHealthy":     healthy,
+		"desiredHealthy":     desired,
+		"disruptionsAllowed": allowed,
+	}
+	if reason != "" {
+		status["conditions"] = []interface{}{
+			map[string]interface{}{"type": "DisruptionAllowed", "status": "False", "reason": reason, "lastTransitionTime": "2025-01-01T00:00:00Z"},
+		}
+	}
+	spec["selector"] = map[string]interface{}{"matchLabels": map[string]interface{}{"app": name}}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "PodDisruptionBudget",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"spec":       spec,
+		"status":     status,
+	}}
+}
+
+func TestPDBAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makePDB("web", map[string]interface{}{"minAvailable": int64(2)}, 3, 3, 2, 1, ""))
+	client.AddResource(makePDB("db", map[string]interface{}{"maxUnavailable": "0%"}, 3, 3, 3, 0, "InsufficientPods"))
+	client.AddResource(makePDB("orphan", map[string]interface{}{"minAvailable": int64(1)}, 0, 0, 1, 0, ""))
+
+	result, err := NewPDBAnalyzer(client).Analyze(context.Background(), PDBParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Total != 3 || result.Blocking != 1 {
+		t.Fatalf("result = %+v", result)
+	}
+
+	db := result.Items[0]
+	if db.Name != "db" || !db.BlocksDrain {
+		t.Fatalf("first item = %+v, want the blocking db budget", db)
+	}
+	if db.MaxUnavailable != "0%" || db.MinAvailable != "" || db.Selector != "app=db" {
+		t.Errorf("db spec = %+v", db)
+	}
+	if db.Warning != "blocks drains: 3 of 3 pods healthy, 3 required (InsufficientPods)" {
+		t.Errorf("db warning = %q", db.Warning)
+	}
+
+	byName := map[string]PDBItem{}
+	for _, item := range result.Items {
+		byName[item.Name] = item
+	}
+	if web := byName["web"]; web.BlocksDrain || web.Warning != "" || web.MinAvailable != "2" || web.DisruptionsAllowed != 1 {
+		t.Errorf("web = %+v", web)
+	}
+	if orphan := byName["orphan"]; orphan.BlocksDrain || orphan.Warning != "selects no pods" {
+		t.Errorf("orphan = %+v, a budget without pods must not block drains", orphan)
+	}
+
+	result, err = NewPDBAnalyzer(client).Analyze(context.Background(), PDBParams{Cluster: "c1", BlockingOnly: true})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Total != 1 || result.Items[0].Name != "db" {
+		t.Fatalf("blockingOnly result = %+v", result)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"ALLOWED DISRUPTIONS", "InsufficientPods", "1 PodDisruptionBudgets allow no disruptions"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PVCAnalyzer reports PersistentVolumeClaim status and capacity
+type PVCAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewPVCAnalyzer creates a new PVC analyzer
+func NewPVCAnalyzer(client steve.ResourceReader) *PVCAnalyzer {
+	return &PVCAnalyzer{client: client}
+}
+
+// Analyze lists PVCs and summarizes their binding and capacity
+func (a *PVCAnalyzer) Analyze(ctx context.Context, p PVCParams) (*PVCResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "persistentvolumeclaim", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims: %w", err)
+	}
+
+	items := make([]PVCItem, 0, len(list.Items))
+	pending := 0
+	for _, obj := range list.Items {
+		item := extractPVCItem(obj)
+		if item.Phase == "Pending" {
+			pending++
+		}
+		items = append(items, item)
+	}
+
+	sortPVCItems(items)
+
+	total := len(items)
+	limit := ClampLimit(p.Limit)
+	truncated := total > limit
+	if truncated {
+		items = items[:limit]
+	}
+
+	return &PVCResult{
+		Items:     items,
+		Truncated: truncated,
+		Total:     total,
+		Pending:   pending,
+	}, nil
+}
+
+// extractPVCItem extracts a PVCItem from an unstructured PersistentVolumeClaim
+func extractPVCItem(obj unstructured.Unstructured) PVCItem {
+	item := PVCItem{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	item.Phase, _, _ = unstructured.NestedString(obj.Object, "status", "phase")
+	item.StorageClass, _, _ = unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	item.Volume, _, _ = unstructured.NestedString(obj.Object, "spec", "volumeName")
+	item.AccessModes, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "accessModes")
+
+	requested, _, _ := unstructured.NestedString(obj.Object, "spec", "resources", "requests", "storage")
+	item.Requested = resourceQuantityToBytes(requested)
+	capacity, _, _ := unstructured.NestedString(obj.Object, "status", "capacity", "storage")
+	item.Capacity = resourceQuantityToBytes(capacity)
+
+	item.Warning = derivePVCWarning(item)
+
+	creationTime := obj.GetCreationTimestamp()
+	if !creationTime.IsZero() {
+		item.CreatedAt = creationTime.Time
+		item.Age = formatAge(creationTime.Time)
+	}
+
+	return item
+}
+
+// derivePVCWarning flags PVCs that need attention
+func derivePVCWarning(item PVCItem) string {
+	switch {
+	case item.Phase == "Pending":
+		return "stuck in Pending"
+	case item.Phase == "Lost":
+		return "bound volume lost"
+	case item.Capacity > 0 && item.Capacity < item.Requested:
+		return "resize pending"
+	default:
+		return ""
+	}
+}
+
+// sortPVCItems sorts PVCs by requested size (largest first), then namespace and name
+func sortPVCItems(items []PVCItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Requested != b.Requested {
+			return a.Requested > b.Requested
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+// accessModeAbbreviations mirrors the short forms kubectl prints
+var accessModeAbbreviations = map[string]string{
+	"ReadWriteOnce":    "RWO",
+	"ReadOnlyMany":     "ROX",
+	"ReadWriteMany":    "RWX",
+	"ReadWriteOncePod": "RWOP",
+}
+
+// abbreviateAccessModes returns the kubectl-style short form of access modes
+func abbreviateAccessModes(modes []string) []string {
+	result := make([]string, len(modes))
+	for i, m := range modes {
+		if short, ok := accessModeAbbreviations[m]; ok {
+			result[i] = short
+		} else {
+			result[i] = m
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makePVC(name, namespace, phase, requested, capacity string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "PersistentVolumeClaim",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"storageClassName": "longhorn",
+			"accessModes":      []interface{}{"ReadWriteOnce"},
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{"storage": requested},
+			},
+		},
+		"status": map[string]interface{}{"phase": phase},
+	}}
+	if capacity != "" {
+		_ = unstructured.SetNestedField(obj.Object, "pvc-"+name, "spec", "volumeName")
+		_ = unstructured.SetNestedField(obj.Object, capacity, "status", "capacity", "storage")
+	}
+	return obj
+}
+
+func TestExtractPVCItem(t *testing.T) {
+	item := extractPVCItem(*makePVC("data", "db", "Bound", "10Gi", "10Gi"))
+
+	if item.Phase != "Bound" || item.StorageClass != "longhorn" || item.Volume != "pvc-data" {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if item.Requested != 10*1024*1024*1024 || item.Capacity != item.Requested {
+		t.Errorf("requested = %d, capacity = %d, want 10Gi each", item.Requested, item.Capacity)
+	}
+	if len(item.AccessModes) != 1 || item.AccessModes[0] != "ReadWriteOnce" {
+		t.Errorf("access modes = %v, want [ReadWriteOnce]", item.AccessModes)
+	}
+	if item.Warning != "" {
+		t.Errorf("expected no warning for a bound PVC, got %q", item.Warning)
+	}
+}
+
+func TestDerivePVCWarning(t *testing.T) {
+	tests := []struct {
+		name string
+		item PVCItem
+		want string
+	}{
+		{name: "bound", item: PVCItem{Phase: "Bound", Requested: 10, Capacity: 10}, want: ""},
+		{name: "pending", item: PVCItem{Phase: "Pending", Requested: 10}, want: "stuck in Pending"},
+		{name: "lost", item: PVCItem{Phase: "Lost", Requested: 10}, want: "bound volume lost"},
+		{name: "resize pending", item: PVCItem{Phase: "Bound", Requested: 20, Capacity: 10}, want: "resize pending"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := derivePVCWarning(tt.item); got != tt.want {
+				t.Errorf("derivePVCWarning() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPVCAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makePVC("small", "db", "Bound", "1Gi", "1Gi"))
+	client.AddResource(makePVC("large", "db", "Bound", "100Gi", "100Gi"))
+	client.AddResource(makePVC("waiting", "web", "Pending", "5Gi", ""))
+
+	result, err := NewPVCAnalyzer(client).Analyze(context.Background(), PVCParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, item := range result.Items {
+		names = append(names, item.Name)
+	}
+	if got := strings.Join(names, ","); got != "large,waiting,small" {
+		t.Errorf("order = %s, want large,waiting,small", got)
+	}
+	if result.Total != 3 || result.Pending != 1 {
+		t.Errorf("total = %d, pending = %d, want 3 and 1", result.Total, result.Pending)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, want := range []string{"REQUESTED", "CAPACITY", "RWO", "stuck in Pending", "1 of 3 PVCs are Pending"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// quotaNearLimitPercent is the usage at which a quota entry is flagged as near its limit
+const quotaNearLimitPercent = 90
+
+// QuotaAnalyzer reports ResourceQuota usage and LimitRange defaults of a namespace
+type QuotaAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewQuotaAnalyzer creates a new namespace quota analyzer
+func NewQuotaAnalyzer(client steve.ResourceReader) *QuotaAnalyzer {
+	return &QuotaAnalyzer{client: client}
+}
+
+// Analyze lists the ResourceQuotas and LimitRanges of a namespace
+func (a *QuotaAnalyzer) Analyze(ctx context.Context, p QuotaParams) (*QuotaResult, error) {
+	quotas, err := a.client.ListResources(ctx, p.Cluster, "resourcequota", p.Namespace, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resourcequotas: %w", err)
+	}
+	limitRanges, err := a.client.ListResources(ctx, p.Cluster, "limitrange", p.Namespace, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limitranges: %w", err)
+	}
+
+	result := &QuotaResult{
+		Namespace:   p.Namespace,
+		Quotas:      []QuotaItem{},
+		LimitRanges: []LimitRangeItem{},
+	}
+	for _, obj := range quotas.Items {
+		for _, item := range extractQuotaItems(obj) {
+			if item.Warning != "" {
+				result.Attention++
+			}
+			result.Quotas = append(result.Quotas, item)
+		}
+	}
+	for _, obj := range limitRanges.Items {
+		result.LimitRanges = append(result.LimitRanges, extractLimitRangeItems(obj)...)
+	}
+
+	sort.SliceStable(result.Quotas, func(i, j int) bool {
+		a, b := result.Quotas[i], result.Quotas[j]
+		if a.Quota != b.Quota {
+			return a.Quota < b.Quota
+		}
+		return a.Resource < b.Resource
+	})
+	sort.SliceStable(result.LimitRanges, func(i, j int) bool {
+		a, b := result.LimitRanges[i], result.LimitRanges[j]
+		if a.LimitRange != b.LimitRange {
+			return a.LimitRange < b.LimitRange
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Resource < b.Resource
+	})
+
+	return result, nil
+}
+
+// extractQuotaItems returns one entry per resource tracked by a ResourceQuota.
+// The enforced limits in status.hard are preferred over spec.hard, which the
+// quota controller may not have reconciled yet.
+func extractQuotaItems(obj unstructured.Unstructured) []QuotaItem {
+	hard, found, _ := unstructured.NestedStringMap(obj.Object, "status", "hard")
+	if !found || len(hard) == 0 {
+		hard, _, _ = unstructured.NestedStringMap(obj.Object, "spec", "hard")
+	}
+	used, _, _ := unstructured.NestedStringMap(obj.Object, "status", "used")
+
+	items := make([]QuotaItem, 0, len(hard))
+	for name, hardValue := range hard {
+		item := QuotaItem{
+			Quota:    obj.GetName(),
+			Resource: name,
+			Hard:     hardValue,
+			Used:     used[name],
+		}
+		hardMilli := resourceQuantityToMilli(hardValue)
+		usedMilli := resourceQuantityToMilli(item.Used)
+		item.Percent = calcPercentage(usedMilli, hardMilli)
+		item.Warning = deriveQuotaWarning(usedMilli, hardMilli, item.Percent)
+		items = append(items, item)
+	}
+	return items
+}
+
+// deriveQuotaWarning flags quota entries that are near, at or over their hard limit
+func deriveQuotaWarning(used, hard int64, percent float64) string {
+	switch {
+	case used > hard:
+		return "over limit"
+	case hard > 0 && used == hard:
+		return "at limit"
+	case percent >= quotaNearLimitPercent:
+		return "near limit"
+	default:
+		return ""
+	}
+}
+
+// extractLimitRangeItems returns one entry per resource constrained by each
+// limit of a LimitRange
+func extractLimitRangeItems(obj unstructured.Unstructured) []LimitRangeItem {
+	limits, _, _ := unstructured.NestedSlice(obj.Object, "spec", "limits")
+
+	var items []LimitRangeItem
+	for _, l := range limits {
+		limit, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		limitType, _, _ := unstructured.NestedString(limit, "type")
+		minValues, _, _ := unstructured.NestedStringMap(limit, "min")
+		maxValues, _, _ := unstructured.NestedStringMap(limit, "max")
+		defaultRequests, _, _ := unstructured.NestedStringMap(limit, "defaultRequest")
+		defaults, _, _ := unstructured.NestedStringMap(limit, "default")
+
+		resources := map[string]bool{}
+		for _, m := range []map[string]string{minValues, maxValues, defaultRequests, defaults} {
+			for name := range m {
+				resources[name] = true
+			}
+		}
+		for name := range resources {
+			items = append(items, LimitRangeItem{
+				LimitRange:     obj.GetName(),
+				Type:           limitType,
+				Resource:       name,
+				Min:            minValues[name],
+				Max:            maxValues[name],
+				DefaultRequest: defaultRequests[name],
+				Default:        defaults[name],
+			})
+		}
+	}
+	return items
+}
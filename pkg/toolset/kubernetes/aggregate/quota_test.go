@@ -0,0 +1,125 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeResourceQuota(name, namespace string, hard, used map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "ResourceQuota",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec":   map[string]interface{}{"hard": hard},
+		"status": map[string]interface{}{"hard": hard, "used": used},
+	}}
+}
+
+func TestDeriveQuotaWarning(t *testing.T) {
+	tests := []struct {
+		name       string
+		used, hard int64
+		want       string
+	}{
+		{name: "well under", used: 500, hard: 1000, want: ""},
+		{name: "near", used: 950, hard: 1000, want: "near limit"},
+		{name: "at", used: 1000, hard: 1000, want: "at limit"},
+		{name: "over", used: 1200, hard: 1000, want: "over limit"},
+		{name: "zero hard unused", used: 0, hard: 0, want: ""},
+		{name: "zero hard used", used: 1000, hard: 0, want: "over limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := deriveQuotaWarning(tt.used, tt.hard, calcPercentage(tt.used, tt.hard))
+			if got != tt.want {
+				t.Errorf("deriveQuotaWarning() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractQuotaItems_FallsBackToSpecHard(t *testing.T) {
+	obj := makeResourceQuota("compute", "team-a", map[string]interface{}{"pods": "10"}, nil)
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	items := extractQuotaItems(*obj)
+	if len(items) != 1 || items[0].Hard != "10" || items[0].Used != "" || items[0].Percent != 0 {
+		t.Errorf("unexpected items: %+v", items)
+	}
+}
+
+func TestQuotaAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeResourceQuota("compute", "team-a",
+		map[string]interface{}{"requests.cpu": "4", "requests.memory": "8Gi", "pods": "10"},
+		map[string]interface{}{"requests.cpu": "3800m", "requests.memory": "2Gi", "pods": "12"},
+	))
+	client.AddResource(makeResourceQuota("other", "team-b",
+		map[string]interface{}{"pods": "5"},
+		map[string]interface{}{"pods": "5"},
+	))
+	client.AddResource(&unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "LimitRange",
+		"metadata": map[string]interface{}{
+			"name":      "defaults",
+			"namespace": "team-a",
+		},
+		"spec": map[string]interface{}{
+			"limits": []interface{}{
+				map[string]interface{}{
+					"type":           "Container",
+					"default":        map[string]interface{}{"cpu": "500m", "memory": "512Mi"},
+					"defaultRequest": map[string]interface{}{"cpu": "100m"},
+					"max":            map[string]interface{}{"memory": "2Gi"},
+				},
+			},
+		},
+	}})
+
+	result, err := NewQuotaAnalyzer(client).Analyze(context.Background(), QuotaParams{Cluster: "c1", Namespace: "team-a"})
+	if err != nil {
+		t.Fatalf("Analyze() unexpected error: %v", err)
+	}
+
+	var resources []string
+	for _, item := range result.Quotas {
+		resources = append(resources, item.Resource+"="+item.Warning)
+	}
+	if got := strings.Join(resources, ","); got != "pods=over limit,requests.cpu=near limit,requests.memory=" {
+		t.Errorf("quota entries = %s", got)
+	}
+	if result.Attention != 2 {
+		t.Errorf("attention = %d, want 2", result.Attention)
+	}
+	if got := result.Quotas[2].Percent; got != 25 {
+		t.Errorf("requests.memory percent = %v, want 25", got)
+	}
+
+	if len(result.LimitRanges) != 2 {
+		t.Fatalf("limit range entries = %+v, want cpu and memory", result.LimitRanges)
+	}
+	cpu, memory := result.LimitRanges[0], result.LimitRanges[1]
+	if cpu.Resource != "cpu" || cpu.Default != "500m" || cpu.DefaultRequest != "100m" || cpu.Max != "" {
+		t.Errorf("unexpected cpu entry: %+v", cpu)
+	}
+	if memory.Resource != "memory" || memory.Default != "512Mi" || memory.Max != "2Gi" {
+		t.Errorf("unexpected memory entry: %+v", memory)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() unexpected error: %v", err)
+	}
+	for _, want := range []string{"USE%", "95.0%", "120.0%", "DEFAULT-REQUEST", "512Mi", "2 of 3 quota entries are near or over their limit"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
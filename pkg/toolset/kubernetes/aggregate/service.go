@@ -0,0 +1,272 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// How a service's readiness is determined, depending on its type
+const (
+	ReadinessPods         = "pods"
+	ReadinessEndpoints    = "endpoints"
+	ReadinessExternalName = "externalName"
+)
+
+// ServiceHealthAnalyzer reports how many backend pods of each Service are ready
+type ServiceHealthAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewServiceHealthAnalyzer creates a new service health analyzer
+func NewServiceHealthAnalyzer(client steve.ResourceReader) *ServiceHealthAnalyzer {
+	return &ServiceHealthAnalyzer{client: client}
+}
+
+// Analyze lists Services and counts the ready pods matched by their selectors
+func (a *ServiceHealthAnalyzer) Analyze(ctx context.Context, p ServiceHealthParams) (*ServiceHealthResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	list, err := a.client.ListResources(ctx, p.Cluster, "service", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	services := make([]corev1.Service, 0, len(list.Items))
+	for _, obj := range list.Items {
+		var svc corev1.Service
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &svc); err != nil {
+			continue
+		}
+		services = append(services, svc)
+	}
+
+	// The label selector filters services, not their backends, so pods are listed unfiltered
+	podList, err := a.client.ListResources(ctx, p.Cluster, "pod", p.Namespace, &steve.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	podsByNamespace := make(map[string][]corev1.Pod)
+	for _, obj := range podList.Items {
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &pod); err != nil {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podsByNamespace[pod.Namespace] = append(podsByNamespace[pod.Namespace], pod)
+	}
+
+	// Headless and selectorless services are judged by their endpoints; only
+	// list slices when there are any
+	var slicesByService map[string][]discoveryv1.EndpointSlice
+	if slices.ContainsFunc(services, usesEndpointReadiness) {
+		slicesByService, err = a.listEndpointSlices(ctx, p.Cluster, p.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &ServiceHealthResult{Services: len(services)}
+	items := make([]ServiceHealthItem, 0, len(services))
+	for _, svc := range services {
+		var item ServiceHealthItem
+		switch {
+		case svc.Spec.Type == corev1.ServiceTypeExternalName:
+			item = extractExternalNameServiceItem(svc)
+		case usesEndpointReadiness(svc):
+			item = extractEndpointServiceItem(svc, slicesByService[svc.Namespace+"/"+svc.Name])
+		default:
+			item = extractServiceHealthItem(svc, podsByNamespace[svc.Namespace])
+		}
+		if !item.Ready {
+			result.NotReady++
+		}
+		if item.Degraded {
+			result.Degraded++
+		}
+		if p.UnhealthyOnly && item.Healthy() {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	// Not ready services first, then degraded ones, then by namespace and name
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Ready != items[j].Ready {
+			return !items[i].Ready
+		}
+		if items[i].Degraded != items[j].Degraded {
+			return items[i].Degraded
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	result.Total = len(items)
+	limit := ClampLimit(p.Limit)
+	result.Truncated = result.Total > limit
+	if result.Truncated {
+		items = items[:limit]
+	}
+	result.Items = items
+	return result, nil
+}
+
+// listEndpointSlices returns the EndpointSlices in the namespace keyed by
+// the "namespace/name" of the Service they belong to
+func (a *ServiceHealthAnalyzer) listEndpointSlices(ctx context.Context, cluster, namespace string) (map[string][]discoveryv1.EndpointSlice, error) {
+	list, err := a.client.ListResources(ctx, cluster, "endpointslice", namespace, &steve.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpointslices: %w", err)
+	}
+	byService := make(map[string][]discoveryv1.EndpointSlice)
+	for _, obj := range list.Items {
+		var slice discoveryv1.EndpointSlice
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &slice); err != nil {
+			continue
+		}
+		service := slice.Labels[discoveryv1.LabelServiceName]
+		if service == "" {
+			continue
+		}
+		key := slice.Namespace + "/" + service
+		byService[key] = append(byService[key], slice)
+	}
+	return byService, nil
+}
+
+// isHeadlessService reports whether the service has no cluster IP
+func isHeadlessService(svc corev1.Service) bool {
+	return svc.Spec.ClusterIP == corev1.ClusterIPNone
+}
+
+// usesEndpointReadiness reports whether the service is judged by its
+// EndpointSlices rather than pods: headless services, and services without
+// a selector, whose endpoints are managed by something other than the
+// endpoint controller
+func usesEndpointReadiness(svc corev1.Service) bool {
+	return svc.Spec.Type != corev1.ServiceTypeExternalName && (isHeadlessService(svc) || len(svc.Spec.Selector) == 0)
+}
+
+// newServiceHealthItem fills the fields shared by all service types
+func newServiceHealthItem(svc corev1.Service, readiness string) ServiceHealthItem {
+	item := ServiceHealthItem{
+		Name:      svc.Name,
+		Namespace: svc.Namespace,
+		Type:      string(svc.Spec.Type),
+		ClusterIP: svc.Spec.ClusterIP,
+		Selector:  labels.Set(svc.Spec.Selector).String(),
+		Headless:  isHeadlessService(svc),
+		Readiness: readiness,
+	}
+	if item.Type == "" {
+		item.Type = string(corev1.ServiceTypeClusterIP)
+	}
+	if !svc.CreationTimestamp.IsZero() {
+		item.CreatedAt = svc.CreationTimestamp.Time
+		item.Age = formatAge(svc.CreationTimestamp.Time)
+	}
+	return item
+}
+
+// extractExternalNameServiceItem summarizes an ExternalName Service. It is
+// only a DNS alias without backends, so it is ready when externalName is set.
+func extractExternalNameServiceItem(svc corev1.Service) ServiceHealthItem {
+	item := newServiceHealthItem(svc, ReadinessExternalName)
+	item.ExternalName = svc.Spec.ExternalName
+	item.Ready = item.ExternalName != ""
+	if item.Ready {
+		item.Message = "alias for " + item.ExternalName
+	} else {
+		item.Message = "externalName not set"
+	}
+	return item
+}
+
+// extractEndpointServiceItem counts the endpoints of a headless or
+// selectorless Service. Clients of a headless Service resolve its endpoint
+// addresses directly through DNS, and the endpoints of a Service without a
+// selector are managed by hand or by another controller, so pods are not
+// consulted. An endpoint whose ready condition is unset is treated as ready.
+func extractEndpointServiceItem(svc corev1.Service, endpointSlices []discoveryv1.EndpointSlice) ServiceHealthItem {
+	item := newServiceHealthItem(svc, ReadinessEndpoints)
+	prefix := "headless"
+	if !item.Headless {
+		prefix = "no selector"
+	}
+	for _, slice := range endpointSlices {
+		for _, endpoint := range slice.Endpoints {
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			for range endpoint.Addresses {
+				item.TotalBackends++
+				if ready {
+					item.ReadyBackends++
+				}
+			}
+		}
+	}
+	switch {
+	case item.TotalBackends == 0:
+		item.Message = prefix + ", no endpoints"
+	case item.ReadyBackends == 0:
+		item.Message = prefix + ", no ready endpoints"
+	}
+	item.Ready = item.ReadyBackends > 0
+	item.Degraded = item.Ready && item.ReadyBackends < item.TotalBackends
+	return item
+}
+
+// extractServiceHealthItem counts the pods selected by a Service. It is ready
+// when at least one backend pod is ready, and degraded when only some are.
+func extractServiceHealthItem(svc corev1.Service, pods []corev1.Pod) ServiceHealthItem {
+	item := newServiceHealthItem(svc, ReadinessPods)
+
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	for _, pod := range pods {
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		item.TotalBackends++
+		if podIsReady(pod) {
+			item.ReadyBackends++
+		}
+	}
+	switch {
+	case item.TotalBackends == 0:
+		item.Message = "selector matches no pods"
+	case item.ReadyBackends == 0:
+		item.Message = "no ready pods"
+	}
+	item.Ready = item.ReadyBackends > 0
+	item.Degraded = item.Ready && item.ReadyBackends < item.TotalBackends
+	return item
+}
+
+// podIsReady reports whether the pod's Ready condition is true
+func podIsReady(pod corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// Healthy reports whether all backends of the service are ready
+func (i ServiceHealthItem) Healthy() bool {
+	return i.Ready && !i.Degraded
+}
@@ -0,0 +1,301 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeService(name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"spec":       spec,
+	}}
+}
+
+func makeBackendPod(name, namespace, app, phase string, ready bool) *unstructured.Unstructured {
+	status := "False"
+	if ready {
+		status = "True"
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+			"labels":    map[string]interface{}{"app": app},
+		},
+		"status": map[string]interface{}{
+			"phase": phase,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": status},
+			},
+		},
+	}}
+}
+
+func selectorSpec(app string) map[string]interface{} {
+	return map[string]interface{}{"selector": map[string]interface{}{"app": app}}
+}
+
+func TestServiceHealthAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeService("web", "default", selectorSpec("web")))
+	client.AddResource(makeService("api", "default", selectorSpec("api")))
+	client.AddResource(makeService("db", "default", selectorSpec("db")))
+	client.AddResource(makeService("orphan", "default", selectorSpec("orphan")))
+	client.AddResource(makeService("manual", "default", map[string]interface{}{}))
+	// Same labels in another namespace must not count as backends
+	client.AddResource(makeService("web", "staging", selectorSpec("web")))
+
+	client.AddResource(makeBackendPod("web-1", "default", "web", "Running", true))
+	client.AddResource(makeBackendPod("web-2", "default", "web", "Running", true))
+	client.AddResource(makeBackendPod("api-1", "default", "api", "Running", true))
+	client.AddResource(makeBackendPod("api-2", "default", "api", "Running", false))
+	client.AddResource(makeBackendPod("api-3", "default", "api", "Running", false))
+	client.AddResource(makeBackendPod("api-old", "default", "api", "Succeeded", false))
+	client.AddResource(makeBackendPod("db-1", "default", "db", "Pending", false))
+	// A service without a selector is judged by the endpoints managed for it
+	client.AddResource(makeServiceEndpointSlice("manual-a", "default", "manual", true, false))
+
+	result, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Services != 6 || result.Total != 6 || result.NotReady != 3 || result.Degraded != 2 {
+		t.Fatalf("result = %+v", result)
+	}
+
+	byKey := map[string]ServiceHealthItem{}
+	for _, item := range result.Items {
+		byKey[item.Namespace+"/"+item.Name] = item
+	}
+	tests := []struct {
+		key           string
+		ready, total  int
+		wantReady     bool
+		wantDegraded  bool
+		wantMessage   string
+		wantSelector  string
+		wantType      string
+		wantIsHealthy bool
+	}{
+		{key: "default/web", ready: 2, total: 2, wantReady: true, wantSelector: "app=web", wantType: "ClusterIP", wantIsHealthy: true},
+		{key: "default/api", ready: 1, total: 3, wantReady: true, wantDegraded: true, wantSelector: "app=api", wantType: "ClusterIP"},
+		{key: "default/db", ready: 0, total: 1, wantMessage: "no ready pods", wantSelector: "app=db", wantType: "ClusterIP"},
+		{key: "default/orphan", wantMessage: "selector matches no pods", wantSelector: "app=orphan", wantType: "ClusterIP"},
+		{key: "default/manual", ready: 1, total: 2, wantReady: true, wantDegraded: true, wantType: "ClusterIP"},
+		{key: "staging/web", wantMessage: "selector matches no pods", wantSelector: "app=web", wantType: "ClusterIP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			item, ok := byKey[tt.key]
+			if !ok {
+				t.Fatalf("missing %s", tt.key)
+			}
+			if item.ReadyBackends != tt.ready || item.TotalBackends != tt.total {
+				t.Errorf("backends = %d/%d, want %d/%d", item.ReadyBackends, item.TotalBackends, tt.ready, tt.total)
+			}
+			if item.Ready != tt.wantReady || item.Degraded != tt.wantDegraded || item.Healthy() != tt.wantIsHealthy {
+				t.Errorf("ready=%v degraded=%v healthy=%v", item.Ready, item.Degraded, item.Healthy())
+			}
+			if item.Message != tt.wantMessage || item.Selector != tt.wantSelector || item.Type != tt.wantType {
+				t.Errorf("item = %+v", item)
+			}
+		})
+	}
+
+	// Not ready services sort before degraded ones, healthy ones last
+	if result.Items[0].Name != "db" || result.Items[3].Name != "api" || result.Items[4].Name != "manual" || result.Items[5].Name != "web" {
+		t.Errorf("unexpected order: %+v", result.Items)
+	}
+}
+
+func TestServiceHealthAnalyzer_Analyze_UnhealthyOnlyAndTable(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeService("web", "default", selectorSpec("web")))
+	client.AddResource(makeService("api", "default", selectorSpec("api")))
+	client.AddResource(makeBackendPod("web-1", "default", "web", "Running", true))
+	client.AddResource(makeBackendPod("api-1", "default", "api", "Running", true))
+	client.AddResource(makeBackendPod("api-2", "default", "api", "Running", false))
+
+	result, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1", UnhealthyOnly: true})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Total != 1 || result.Items[0].Name != "api" || result.Services != 2 {
+		t.Fatalf("unhealthyOnly result = %+v", result)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"BACKENDS", "DEGRADED", "1/2", "Yes", "0 not ready, 1 degraded of 2 services"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+
+	out, err = FormatResult(&ServiceHealthResult{Services: 2}, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	if !strings.Contains(out, "All 2 services have all backends ready") {
+		t.Errorf("unexpected empty table: %q", out)
+	}
+}
+
+func makeServiceEndpointSlice(name, namespace, service string, ready ...interface{}) *unstructured.Unstructured {
+	endpoints := make([]interface{}, 0, len(ready))
+	for i, r := range ready {
+		endpoint := map[string]interface{}{"addresses": []interface{}{fmt.Sprintf("10.0.0.%d", i+1)}}
+		if r != nil {
+			endpoint["conditions"] = map[string]interface{}{"ready": r}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "discovery.k8s.io/v1",
+		"kind":       "EndpointSlice",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+			"labels":    map[string]interface{}{"kubernetes.io/service-name": service},
+		},
+		"addressType": "IPv4",
+		"endpoints":   endpoints,
+	}}
+}
+
+func TestServiceHealthAnalyzer_Analyze_ServiceTypes(t *testing.T) {
+	headless := func(selector map[string]interface{}) map[string]interface{} {
+		spec := map[string]interface{}{"type": "ClusterIP", "clusterIP": "None"}
+		if selector != nil {
+			spec["selector"] = selector
+		}
+		return spec
+	}
+
+	client := fake.NewClient()
+	client.AddResource(makeService("cluster-ip", "default", map[string]interface{}{
+		"type": "ClusterIP", "clusterIP": "10.43.0.10", "selector": map[string]interface{}{"app": "web"},
+	}))
+	client.AddResource(makeService("node-port", "default", map[string]interface{}{
+		"type": "NodePort", "clusterIP": "10.43.0.11", "selector": map[string]interface{}{"app": "web"},
+	}))
+	client.AddResource(makeService("load-balancer", "default", map[string]interface{}{
+		"type": "LoadBalancer", "clusterIP": "10.43.0.12", "selector": map[string]interface{}{"app": "web"},
+	}))
+	// The pods of this headless service are not ready, but its endpoints are what count
+	client.AddResource(makeService("db", "default", headless(map[string]interface{}{"app": "db"})))
+	client.AddResource(makeService("db-partial", "default", headless(map[string]interface{}{"app": "db"})))
+	client.AddResource(makeService("external-db", "default", headless(nil)))
+	client.AddResource(makeService("headless-empty", "default", headless(map[string]interface{}{"app": "none"})))
+	client.AddResource(makeService("manual-empty", "default", map[string]interface{}{"type": "ClusterIP", "clusterIP": "10.43.0.13"}))
+	client.AddResource(makeService("alias", "default", map[string]interface{}{
+		"type": "ExternalName", "externalName": "db.example.com",
+	}))
+	client.AddResource(makeService("broken-alias", "default", map[string]interface{}{"type": "ExternalName"}))
+
+	client.AddResource(makeBackendPod("web-1", "default", "web", "Running", true))
+	client.AddResource(makeBackendPod("web-2", "default", "web", "Running", false))
+	client.AddResource(makeBackendPod("db-0", "default", "db", "Running", false))
+
+	client.AddResource(makeServiceEndpointSlice("db-a", "default", "db", true, nil))
+	client.AddResource(makeServiceEndpointSlice("db-b", "default", "db", true))
+	client.AddResource(makeServiceEndpointSlice("db-partial-a", "default", "db-partial", true, false))
+	client.AddResource(makeServiceEndpointSlice("external-db-a", "default", "external-db", false))
+
+	result, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1"})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	byName := map[string]ServiceHealthItem{}
+	for _, item := range result.Items {
+		byName[item.Name] = item
+	}
+
+	tests := []struct {
+		name         string
+		readiness    string
+		headless     bool
+		ready, total int
+		wantReady    bool
+		wantDegraded bool
+		wantMessage  string
+	}{
+		{name: "cluster-ip", readiness: ReadinessPods, ready: 1, total: 2, wantReady: true, wantDegraded: true},
+		{name: "node-port", readiness: ReadinessPods, ready: 1, total: 2, wantReady: true, wantDegraded: true},
+		{name: "load-balancer", readiness: ReadinessPods, ready: 1, total: 2, wantReady: true, wantDegraded: true},
+		{name: "db", readiness: ReadinessEndpoints, headless: true, ready: 3, total: 3, wantReady: true},
+		{name: "db-partial", readiness: ReadinessEndpoints, headless: true, ready: 1, total: 2, wantReady: true, wantDegraded: true},
+		{name: "external-db", readiness: ReadinessEndpoints, headless: true, ready: 0, total: 1, wantMessage: "headless, no ready endpoints"},
+		{name: "headless-empty", readiness: ReadinessEndpoints, headless: true, wantMessage: "headless, no endpoints"},
+		{name: "manual-empty", readiness: ReadinessEndpoints, wantMessage: "no selector, no endpoints"},
+		{name: "alias", readiness: ReadinessExternalName, wantReady: true, wantMessage: "alias for db.example.com"},
+		{name: "broken-alias", readiness: ReadinessExternalName, wantMessage: "externalName not set"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, ok := byName[tt.name]
+			if !ok {
+				t.Fatalf("missing %s", tt.name)
+			}
+			if item.Readiness != tt.readiness || item.Headless != tt.headless {
+				t.Errorf("readiness=%q headless=%v, want %q %v", item.Readiness, item.Headless, tt.readiness, tt.headless)
+			}
+			if item.ReadyBackends != tt.ready || item.TotalBackends != tt.total {
+				t.Errorf("backends = %d/%d, want %d/%d", item.ReadyBackends, item.TotalBackends, tt.ready, tt.total)
+			}
+			if item.Ready != tt.wantReady || item.Degraded != tt.wantDegraded {
+				t.Errorf("ready=%v degraded=%v, want %v %v", item.Ready, item.Degraded, tt.wantReady, tt.wantDegraded)
+			}
+			if item.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", item.Message, tt.wantMessage)
+			}
+		})
+	}
+	if alias := byName["alias"]; alias.Type != "ExternalName" || alias.ExternalName != "db.example.com" {
+		t.Errorf("alias = %+v", alias)
+	}
+
+	out, err := FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"Headless", "ExternalName", "alias for db.example.com"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestServiceHealthAnalyzer_Analyze_SkipsEndpointSlicesWhenUnneeded(t *testing.T) {
+	client := &forbiddenReader{Client: fake.NewClient(), kind: "endpointslice"}
+	client.AddResource(makeService("web", "default", selectorSpec("web")))
+	client.AddResource(makeService("alias", "default", map[string]interface{}{"type": "ExternalName", "externalName": "example.com"}))
+
+	if _, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1"}); err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+
+	client.AddResource(makeService("db", "default", map[string]interface{}{"clusterIP": "None"}))
+	if _, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1"}); err == nil {
+		t.Fatal("expected the endpointslice list error with a headless service")
+	}
+
+	client = &forbiddenReader{Client: fake.NewClient(), kind: "endpointslice"}
+	client.AddResource(makeService("manual", "default", map[string]interface{}{}))
+	if _, err := NewServiceHealthAnalyzer(client).Analyze(context.Background(), ServiceHealthParams{Cluster: "c1"}); err == nil {
+		t.Fatal("expected the endpointslice list error with a service without a selector")
+	}
+}
@@ -2,12 +2,14 @@ package aggregate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/core/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -29,6 +31,8 @@ func (a *TopAnalyzer) Analyze(ctx context.Context, p TopParams) (*TopResult, err
 	if kind == "" {
 		kind = "pod"
 	}
+	p.Kind = kind
+	p.SortBy = normalizeTopSortBy(p.SortBy)
 
 	switch kind {
 	case "pod":
@@ -77,14 +81,8 @@ func (a *TopAnalyzer) analyzeNodes(ctx context.Context, p TopParams) (*TopResult
 		return nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
 
-	// Fetch node metrics if needed
-	metricsMap := make(map[string]*nodeMetrics)
-	var warning string
-	if needsMetrics(p.SortBy) {
-		m, w := a.fetchNodeMetrics(ctx, p.Cluster)
-		metricsMap = m
-		warning = w
-	}
+	// Always fetch node metrics: utilization percentages are the point of node top
+	metricsMap, warning := a.fetchNodeMetrics(ctx, p.Cluster)
 
 	items := make([]TopItem, 0, len(nodes.Items))
 	for _, node := range nodes.Items {
@@ -112,7 +110,7 @@ func (a *TopAnalyzer) fetchPodMetrics(ctx context.Context, cluster, namespace st
 	metricsList, err := a.client.ListResources(ctx, cluster, "pod.metrics.k8s.io", namespace, nil)
 	if err != nil {
 		logging.Debug("Failed to get pod metrics (metrics-server may not be installed): %v", err)
-		return nil, "metrics-server unavailable: utilization data omitted"
+		return nil, metricsWarning(err)
 	}
 
 	result := make(map[string]*podMetrics)
@@ -148,7 +146,7 @@ func (a *TopAnalyzer) fetchNodeMetrics(ctx context.Context, cluster string) (map
 	metricsList, err := a.client.ListResources(ctx, cluster, "node.metrics.k8s.io", "", nil)
 	if err != nil {
 		logging.Debug("Failed to get node metrics (metrics-server may not be installed): %v", err)
-		return nil, "metrics-server unavailable: utilization data omitted"
+		return nil, metricsWarning(err)
 	}
 
 	result := make(map[string]*nodeMetrics)
@@ -168,6 +166,19 @@ func (a *TopAnalyzer) fetchNodeMetrics(ctx context.Context, cluster string) (map
 	return result, ""
 }
 
+// metricsUnavailableWarning is reported when metrics could not be fetched.
+const metricsUnavailableWarning = "metrics-server not available: utilization data omitted"
+
+// metricsWarning describes why metrics could not be fetched. A missing
+// metrics.k8s.io API is the expected case and reported as is; other failures
+// carry the error so they can be told apart from an uninstalled metrics-server.
+func metricsWarning(err error) string {
+	if errors.Is(err, steve.ErrUnsupportedKind) || errors.Is(err, steve.ErrNotFound) || apierrors.IsNotFound(err) {
+		return metricsUnavailableWarning
+	}
+	return fmt.Sprintf("%s (%v)", metricsUnavailableWarning, err)
+}
+
 // extractPodTopItem extracts a TopItem from a pod unstructured object
 func extractPodTopItem(pod unstructured.Unstructured, metricsMap map[string]*podMetrics) TopItem {
 	item := TopItem{
@@ -275,11 +286,15 @@ func extractNodeTopItem(node unstructured.Unstructured, metricsMap map[string]*n
 		}
 	}
 
-	// Apply metrics if available
+	// Apply metrics if available, with utilization relative to allocatable
 	name := item.Name
 	if m, ok := metricsMap[name]; ok {
 		item.CPUUtil = m.cpuUtil
 		item.MemUtil = m.memUtil
+		cpuPercent := calcPercentage(item.CPUUtil, item.CPULimit)
+		memPercent := calcPercentage(item.MemUtil, item.MemLimit)
+		item.CPUPercent = &cpuPercent
+		item.MemPercent = &memPercent
 	}
 
 	return item
@@ -302,6 +317,7 @@ func (a *TopAnalyzer) buildResult(items []TopItem, p TopParams, warning string)
 	}
 
 	return &TopResult{
+		Kind:      p.Kind,
 		Items:     items,
 		Truncated: truncated,
 		Total:     total,
@@ -309,6 +325,19 @@ func (a *TopAnalyzer) buildResult(items []TopItem, p TopParams, warning string)
 	}, nil
 }
 
+// normalizeTopSortBy maps the short sortBy aliases "cpu" and "memory"/"mem"
+// to live utilization sorting, like kubectl top --sort-by.
+func normalizeTopSortBy(sortBy string) string {
+	switch strings.ToLower(sortBy) {
+	case "cpu":
+		return "cpu.util"
+	case "memory", "mem":
+		return "mem.util"
+	default:
+		return sortBy
+	}
+}
+
 // needsMetrics returns true if the sortBy requires metrics-server data.
 // Used for both pod and node top analysis.
 func needsMetrics(sortBy string) bool {
@@ -341,9 +370,9 @@ func sortTopItems(items []TopItem, sortBy string) {
 		case "mem.limit", "memory.limit":
 			return a.MemLimit > b.MemLimit
 		case "cpu.util.percentage":
-			return calcPercentage(a.CPUUtil, a.CPUReq) > calcPercentage(b.CPUUtil, b.CPUReq)
+			return utilPercentage(a.CPUPercent, a.CPUUtil, a.CPUReq) > utilPercentage(b.CPUPercent, b.CPUUtil, b.CPUReq)
 		case "mem.util.percentage", "memory.util.percentage":
-			return calcPercentage(a.MemUtil, a.MemReq) > calcPercentage(b.MemUtil, b.MemReq)
+			return utilPercentage(a.MemPercent, a.MemUtil, a.MemReq) > utilPercentage(b.MemPercent, b.MemUtil, b.MemReq)
 		case "restart.count":
 			return a.Restarts > b.Restarts
 		case "pod.count":
@@ -366,6 +395,15 @@ func calcPercentage(value, total int64) float64 {
 	return float64(value) / float64(total) * 100
 }
 
+// utilPercentage returns the precomputed percentage (nodes, against allocatable)
+// when set, or falls back to utilization relative to requests (pods).
+func utilPercentage(precomputed *float64, util, base int64) float64 {
+	if precomputed != nil {
+		return *precomputed
+	}
+	return calcPercentage(util, base)
+}
+
 // resourceQuantityToMilli parses a resource quantity string and returns millivalue.
 // Returns 0 for empty or unparseable input instead of panicking.
 func resourceQuantityToMilli(q string) int64 {
@@ -2,11 +2,17 @@ package aggregate
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestSortTopItems_ByCPURequest(t *testing.T) {
@@ -563,3 +569,108 @@ func addTopNodeResource(c *fake.Client, name, capacityCPU, capacityMem, allocCPU
 	u.SetName(name)
 	c.AddResource(u)
 }
+
+func TestNormalizeTopSortBy(t *testing.T) {
+	tests := map[string]string{
+		"cpu":         "cpu.util",
+		"CPU":         "cpu.util",
+		"memory":      "mem.util",
+		"mem":         "mem.util",
+		"cpu.request": "cpu.request",
+		"":            "",
+	}
+	for in, want := range tests {
+		if got := normalizeTopSortBy(in); got != want {
+			t.Errorf("normalizeTopSortBy(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMetricsWarning(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "metrics.k8s.io", Resource: "pods"}, "")
+	if got := metricsWarning(fmt.Errorf("failed to list resources: %w", notFound)); got != metricsUnavailableWarning {
+		t.Errorf("metricsWarning(NotFound) = %q, want %q", got, metricsUnavailableWarning)
+	}
+	if got := metricsWarning(fmt.Errorf("failed to list resources: %w", fmt.Errorf("%w: pod.metrics.k8s.io", steve.ErrUnsupportedKind))); got != metricsUnavailableWarning {
+		t.Errorf("metricsWarning(unsupported kind) = %q, want %q", got, metricsUnavailableWarning)
+	}
+	if got := metricsWarning(errors.New("connection refused")); !strings.HasPrefix(got, metricsUnavailableWarning) || !strings.Contains(got, "connection refused") {
+		t.Errorf("metricsWarning(other) = %q, want the warning with the error", got)
+	}
+}
+
+func TestTopAnalyzer_Analyze_SortByCPUAliasUsesMetrics(t *testing.T) {
+	c := fake.NewClient()
+	addTopPodResource(c, "pod-a", "default", "100m", "128Mi")
+	addTopPodResource(c, "pod-b", "default", "100m", "128Mi")
+	for name, cpu := range map[string]string{"pod-a": "50m", "pod-b": "900m"} {
+		c.AddResource(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "pod.metrics.k8s.io",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "usage": map[string]interface{}{"cpu": cpu, "memory": "64Mi"}},
+			},
+		}})
+	}
+
+	result, err := NewTopAnalyzer(c).Analyze(context.Background(), TopParams{
+		Cluster:   "test-cluster",
+		Namespace: "default",
+		SortBy:    "cpu",
+	})
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if result.Items[0].Name != "pod-b" || result.Items[0].CPUUtil != 900 {
+		t.Errorf("expected pod-b with 900m usage first, got %+v", result.Items[0])
+	}
+}
+
+func TestTopAnalyzer_Analyze_NodeUtilizationPercentages(t *testing.T) {
+	c := fake.NewClient()
+	addTopNodeResource(c, "node-a", "4", "16Gi", "4", "16Gi")
+	addTopNodeResource(c, "node-b", "8", "32Gi", "8", "32Gi")
+	addTopNodeResource(c, "node-c", "2", "8Gi", "2", "8Gi") // no metrics reported
+	for name, usage := range map[string][2]string{"node-a": {"3", "4Gi"}, "node-b": {"2", "24Gi"}} {
+		c.AddResource(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "node.metrics.k8s.io",
+			"metadata":   map[string]interface{}{"name": name},
+			"usage":      map[string]interface{}{"cpu": usage[0], "memory": usage[1]},
+		}})
+	}
+
+	a := NewTopAnalyzer(c)
+	result, err := a.Analyze(context.Background(), TopParams{
+		Cluster: "test-cluster",
+		Kind:    "node",
+		SortBy:  "cpu.util.percentage",
+	})
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if result.Kind != "node" {
+		t.Errorf("expected result kind node, got %q", result.Kind)
+	}
+	// node-a: 3/4 = 75% cpu; node-b: 2/8 = 25% cpu
+	if result.Items[0].Name != "node-a" || result.Items[0].CPUPercent == nil || *result.Items[0].CPUPercent != 75 {
+		t.Errorf("expected node-a at 75%% cpu first, got %+v", result.Items[0])
+	}
+	if last := result.Items[2]; last.Name != "node-c" || last.CPUPercent != nil || last.MemPercent != nil {
+		t.Errorf("expected node-c without percentages last, got %+v", last)
+	}
+
+	result, err = a.Analyze(context.Background(), TopParams{
+		Cluster: "test-cluster",
+		Kind:    "node",
+		SortBy:  "mem.util.percentage",
+	})
+	if err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	// node-a: 4/16 = 25% memory; node-b: 24/32 = 75% memory
+	if result.Items[0].Name != "node-b" || result.Items[0].MemPercent == nil || *result.Items[0].MemPercent != 75 {
+		t.Errorf("expected node-b at 75%% memory first, got %+v", result.Items[0])
+	}
+}
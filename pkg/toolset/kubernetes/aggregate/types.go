@@ -37,6 +37,7 @@ type TopParams struct {
 
 // TopResult holds the result of top analysis
 type TopResult struct {
+	Kind      string    `json:"kind"`
 	Items     []TopItem `json:"items"`
 	Truncated bool      `json:"truncated"`
 	Total     int       `json:"total"`
@@ -54,6 +55,10 @@ type TopItem struct {
 	MemLimit  int64  `json:"memoryLimit"`
 	MemUtil   int64  `json:"memoryUtilization"`
 	Restarts  int32  `json:"restarts,omitempty"`
+	// Node utilization as a percentage of allocatable (nodes only), nil
+	// when the node has no metrics
+	CPUPercent *float64 `json:"cpuPercent,omitempty"`
+	MemPercent *float64 `json:"memoryPercent,omitempty"`
 }
 
 // --- Workload Health (kubernetes_workload_health) ---
@@ -64,9 +69,15 @@ type WorkloadParams struct {
 	Namespace     string
 	Kind          string // "deployment", "statefulset", "daemonset", "all"
 	LabelSelector string
-	SortBy        string
-	Limit         int
-	Format        string
+	Name          string // case-insensitive substring of the workload name
+	Image         string // case-insensitive substring of a container image
+	// IncludeContainers adds the pod template's containers to each item
+	IncludeContainers bool
+	// ShowSensitiveData shows literal env var values instead of masking them
+	ShowSensitiveData bool
+	SortBy            string
+	Limit             int
+	Format            string
 }
 
 // WorkloadResult holds the result of workload health analysis
@@ -78,16 +89,40 @@ type WorkloadResult struct {
 
 // WorkloadItem holds a single workload entry
 type WorkloadItem struct {
-	Name        string    `json:"name"`
-	Namespace   string    `json:"namespace"`
-	Kind        string    `json:"kind"`
-	Ready       int32     `json:"ready"`
-	Desired     int32     `json:"desired"`
-	Unavailable int32     `json:"unavailable"`
-	Updated     int32     `json:"updated"`
-	Age         string    `json:"age"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"-"` // for accurate age sorting
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace"`
+	Kind        string `json:"kind"`
+	Ready       int32  `json:"ready"`
+	Desired     int32  `json:"desired"`
+	Unavailable int32  `json:"unavailable"`
+	Updated     int32  `json:"updated"`
+	Age         string `json:"age"`
+	Status      string `json:"status"`
+	// Containers are the pod template's containers, set when requested
+	Containers []WorkloadContainer `json:"containers,omitempty"`
+	CreatedAt  time.Time           `json:"-"` // for accurate age sorting
+}
+
+// WorkloadContainer summarizes a container of a workload's pod template
+type WorkloadContainer struct {
+	Name     string            `json:"name"`
+	Image    string            `json:"image"`
+	Init     bool              `json:"init,omitempty"`
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+	// Ports are formatted as [name:]port/protocol
+	Ports []string         `json:"ports,omitempty"`
+	Env   []WorkloadEnvVar `json:"env,omitempty"`
+	// EnvFrom lists the ConfigMaps and Secrets imported whole, e.g. "secretRef:db-credentials"
+	EnvFrom []string `json:"envFrom,omitempty"`
+}
+
+// WorkloadEnvVar is a container env var. Literal values are masked unless
+// sensitive data is shown; From names the source of a valueFrom reference.
+type WorkloadEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	From  string `json:"from,omitempty"`
 }
 
 // --- Resource Summary (kubernetes_resource_summary) ---
@@ -150,3 +185,446 @@ type EventItem struct {
 	Count     int32     `json:"count"`
 	LastSeen  time.Time `json:"lastSeen"`
 }
+
+// --- PVC Status (kubernetes_pvc_status) ---
+
+// PVCParams holds parameters for PVC status analysis
+type PVCParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	Limit         int
+	Format        string
+}
+
+// PVCResult holds the result of PVC status analysis
+type PVCResult struct {
+	Items     []PVCItem `json:"items"`
+	Truncated bool      `json:"truncated"`
+	Total     int       `json:"total"`
+	Pending   int       `json:"pending"`
+}
+
+// PVCItem holds a single PersistentVolumeClaim entry
+type PVCItem struct {
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Phase        string    `json:"phase"`
+	Requested    int64     `json:"requestedBytes"`
+	Capacity     int64     `json:"capacityBytes"`
+	StorageClass string    `json:"storageClass,omitempty"`
+	Volume       string    `json:"volume,omitempty"`
+	AccessModes  []string  `json:"accessModes,omitempty"`
+	Age          string    `json:"age"`
+	Warning      string    `json:"warning,omitempty"`
+	CreatedAt    time.Time `json:"-"`
+}
+
+// --- HPA Status (kubernetes_hpa_status) ---
+
+// HPAParams holds parameters for HPA status analysis
+type HPAParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	Limit         int
+	Format        string
+}
+
+// HPAResult holds the result of HPA status analysis
+type HPAResult struct {
+	Items     []HPAItem `json:"items"`
+	Truncated bool      `json:"truncated"`
+	Total     int       `json:"total"`
+}
+
+// HPAItem holds a single HorizontalPodAutoscaler entry
+type HPAItem struct {
+	Name            string         `json:"name"`
+	Namespace       string         `json:"namespace"`
+	Target          string         `json:"target"`
+	MinReplicas     int32          `json:"minReplicas"`
+	MaxReplicas     int32          `json:"maxReplicas"`
+	CurrentReplicas int32          `json:"currentReplicas"`
+	DesiredReplicas int32          `json:"desiredReplicas"`
+	Metrics         []HPAMetric    `json:"metrics"`
+	Conditions      []HPACondition `json:"conditions,omitempty"`
+	Age             string         `json:"age"`
+	CreatedAt       time.Time      `json:"-"`
+}
+
+// HPAMetric holds the current and target value of one scaling metric
+type HPAMetric struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	Current string `json:"current"`
+	Target  string `json:"target"`
+}
+
+// HPACondition holds an HPA status condition such as AbleToScale or ScalingLimited
+type HPACondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// --- CronJob Status (kubernetes_cronjob_status) ---
+
+// CronJobParams holds parameters for CronJob status analysis
+type CronJobParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	Limit         int
+	Format        string
+}
+
+// CronJobResult holds the result of CronJob status analysis
+type CronJobResult struct {
+	Items     []CronJobItem `json:"items"`
+	Truncated bool          `json:"truncated"`
+	Total     int           `json:"total"`
+	Attention int           `json:"attention"`
+}
+
+// CronJobItem holds a single CronJob entry with the outcome of its Jobs
+type CronJobItem struct {
+	Name               string     `json:"name"`
+	Namespace          string     `json:"namespace"`
+	Schedule           string     `json:"schedule"`
+	Suspend            bool       `json:"suspend"`
+	Active             int        `json:"active"`
+	LastScheduleTime   *time.Time `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime *time.Time `json:"lastSuccessfulTime,omitempty"`
+	JobsSucceeded      int        `json:"jobsSucceeded"`
+	JobsFailed         int        `json:"jobsFailed"`
+	LastRun            string     `json:"lastRun,omitempty"`
+	Age                string     `json:"age"`
+	Warning            string     `json:"warning,omitempty"`
+	CreatedAt          time.Time  `json:"-"`
+}
+
+// --- NetworkPolicy List (kubernetes_networkpolicy_list) ---
+
+// NetworkPolicyParams holds parameters for NetworkPolicy listing
+type NetworkPolicyParams struct {
+	Cluster       string
+	Namespace     string
+	CheckCoverage bool
+	Limit         int
+	Format        string
+}
+
+// NetworkPolicyResult holds the result of NetworkPolicy listing
+type NetworkPolicyResult struct {
+	Items     []NetworkPolicyItem `json:"items"`
+	Truncated bool                `json:"truncated"`
+	Total     int                 `json:"total"`
+	// Coverage is only populated when CheckCoverage is set
+	Coverage []NamespaceCoverage `json:"coverage,omitempty"`
+}
+
+// NetworkPolicyItem holds a single NetworkPolicy summary
+type NetworkPolicyItem struct {
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	PodSelector  string    `json:"podSelector"`
+	PolicyTypes  []string  `json:"policyTypes"`
+	IngressRules int       `json:"ingressRules"`
+	EgressRules  int       `json:"egressRules"`
+	DefaultDeny  []string  `json:"defaultDeny,omitempty"`
+	Age          string    `json:"age"`
+	CreatedAt    time.Time `json:"-"`
+}
+
+// NamespaceCoverage holds the pods of a namespace that no NetworkPolicy selects
+type NamespaceCoverage struct {
+	Namespace      string `json:"namespace"`
+	Policies       int    `json:"policies"`
+	Pods           int    `json:"pods"`
+	UnselectedPods int    `json:"unselectedPods"`
+}
+
+// --- Namespace Quota (kubernetes_namespace_quota) ---
+
+// QuotaParams holds parameters for namespace quota reporting
+type QuotaParams struct {
+	Cluster   string
+	Namespace string
+	Format    string
+}
+
+// QuotaResult holds the ResourceQuota usage and LimitRange defaults of a namespace
+type QuotaResult struct {
+	Namespace   string           `json:"namespace"`
+	Quotas      []QuotaItem      `json:"quotas"`
+	LimitRanges []LimitRangeItem `json:"limitRanges"`
+	// Attention counts quota entries that are near, at or over their hard limit
+	Attention int `json:"attention"`
+}
+
+// QuotaItem holds the hard limit and usage of one resource tracked by a ResourceQuota
+type QuotaItem struct {
+	Quota    string  `json:"quota"`
+	Resource string  `json:"resource"`
+	Hard     string  `json:"hard"`
+	Used     string  `json:"used"`
+	Percent  float64 `json:"percent"`
+	Warning  string  `json:"warning,omitempty"`
+}
+
+// LimitRangeItem holds the constraints a LimitRange sets for one resource of one type
+type LimitRangeItem struct {
+	LimitRange     string `json:"limitRange"`
+	Type           string `json:"type"`
+	Resource       string `json:"resource"`
+	Min            string `json:"min,omitempty"`
+	Max            string `json:"max,omitempty"`
+	DefaultRequest string `json:"defaultRequest,omitempty"`
+	Default        string `json:"default,omitempty"`
+}
+
+// --- Image Inventory (kubernetes_images) ---
+
+// ImageParams holds parameters for the container image inventory
+type ImageParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	Limit         int
+	Format        string
+}
+
+// ImageResult holds the deduplicated container images used by pods
+type ImageResult struct {
+	Items     []ImageItem `json:"items"`
+	Truncated bool        `json:"truncated"`
+	// Total is the number of distinct images
+	Total int `json:"total"`
+	// Pods is the number of pods scanned
+	Pods int `json:"pods"`
+}
+
+// ImageItem holds one container image and where it is used
+type ImageItem struct {
+	Image      string   `json:"image"`
+	Pods       int      `json:"pods"`
+	Namespaces []string `json:"namespaces"`
+	// Workloads are the pods' controllers as namespace/Kind/name, or the pod itself when unowned
+	Workloads []string `json:"workloads"`
+}
+
+// --- Resource Counts (kubernetes_counts) ---
+
+// CountParams holds parameters for counting resources per kind
+type CountParams struct {
+	Cluster   string
+	Namespace string
+	// Kinds to count; empty counts DefaultCountKinds
+	Kinds []string
+	// ByNamespace splits the counts of namespaced kinds per namespace
+	ByNamespace bool
+	// MaxConcurrency caps parallel list calls; zero or negative uses DefaultCountConcurrency
+	MaxConcurrency int
+	Format         string
+}
+
+// CountResult holds the resource counts sorted by count, highest first
+type CountResult struct {
+	Items []CountItem `json:"items"`
+	// Total is the sum of all counts
+	Total int `json:"total"`
+	// Errors lists the kinds that could not be counted, e.g. for lack of permission
+	Errors []CountError `json:"errors,omitempty"`
+}
+
+// CountItem holds the number of resources of a kind, in one namespace when
+// counts are split by namespace
+type CountItem struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Count     int    `json:"count"`
+}
+
+// CountError holds why a kind could not be counted
+type CountError struct {
+	Kind  string `json:"kind"`
+	Error string `json:"error"`
+}
+
+// --- Unhealthy Pods (kubernetes_unhealthy_pods) ---
+
+// UnhealthyPodParams holds parameters for the unhealthy pod triage
+type UnhealthyPodParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	// PendingThreshold is how long a pod may be Pending before it is reported
+	PendingThreshold time.Duration
+	// RestartThreshold reports pods whose containers restarted more often; zero disables it
+	RestartThreshold int32
+	// IncludeEvents attaches the latest event of each reported pod
+	IncludeEvents bool
+	Limit         int
+	Format        string
+}
+
+// UnhealthyPodResult holds the unhealthy pods, most severe first
+type UnhealthyPodResult struct {
+	Items     []UnhealthyPodItem `json:"items"`
+	Truncated bool               `json:"truncated"`
+	// Total is the number of unhealthy pods
+	Total int `json:"total"`
+	// Pods is the number of pods scanned
+	Pods int `json:"pods"`
+}
+
+// UnhealthyPodItem holds one unhealthy pod and why it is reported
+type UnhealthyPodItem struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Phase     string `json:"phase"`
+	// Status is the triage category, e.g. CrashLoopBackOff or Pending
+	Status    string `json:"status"`
+	Severity  int    `json:"severity"`
+	Container string `json:"container,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Restarts  int32  `json:"restarts"`
+	Node      string `json:"node,omitempty"`
+	// Problem is a one-line explanation like kubernetes_inspect_pod's likelyProblem
+	Problem string    `json:"problem,omitempty"`
+	Event   string    `json:"event,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// --- Deployment Health (kubernetes_deployment_health) ---
+
+// DeploymentHealthParams holds parameters for the deployment health scan
+type DeploymentHealthParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	// UnhealthyOnly drops Healthy and ScaledToZero Deployments from the items
+	UnhealthyOnly bool
+	Limit         int
+	Format        string
+}
+
+// DeploymentHealthResult holds the deployment health scan, unhealthy Deployments first
+type DeploymentHealthResult struct {
+	Items     []DeploymentHealthItem `json:"items"`
+	Truncated bool                   `json:"truncated"`
+	Total     int                    `json:"total"`
+	// Unhealthy is the number of Deployments that need attention
+	Unhealthy int `json:"unhealthy"`
+	// Deployments is the number of Deployments scanned
+	Deployments int `json:"deployments"`
+}
+
+// DeploymentHealthItem holds the replica counters and health verdict of one Deployment
+type DeploymentHealthItem struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	Desired            int32  `json:"desired"`
+	Ready              int32  `json:"ready"`
+	Updated            int32  `json:"updated"`
+	Available          int32  `json:"available"`
+	Generation         int64  `json:"generation"`
+	ObservedGeneration int64  `json:"observedGeneration"`
+	Paused             bool   `json:"paused,omitempty"`
+	// Health is the verdict, e.g. Healthy, Degraded or Stalled
+	Health  string `json:"health"`
+	Message string `json:"message,omitempty"`
+	// Since is when the discrepancy started, if the conditions record it
+	Since     *time.Time `json:"since,omitempty"`
+	Age       string     `json:"age"`
+	CreatedAt time.Time  `json:"-"`
+}
+
+// --- PDB Status (kubernetes_pdb_status) ---
+
+// PDBParams holds parameters for PodDisruptionBudget status analysis
+type PDBParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	// BlockingOnly drops budgets that currently allow disruptions from the items
+	BlockingOnly bool
+	Limit        int
+	Format       string
+}
+
+// PDBResult holds the PodDisruptionBudgets, drain-blocking budgets first
+type PDBResult struct {
+	Items     []PDBItem `json:"items"`
+	Truncated bool      `json:"truncated"`
+	Total     int       `json:"total"`
+	// Blocking is the number of budgets that currently block node drains
+	Blocking int `json:"blocking"`
+}
+
+// PDBItem holds a single PodDisruptionBudget entry
+type PDBItem struct {
+	Name               string    `json:"name"`
+	Namespace          string    `json:"namespace"`
+	Selector           string    `json:"selector,omitempty"`
+	MinAvailable       string    `json:"minAvailable,omitempty"`
+	MaxUnavailable     string    `json:"maxUnavailable,omitempty"`
+	CurrentHealthy     int32     `json:"currentHealthy"`
+	DesiredHealthy     int32     `json:"desiredHealthy"`
+	ExpectedPods       int32     `json:"expectedPods"`
+	DisruptionsAllowed int32     `json:"disruptionsAllowed"`
+	BlocksDrain        bool      `json:"blocksDrain"`
+	Warning            string    `json:"warning,omitempty"`
+	Age                string    `json:"age"`
+	CreatedAt          time.Time `json:"-"`
+}
+
+// --- Service Health (kubernetes_service_health) ---
+
+// ServiceHealthParams holds parameters for Service backend readiness analysis
+type ServiceHealthParams struct {
+	Cluster       string
+	Namespace     string
+	LabelSelector string
+	// UnhealthyOnly drops services whose backends are all ready from the items
+	UnhealthyOnly bool
+	Limit         int
+	Format        string
+}
+
+// ServiceHealthResult holds the Services, not ready and degraded ones first
+type ServiceHealthResult struct {
+	Items     []ServiceHealthItem `json:"items"`
+	Truncated bool                `json:"truncated"`
+	Total     int                 `json:"total"`
+	// NotReady and Degraded count across all services, before the unhealthyOnly filter
+	NotReady int `json:"notReady"`
+	Degraded int `json:"degraded"`
+	Services int `json:"services"`
+}
+
+// ServiceHealthItem holds the backend readiness of a single Service
+type ServiceHealthItem struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	Type         string `json:"type"`
+	ClusterIP    string `json:"clusterIP,omitempty"`
+	Selector     string `json:"selector,omitempty"`
+	Headless     bool   `json:"headless,omitempty"`
+	ExternalName string `json:"externalName,omitempty"`
+	// Readiness names what the backends are counted from: pods matched by the
+	// selector, the endpoints of a headless or selectorless service, or an
+	// ExternalName target
+	Readiness     string `json:"readiness"`
+	ReadyBackends int    `json:"readyBackends"`
+	TotalBackends int    `json:"totalBackends"`
+	// Ready is true when at least one backend is ready
+	Ready bool `json:"ready"`
+	// Degraded is true when the service is ready but some backends are not
+	Degraded  bool      `json:"degraded"`
+	Message   string    `json:"message,omitempty"`
+	Age       string    `json:"age"`
+	CreatedAt time.Time `json:"-"`
+}
@@ -0,0 +1,243 @@
+package aggregate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Triage categories of unhealthy pods
+const (
+	StatusCrashLoopBackOff = "CrashLoopBackOff"
+	StatusOOMKilled        = "OOMKilled"
+	StatusImagePullBackOff = "ImagePullBackOff"
+	StatusContainerError   = "ContainerError"
+	StatusFailed           = "Failed"
+	StatusPending          = "Pending"
+	StatusEvicted          = "Evicted"
+	StatusHighRestarts     = "HighRestarts"
+)
+
+// unhealthySeverity ranks the categories; lower is more severe
+var unhealthySeverity = map[string]int{
+	StatusCrashLoopBackOff: 1,
+	StatusOOMKilled:        1,
+	StatusImagePullBackOff: 2,
+	StatusContainerError:   2,
+	StatusFailed:           3,
+	StatusPending:          3,
+	StatusEvicted:          4,
+	StatusHighRestarts:     5,
+}
+
+// DefaultPendingThreshold is how long a pod may be Pending before it is reported
+const DefaultPendingThreshold = 5 * time.Minute
+
+// DefaultRestartThreshold reports pods whose containers restarted more often
+const DefaultRestartThreshold = 5
+
+// imagePullReasons are the waiting reasons of containers whose image cannot be pulled
+var imagePullReasons = map[string]bool{
+	"ImagePullBackOff":  true,
+	"ErrImagePull":      true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// containerErrorReasons are the waiting reasons of containers that cannot be created or started
+var containerErrorReasons = map[string]bool{
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+}
+
+// UnhealthyPodAnalyzer lists the pods that are not in a healthy state
+type UnhealthyPodAnalyzer struct {
+	client steve.ResourceReader
+}
+
+// NewUnhealthyPodAnalyzer creates a new unhealthy pod analyzer
+func NewUnhealthyPodAnalyzer(client steve.ResourceReader) *UnhealthyPodAnalyzer {
+	return &UnhealthyPodAnalyzer{client: client}
+}
+
+// Analyze lists pods, keeps the unhealthy ones sorted by severity and, when
+// requested, attaches the latest event of each pod that is returned.
+func (a *UnhealthyPodAnalyzer) Analyze(ctx context.Context, p UnhealthyPodParams) (*UnhealthyPodResult, error) {
+	opts := &steve.ListOptions{}
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
+	}
+
+	pods, err := a.client.ListResources(ctx, p.Cluster, "pod", p.Namespace, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	items := findUnhealthyPods(pods.Items, p, time.Now())
+	sortUnhealthyPods(items)
+
+	total := len(items)
+	limit := ClampLimit(p.Limit)
+	truncated := total > limit
+	if truncated {
+		items = items[:limit]
+	}
+
+	if p.IncludeEvents {
+		for i := range items {
+			// Events are best-effort context, as in kubernetes_inspect_pod
+			events, err := a.client.GetEvents(ctx, p.Cluster, items[i].Namespace, items[i].Name, "Pod", nil)
+			if err == nil {
+				items[i].Event = latestPodEvent(events)
+			}
+		}
+	}
+
+	return &UnhealthyPodResult{
+		Items:     items,
+		Truncated: truncated,
+		Total:     total,
+		Pods:      len(pods.Items),
+	}, nil
+}
+
+func findUnhealthyPods(pods []unstructured.Unstructured, p UnhealthyPodParams, now time.Time) []UnhealthyPodItem {
+	items := []UnhealthyPodItem{}
+	for _, obj := range pods {
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &pod); err != nil {
+			continue
+		}
+		if item, ok := classifyPod(&pod, p, now); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// classifyPod reports whether a pod is unhealthy and why. Container states
+// are checked first since they name the failing container, then the pod
+// phase, then the restart count.
+func classifyPod(pod *corev1.Pod, p UnhealthyPodParams, now time.Time) (UnhealthyPodItem, bool) {
+	containers := steve.SummarizeContainerStatuses(pod)
+	item := UnhealthyPodItem{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     string(pod.Status.Phase),
+		Node:      pod.Spec.NodeName,
+		Created:   pod.CreationTimestamp.Time,
+	}
+	var worst steve.ContainerStatusSummary
+	for _, c := range containers {
+		if c.Type == steve.ContainerTypeEphemeral {
+			continue
+		}
+		item.Restarts += c.RestartCount
+		if c.RestartCount > worst.RestartCount {
+			worst = c
+		}
+	}
+
+	if status, c, ok := classifyContainers(containers); ok {
+		item.Status, item.Container, item.Reason = status, c.Name, c.Reason
+		if status == StatusOOMKilled {
+			item.Reason = StatusOOMKilled
+		} else if item.Reason == "" {
+			item.Reason = c.LastTerminationReason
+		}
+	} else {
+		switch {
+		case pod.Status.Phase == corev1.PodFailed && pod.Status.Reason == "Evicted":
+			item.Status, item.Reason = StatusEvicted, pod.Status.Message
+		case pod.Status.Phase == corev1.PodFailed:
+			item.Status, item.Reason = StatusFailed, pod.Status.Reason
+		case pod.Status.Phase == corev1.PodPending && now.Sub(pod.CreationTimestamp.Time) > p.PendingThreshold:
+			item.Status = StatusPending
+		case p.RestartThreshold > 0 && worst.RestartCount > p.RestartThreshold:
+			item.Status, item.Container, item.Reason = StatusHighRestarts, worst.Name, worst.LastTerminationReason
+		default:
+			return UnhealthyPodItem{}, false
+		}
+	}
+
+	item.Severity = unhealthySeverity[item.Status]
+	item.Problem = steve.DiagnosePod(pod, containers)
+	if item.Problem == "" && item.Status == StatusPending {
+		item.Problem = "pod pending for " + now.Sub(pod.CreationTimestamp.Time).Round(time.Second).String()
+	}
+	return item, true
+}
+
+// classifyContainers returns the category and container of the most severe
+// container problem of a pod. Ephemeral debug containers are ignored.
+func classifyContainers(containers []steve.ContainerStatusSummary) (string, steve.ContainerStatusSummary, bool) {
+	var (
+		status string
+		found  steve.ContainerStatusSummary
+	)
+	for _, c := range containers {
+		if c.Type == steve.ContainerTypeEphemeral {
+			continue
+		}
+		var s string
+		switch {
+		case c.Reason == "OOMKilled" || (c.State != steve.ContainerStateRunning && c.LastTerminationReason == "OOMKilled"):
+			s = StatusOOMKilled
+		case c.State == steve.ContainerStateWaiting && c.Reason == "CrashLoopBackOff":
+			s = StatusCrashLoopBackOff
+		case c.State == steve.ContainerStateWaiting && imagePullReasons[c.Reason]:
+			s = StatusImagePullBackOff
+		case c.State == steve.ContainerStateWaiting && containerErrorReasons[c.Reason]:
+			s = StatusContainerError
+		default:
+			continue
+		}
+		if status == "" || unhealthySeverity[s] < unhealthySeverity[status] {
+			status, found = s, c
+		}
+	}
+	return status, found, status != ""
+}
+
+// latestPodEvent formats the newest warning event, or the newest event when
+// there are no warnings, as "Reason: message".
+func latestPodEvent(events []corev1.Event) string {
+	var latest *corev1.Event
+	for i := range events {
+		e := &events[i]
+		if latest == nil ||
+			(e.Type == corev1.EventTypeWarning && latest.Type != corev1.EventTypeWarning) ||
+			(e.Type == latest.Type && eventLastTimestamp(*e).After(eventLastTimestamp(*latest))) {
+			latest = e
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Reason + ": " + latest.Message
+}
+
+// sortUnhealthyPods orders pods by severity, then by restarts (highest first),
+// then by namespace and name
+func sortUnhealthyPods(items []UnhealthyPodItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Severity != b.Severity {
+			return a.Severity < b.Severity
+		}
+		if a.Restarts != b.Restarts {
+			return a.Restarts > b.Restarts
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
@@ -0,0 +1,191 @@
+package aggregate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func makeUnhealthyPod(t *testing.T, name string, age time.Duration, status corev1.PodStatus) *unstructured.Unstructured {
+	t.Helper()
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "prod",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Status: status,
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		t.Fatalf("ToUnstructured() error: %v", err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func waitingContainer(name, reason string, restarts int32, lastReason string) corev1.ContainerStatus {
+	cs := corev1.ContainerStatus{
+		Name:         name,
+		RestartCount: restarts,
+		State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+	}
+	if lastReason != "" {
+		cs.LastTerminationState.Terminated = &corev1.ContainerStateTerminated{Reason: lastReason, ExitCode: 137}
+	}
+	return cs
+}
+
+func runningContainer(name string, restarts int32) corev1.ContainerStatus {
+	return corev1.ContainerStatus{
+		Name:         name,
+		Ready:        true,
+		RestartCount: restarts,
+		State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+		},
+	}
+}
+
+func TestUnhealthyPodAnalyzer_Analyze(t *testing.T) {
+	client := fake.NewClient()
+	for _, pod := range []*unstructured.Unstructured{
+		makeUnhealthyPod(t, "healthy", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{runningContainer("app", 0)},
+		}),
+		makeUnhealthyPod(t, "completed", time.Hour, corev1.PodStatus{Phase: corev1.PodSucceeded}),
+		makeUnhealthyPod(t, "starting", time.Minute, corev1.PodStatus{Phase: corev1.PodPending}),
+		makeUnhealthyPod(t, "stuck", time.Hour, corev1.PodStatus{Phase: corev1.PodPending}),
+		makeUnhealthyPod(t, "crashing", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{waitingContainer("app", "CrashLoopBackOff", 7, "Error")},
+		}),
+		makeUnhealthyPod(t, "oom", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{waitingContainer("app", "CrashLoopBackOff", 3, "OOMKilled")},
+		}),
+		makeUnhealthyPod(t, "bad-image", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodPending, ContainerStatuses: []corev1.ContainerStatus{waitingContainer("app", "ImagePullBackOff", 0, "")},
+		}),
+		makeUnhealthyPod(t, "evicted", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodFailed, Reason: "Evicted", Message: "The node was low on resource: memory.",
+		}),
+		makeUnhealthyPod(t, "flaky", time.Hour, corev1.PodStatus{
+			Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{runningContainer("app", 9)},
+		}),
+	} {
+		client.AddResource(pod)
+	}
+	client.AddEvent(corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "prod"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "bad-image"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "Failed",
+		Message:        "Failed to pull image \"web:typo\"",
+		LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+	})
+	client.AddEvent(corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "prod"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "bad-image"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "BackOff",
+		Message:        "Back-off pulling image",
+		LastTimestamp:  metav1.NewTime(time.Now()),
+	})
+
+	result, err := NewUnhealthyPodAnalyzer(client).Analyze(context.Background(), UnhealthyPodParams{
+		Cluster:          "c1",
+		PendingThreshold: DefaultPendingThreshold,
+		RestartThreshold: DefaultRestartThreshold,
+		IncludeEvents:    true,
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+
+	want := []struct{ name, status string }{
+		{"crashing", StatusCrashLoopBackOff},
+		{"oom", StatusOOMKilled},
+		{"bad-image", StatusImagePullBackOff},
+		{"stuck", StatusPending},
+		{"evicted", StatusEvicted},
+		{"flaky", StatusHighRestarts},
+	}
+	if result.Pods != 9 || result.Total != len(want) || len(result.Items) != len(want) {
+		t.Fatalf("got %d of %d pods: %+v", result.Total, result.Pods, result.Items)
+	}
+	for i, w := range want {
+		if got := result.Items[i]; got.Name != w.name || got.Status != w.status {
+			t.Errorf("item %d = %s %s, want %s %s", i, got.Name, got.Status, w.name, w.status)
+		}
+	}
+
+	byName := map[string]UnhealthyPodItem{}
+	for _, item := range result.Items {
+		byName[item.Name] = item
+	}
+	if got := byName["bad-image"]; got.Container != "app" || got.Reason != "ImagePullBackOff" || got.Event != "Failed: Failed to pull image \"web:typo\"" {
+		t.Errorf("bad-image = %+v, want the warning event rather than the newer normal one", got)
+	}
+	if got := byName["oom"]; got.Reason != "OOMKilled" || !strings.Contains(got.Problem, "CrashLoopBackOff") {
+		t.Errorf("oom = %+v", got)
+	}
+	if got := byName["evicted"]; got.Reason != "The node was low on resource: memory." {
+		t.Errorf("evicted reason = %q", got.Reason)
+	}
+	if got := byName["stuck"]; !strings.HasPrefix(got.Problem, "pod pending for ") {
+		t.Errorf("stuck problem = %q", got.Problem)
+	}
+	if got := byName["flaky"]; got.Restarts != 9 || got.Container != "app" {
+		t.Errorf("flaky = %+v", got)
+	}
+}
+
+func TestUnhealthyPodAnalyzer_Thresholds(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeUnhealthyPod(t, "pending", 10*time.Minute, corev1.PodStatus{Phase: corev1.PodPending}))
+	client.AddResource(makeUnhealthyPod(t, "flaky", time.Hour, corev1.PodStatus{
+		Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{runningContainer("app", 9)},
+	}))
+
+	result, err := NewUnhealthyPodAnalyzer(client).Analyze(context.Background(), UnhealthyPodParams{
+		Cluster:          "c1",
+		PendingThreshold: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error: %v", err)
+	}
+	if result.Total != 0 {
+		t.Errorf("expected no pods with a 1h pending threshold and restart check disabled, got %+v", result.Items)
+	}
+}
+
+func TestFormatResult_TableUnhealthyPods(t *testing.T) {
+	out, err := FormatResult(&UnhealthyPodResult{Pods: 4}, "table")
+	if err != nil || out != "No unhealthy pods found among 4 pods\n" {
+		t.Errorf("empty table = %q, %v", out, err)
+	}
+
+	result := &UnhealthyPodResult{
+		Items:     []UnhealthyPodItem{{Name: "web", Namespace: "prod", Status: StatusCrashLoopBackOff, Container: "app", Restarts: 7, Problem: "container app in CrashLoopBackOff"}},
+		Total:     3,
+		Pods:      10,
+		Truncated: true,
+	}
+	out, err = FormatResult(result, "table")
+	if err != nil {
+		t.Fatalf("FormatResult() error: %v", err)
+	}
+	for _, want := range []string{"STATUS", "CrashLoopBackOff", "container app in CrashLoopBackOff", "3 unhealthy of 10 pods (showing 1)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // WorkloadAnalyzer performs workload health analysis
@@ -27,14 +29,14 @@ func (a *WorkloadAnalyzer) Analyze(ctx context.Context, p WorkloadParams) (*Work
 	kind := strings.ToLower(p.Kind)
 	if kind == "" || kind == "all" {
 		for _, k := range []string{"deployment", "statefulset", "daemonset"} {
-			items, err := a.listWorkload(ctx, p.Cluster, p.Namespace, k, p.LabelSelector)
+			items, err := a.listWorkload(ctx, p, k)
 			if err != nil {
 				return nil, err
 			}
 			allItems = append(allItems, items...)
 		}
 	} else {
-		items, err := a.listWorkload(ctx, p.Cluster, p.Namespace, kind, p.LabelSelector)
+		items, err := a.listWorkload(ctx, p, kind)
 		if err != nil {
 			return nil, err
 		}
@@ -62,21 +64,33 @@ func (a *WorkloadAnalyzer) Analyze(ctx context.Context, p WorkloadParams) (*Work
 	}, nil
 }
 
-// listWorkload lists a specific workload kind and extracts health info
-func (a *WorkloadAnalyzer) listWorkload(ctx context.Context, cluster, namespace, kind, labelSelector string) ([]WorkloadItem, error) {
+// listWorkload lists a specific workload kind and extracts health info for the
+// workloads matching the name and image filters
+func (a *WorkloadAnalyzer) listWorkload(ctx context.Context, p WorkloadParams, kind string) ([]WorkloadItem, error) {
 	opts := &steve.ListOptions{}
-	if labelSelector != "" {
-		opts.LabelSelector = labelSelector
+	if p.LabelSelector != "" {
+		opts.LabelSelector = p.LabelSelector
 	}
 
-	list, err := a.client.ListResources(ctx, cluster, kind, namespace, opts)
+	list, err := a.client.ListResources(ctx, p.Cluster, kind, p.Namespace, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
 	}
 
+	name := strings.ToLower(p.Name)
+	image := strings.ToLower(p.Image)
 	items := make([]WorkloadItem, 0, len(list.Items))
 	for _, obj := range list.Items {
+		if name != "" && !strings.Contains(strings.ToLower(obj.GetName()), name) {
+			continue
+		}
+		if image != "" && !workloadRunsImage(obj, image) {
+			continue
+		}
 		item := extractWorkloadItem(obj, kind)
+		if p.IncludeContainers {
+			item.Containers = extractWorkloadContainers(obj, p.ShowSensitiveData)
+		}
 		items = append(items, item)
 	}
 
@@ -128,6 +142,121 @@ func extractWorkloadItem(obj unstructured.Unstructured, kind string) WorkloadIte
 	return item
 }
 
+// workloadRunsImage reports whether any container or init container of the
+// workload's pod template has an image containing the lowercase substring image
+func workloadRunsImage(obj unstructured.Unstructured, image string) bool {
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ref, _ := container["image"].(string); strings.Contains(strings.ToLower(ref), image) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// extractWorkloadContainers summarizes the init containers and containers of
+// the workload's pod template. Literal env var values are masked unless
+// showSensitive is set; valueFrom references name their source only.
+func extractWorkloadContainers(obj unstructured.Unstructured, showSensitive bool) []WorkloadContainer {
+	raw, found, _ := unstructured.NestedMap(obj.Object, "spec", "template", "spec")
+	if !found {
+		return nil
+	}
+	var spec corev1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &spec); err != nil {
+		return nil
+	}
+
+	containers := make([]WorkloadContainer, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, c := range spec.InitContainers {
+		container := summarizeContainer(c, showSensitive)
+		container.Init = true
+		containers = append(containers, container)
+	}
+	for _, c := range spec.Containers {
+		containers = append(containers, summarizeContainer(c, showSensitive))
+	}
+	return containers
+}
+
+func summarizeContainer(c corev1.Container, showSensitive bool) WorkloadContainer {
+	container := WorkloadContainer{
+		Name:     c.Name,
+		Image:    c.Image,
+		Requests: resourceListToMap(c.Resources.Requests),
+		Limits:   resourceListToMap(c.Resources.Limits),
+	}
+	for _, port := range c.Ports {
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		formatted := fmt.Sprintf("%d/%s", port.ContainerPort, protocol)
+		if port.Name != "" {
+			formatted = port.Name + ":" + formatted
+		}
+		container.Ports = append(container.Ports, formatted)
+	}
+	for _, env := range c.Env {
+		v := WorkloadEnvVar{Name: env.Name, From: envVarSource(env.ValueFrom)}
+		if env.Value != "" {
+			v.Value = maskedEnvValue
+			if showSensitive {
+				v.Value = env.Value
+			}
+		}
+		container.Env = append(container.Env, v)
+	}
+	for _, from := range c.EnvFrom {
+		switch {
+		case from.ConfigMapRef != nil:
+			container.EnvFrom = append(container.EnvFrom, "configMapRef:"+from.ConfigMapRef.Name)
+		case from.SecretRef != nil:
+			container.EnvFrom = append(container.EnvFrom, "secretRef:"+from.SecretRef.Name)
+		}
+	}
+	return container
+}
+
+// maskedEnvValue replaces literal env var values when sensitive data is hidden
+const maskedEnvValue = "***"
+
+// envVarSource describes where a valueFrom env var is read from, e.g.
+// "secretKeyRef:db-credentials/password"
+func envVarSource(from *corev1.EnvVarSource) string {
+	switch {
+	case from == nil:
+		return ""
+	case from.SecretKeyRef != nil:
+		return "secretKeyRef:" + from.SecretKeyRef.Name + "/" + from.SecretKeyRef.Key
+	case from.ConfigMapKeyRef != nil:
+		return "configMapKeyRef:" + from.ConfigMapKeyRef.Name + "/" + from.ConfigMapKeyRef.Key
+	case from.FieldRef != nil:
+		return "fieldRef:" + from.FieldRef.FieldPath
+	case from.ResourceFieldRef != nil:
+		return "resourceFieldRef:" + from.ResourceFieldRef.Resource
+	default:
+		return ""
+	}
+}
+
+func resourceListToMap(resources corev1.ResourceList) map[string]string {
+	if len(resources) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		m[string(name)] = quantity.String()
+	}
+	return m
+}
+
 // deriveWorkloadStatus derives the workload status based on replica counts
 func deriveWorkloadStatus(item WorkloadItem) string {
 	if item.Desired == 0 {
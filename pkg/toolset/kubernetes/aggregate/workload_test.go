@@ -2,6 +2,8 @@ package aggregate
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
@@ -260,6 +262,182 @@ func TestWorkloadAnalyzer_Analyze_AllKinds(t *testing.T) {
 	}
 }
 
+func TestWorkloadAnalyzer_Analyze_NameAndImageFilters(t *testing.T) {
+	c := fake.NewClient()
+	c.AddResource(makeWorkloadWithImages("Deployment", "Payments-API", "team-a", "registry.example.com/payments:1.4"))
+	c.AddResource(makeWorkloadWithImages("Deployment", "payments-worker", "team-b", "registry.example.com/payments:1.3", "nginx:1.25"))
+	c.AddResource(makeWorkloadWithImages("StatefulSet", "postgres", "team-a", "postgres:16"))
+	c.AddResource(makeWorkloadWithImages("DaemonSet", "log-agent", "kube-system", "fluent-bit:3.0"))
+	// The image filter also matches init containers
+	ds := makeWorkloadWithImages("DaemonSet", "node-setup", "kube-system", "busybox:1.36")
+	_ = unstructured.SetNestedSlice(ds.Object, []interface{}{
+		map[string]interface{}{"name": "init", "image": "NGINX:1.25-alpine"},
+	}, "spec", "template", "spec", "initContainers")
+	c.AddResource(ds)
+
+	tests := []struct {
+		name   string
+		params WorkloadParams
+		want   []string
+	}{
+		{name: "name substring across namespaces", params: WorkloadParams{Name: "payments"}, want: []string{"Payments-API", "payments-worker"}},
+		{name: "image substring across kinds", params: WorkloadParams{Image: "nginx:1.25"}, want: []string{"payments-worker", "node-setup"}},
+		{name: "name and image compose", params: WorkloadParams{Name: "PAYMENTS", Image: ":1.4"}, want: []string{"Payments-API"}},
+		{name: "image with namespace", params: WorkloadParams{Namespace: "team-a", Image: "registry.example.com"}, want: []string{"Payments-API"}},
+		{name: "image with kind", params: WorkloadParams{Kind: "statefulset", Image: "postgres"}, want: []string{"postgres"}},
+		{name: "no match", params: WorkloadParams{Image: "redis"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.Cluster = "test-cluster"
+			tt.params.SortBy = "name"
+			result, err := NewWorkloadAnalyzer(c).Analyze(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("Analyze failed: %v", err)
+			}
+			if result.Total != len(tt.want) {
+				t.Fatalf("got %+v, want %v", result.Items, tt.want)
+			}
+			got := make(map[string]bool)
+			for _, item := range result.Items {
+				got[item.Name] = true
+			}
+			for _, name := range tt.want {
+				if !got[name] {
+					t.Errorf("missing %s in %+v", name, result.Items)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractWorkloadContainers(t *testing.T) {
+	u := makeWorkloadWithImages("Deployment", "api", "default", "registry.example.com/api:2.1")
+	_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+		map[string]interface{}{"name": "migrate", "image": "registry.example.com/migrate:2.1"},
+	}, "spec", "template", "spec", "initContainers")
+	_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+		map[string]interface{}{
+			"name":  "api",
+			"image": "registry.example.com/api:2.1",
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "250m", "memory": "256Mi"},
+				"limits":   map[string]interface{}{"memory": "512Mi"},
+			},
+			"ports": []interface{}{
+				map[string]interface{}{"name": "http", "containerPort": int64(8080)},
+				map[string]interface{}{"containerPort": int64(9090), "protocol": "UDP"},
+			},
+			"env": []interface{}{
+				map[string]interface{}{"name": "LOG_LEVEL", "value": "debug"},
+				map[string]interface{}{"name": "DB_PASSWORD", "valueFrom": map[string]interface{}{
+					"secretKeyRef": map[string]interface{}{"name": "db-credentials", "key": "password"},
+				}},
+				map[string]interface{}{"name": "POD_IP", "valueFrom": map[string]interface{}{
+					"fieldRef": map[string]interface{}{"fieldPath": "status.podIP"},
+				}},
+			},
+			"envFrom": []interface{}{
+				map[string]interface{}{"configMapRef": map[string]interface{}{"name": "api-config"}},
+				map[string]interface{}{"secretRef": map[string]interface{}{"name": "api-secrets"}},
+			},
+		},
+	}, "spec", "template", "spec", "containers")
+
+	tests := []struct {
+		name          string
+		showSensitive bool
+		wantLogLevel  string
+	}{
+		{name: "masked", wantLogLevel: "***"},
+		{name: "sensitive data shown", showSensitive: true, wantLogLevel: "debug"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containers := extractWorkloadContainers(*u, tt.showSensitive)
+			if len(containers) != 2 {
+				t.Fatalf("expected 2 containers, got %+v", containers)
+			}
+			if !containers[0].Init || containers[0].Name != "migrate" {
+				t.Errorf("expected init container first, got %+v", containers[0])
+			}
+			c := containers[1]
+			if c.Init || c.Image != "registry.example.com/api:2.1" {
+				t.Errorf("unexpected container %+v", c)
+			}
+			if c.Requests["cpu"] != "250m" || c.Requests["memory"] != "256Mi" || c.Limits["memory"] != "512Mi" {
+				t.Errorf("unexpected resources requests=%v limits=%v", c.Requests, c.Limits)
+			}
+			if fmt.Sprint(c.Ports) != "[http:8080/TCP 9090/UDP]" {
+				t.Errorf("unexpected ports %v", c.Ports)
+			}
+			want := []WorkloadEnvVar{
+				{Name: "LOG_LEVEL", Value: tt.wantLogLevel},
+				{Name: "DB_PASSWORD", From: "secretKeyRef:db-credentials/password"},
+				{Name: "POD_IP", From: "fieldRef:status.podIP"},
+			}
+			if fmt.Sprint(c.Env) != fmt.Sprint(want) {
+				t.Errorf("env = %+v, want %+v", c.Env, want)
+			}
+			if fmt.Sprint(c.EnvFrom) != "[configMapRef:api-config secretRef:api-secrets]" {
+				t.Errorf("unexpected envFrom %v", c.EnvFrom)
+			}
+		})
+	}
+}
+
+func TestWorkloadAnalyzer_Analyze_IncludeContainers(t *testing.T) {
+	c := fake.NewClient()
+	c.AddResource(makeWorkloadWithImages("Deployment", "web", "default", "nginx:1.25", "envoy:1.30"))
+
+	for _, include := range []bool{false, true} {
+		result, err := NewWorkloadAnalyzer(c).Analyze(context.Background(), WorkloadParams{
+			Cluster:           "test-cluster",
+			IncludeContainers: include,
+		})
+		if err != nil {
+			t.Fatalf("Analyze failed: %v", err)
+		}
+		if result.Total != 1 {
+			t.Fatalf("expected 1 workload, got %d", result.Total)
+		}
+		got := result.Items[0].Containers
+		if !include && got != nil {
+			t.Errorf("containers should be omitted by default, got %+v", got)
+		}
+		if include && (len(got) != 2 || got[1].Image != "envoy:1.30") {
+			t.Errorf("unexpected containers %+v", got)
+		}
+
+		table := formatWorkloadAsTable(result)
+		if strings.Contains(table, "CONTAINERS") != include {
+			t.Errorf("CONTAINERS column present=%v, want %v:\n%s", !include, include, table)
+		}
+		if include && !strings.Contains(table, "c0=nginx:1.25, c1=envoy:1.30") {
+			t.Errorf("expected name=image pairs in table:\n%s", table)
+		}
+	}
+}
+
+func makeWorkloadWithImages(kind, name, namespace string, images ...string) *unstructured.Unstructured {
+	containers := make([]interface{}, 0, len(images))
+	for i, image := range images {
+		containers = append(containers, map[string]interface{}{"name": fmt.Sprintf("c%d", i), "image": image})
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": containers},
+			},
+		},
+	}}
+	u.SetKind(kind)
+	u.SetAPIVersion("apps/v1")
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	return u
+}
+
 func addWorkloadResource(c *fake.Client, kind, name, namespace string, desired, ready, unavailable int32) {
 	u := &unstructured.Unstructured{}
 	u.SetUnstructuredContent(map[string]interface{}{
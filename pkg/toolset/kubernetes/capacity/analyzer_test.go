@@ -2,6 +2,7 @@ package capacity
 
 import (
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -91,6 +92,83 @@ func TestAnalyze_Integration_NodeLabelSelector(t *testing.T) {
 	}
 }
 
+func TestAnalyze_Integration_NodeLabelSelectorOperators(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{selector: "env!=prod", want: []string{"node-2"}},
+		{selector: "env in (prod,staging)", want: []string{"node-1", "node-2"}},
+		{selector: "env notin (staging)", want: []string{"node-1"}},
+		{selector: "env", want: []string{"node-1", "node-2"}},
+		{selector: "!env", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			a := NewAnalyzer(makeFakeClient())
+			result, err := a.Analyze(context.Background(), Params{
+				Cluster:           "test-cluster",
+				NodeLabelSelector: tt.selector,
+				SortBy:            "name",
+			})
+			if err != nil {
+				t.Fatalf("Analyze failed: %v", err)
+			}
+
+			var got []string
+			for _, n := range result.Nodes {
+				got = append(got, n.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("nodes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyze_Integration_NamespaceLabelSelector(t *testing.T) {
+	c := makeFakeClient()
+	c.AddResource(makeUnstructuredPtr("Namespace", "default", "", map[string]interface{}{}, map[string]string{"team": "web"}))
+
+	tests := []struct {
+		selector string
+		wantPods int64
+	}{
+		{selector: "team=web", wantPods: 3},
+		{selector: "team!=web", wantPods: 0},
+		{selector: "team notin (web)", wantPods: 0},
+		{selector: "!team", wantPods: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			a := NewAnalyzer(c)
+			result, err := a.Analyze(context.Background(), Params{
+				Cluster:                "test-cluster",
+				NamespaceLabelSelector: tt.selector,
+			})
+			if err != nil {
+				t.Fatalf("Analyze failed: %v", err)
+			}
+			if result.Cluster.PodCount.Requested != tt.wantPods {
+				t.Errorf("pods = %d, want %d", result.Cluster.PodCount.Requested, tt.wantPods)
+			}
+		})
+	}
+}
+
+func TestAnalyze_InvalidLabelSelector(t *testing.T) {
+	a := NewAnalyzer(makeFakeClient())
+	_, err := a.Analyze(context.Background(), Params{
+		Cluster:           "test-cluster",
+		NodeLabelSelector: "env in (prod",
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid nodeLabelSelector") {
+		t.Fatalf("Analyze() error = %v, want invalid nodeLabelSelector", err)
+	}
+}
+
 func TestAnalyze_Integration_PodLabelSelector(t *testing.T) {
 	c := makeFakeClient()
 
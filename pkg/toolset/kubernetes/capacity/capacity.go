@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // Analyzer performs capacity analysis
@@ -62,11 +63,14 @@ func (a *Analyzer) buildNodeInfoMap(ctx context.Context, p Params) (map[string]*
 		return nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
 
-	nodeSelectorMap := parseLabelSelector(p.NodeLabelSelector)
+	nodeSelector, err := parseLabelSelector(p.NodeLabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nodeLabelSelector: %w", err)
+	}
 	nodeInfoMap := make(map[string]*NodeInfo)
 
 	for _, node := range nodes.Items {
-		if !matchesNodeSelector(node, nodeSelectorMap) {
+		if !matchesNodeSelector(node, nodeSelector) {
 			continue
 		}
 
@@ -121,8 +125,11 @@ func (a *Analyzer) buildNamespaceFilter(ctx context.Context, p Params) (map[stri
 		return nil, nil
 	}
 
-	nsSelector := parseLabelSelector(p.NamespaceLabelSelector)
-	if len(nsSelector) == 0 {
+	nsSelector, err := parseLabelSelector(p.NamespaceLabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespaceLabelSelector: %w", err)
+	}
+	if nsSelector.Empty() {
 		return nil, nil
 	}
 
@@ -199,8 +206,8 @@ func (a *Analyzer) buildResult(nodeInfoMap map[string]*NodeInfo, p Params) Resul
 }
 
 // matchesNodeSelector checks if a node matches the label selector
-func matchesNodeSelector(node unstructured.Unstructured, selector map[string]string) bool {
-	if len(selector) == 0 {
+func matchesNodeSelector(node unstructured.Unstructured, selector labels.Selector) bool {
+	if selector == nil || selector.Empty() {
 		return true
 	}
 	return matchLabels(node.GetLabels(), selector)
@@ -282,7 +289,7 @@ func extractTaints(node unstructured.Unstructured) []corev1.Taint {
 // shouldProcessPod checks if a pod should be processed
 func shouldProcessPod(pod unstructured.Unstructured, nodeInfoMap map[string]*NodeInfo, namespaceFilter map[string]bool) bool {
 	// Filter by namespace labels
-	if len(namespaceFilter) > 0 && !namespaceFilter[pod.GetNamespace()] {
+	if namespaceFilter != nil && !namespaceFilter[pod.GetNamespace()] {
 		return false
 	}
 
@@ -447,29 +454,47 @@ func aggregateNodeToCluster(cluster, node *NodeInfo) {
 	cluster.PodCount.Requested += node.PodCount.Requested
 }
 
-// parseLabelSelector parses a label selector string into a map
-func parseLabelSelector(selector string) map[string]string {
-	result := make(map[string]string)
+// parseLabelSelector parses a kubectl-style label selector, supporting
+// =, ==, !=, in, notin, existence (key) and non-existence (!key).
+// Requirements may also be separated by whitespace instead of commas.
+func parseLabelSelector(selector string) (labels.Selector, error) {
+	selector = normalizeSelectorSeparators(selector)
 	if selector == "" {
-		return result
+		return labels.Everything(), nil
 	}
+	return labels.Parse(selector)
+}
 
-	parts := strings.FieldsFunc(selector, func(r rune) bool { return r == ',' || r == ' ' })
-
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
-			continue
-		}
-
-		if idx := strings.Index(part, "=="); idx != -1 {
-			result[strings.TrimSpace(part[:idx])] = strings.TrimSpace(part[idx+2:])
-		} else if idx := strings.Index(part, "="); idx != -1 {
-			result[strings.TrimSpace(part[:idx])] = strings.TrimSpace(part[idx+1:])
+// normalizeSelectorSeparators rewrites whitespace-separated requirements
+// ("app=nginx env=prod") into the comma-separated form labels.Parse expects,
+// keeping the whitespace that belongs inside a requirement ("env in (a, b)").
+func normalizeSelectorSeparators(selector string) string {
+	fields := strings.Fields(selector)
+
+	var b strings.Builder
+	depth := 0
+	for i, field := range fields {
+		if i > 0 {
+			prev := fields[i-1]
+			sameRequirement := depth > 0 ||
+				isSetOperator(field) || isSetOperator(prev) ||
+				strings.HasSuffix(prev, ",") || strings.HasSuffix(prev, "=") ||
+				strings.HasPrefix(field, ",") || strings.HasPrefix(field, "=") ||
+				strings.HasPrefix(field, "!=") || strings.HasPrefix(field, "(")
+			if sameRequirement {
+				b.WriteByte(' ')
+			} else {
+				b.WriteByte(',')
+			}
 		}
+		b.WriteString(field)
+		depth += strings.Count(field, "(") - strings.Count(field, ")")
 	}
+	return b.String()
+}
 
-	return result
+func isSetOperator(token string) bool {
+	return token == "in" || token == "notin"
 }
 
 // matchTaints checks if node taints match the taint selector expression
@@ -540,14 +565,9 @@ func taintMatches(taints []corev1.Taint, key, value, effect string) bool {
 	return false
 }
 
-// matchLabels checks if the given labels match the selector map
-func matchLabels(labels, selector map[string]string) bool {
-	for key, value := range selector {
-		if labels[key] != value {
-			return false
-		}
-	}
-	return true
+// matchLabels checks if the given labels match the selector
+func matchLabels(labelMap map[string]string, selector labels.Selector) bool {
+	return selector.Matches(labels.Set(labelMap))
 }
 
 // resourceQuantityToMilli parses a resource quantity string and returns millivalue.
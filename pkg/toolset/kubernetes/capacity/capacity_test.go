@@ -4,75 +4,92 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func TestParseLabelSelector(t *testing.T) {
-	t.Run("empty", func(t *testing.T) {
-		result := parseLabelSelector("")
-		if len(result) != 0 {
-			t.Fatalf("expected empty map, got %v", result)
-		}
-	})
-
-	t.Run("single equals", func(t *testing.T) {
-		result := parseLabelSelector("app=nginx")
-		if result["app"] != "nginx" {
-			t.Fatalf("expected app=nginx, got %v", result)
-		}
-	})
+	nodeLabels := map[string]string{"app": "nginx", "env": "prod", "tier": "frontend"}
 
-	t.Run("double equals", func(t *testing.T) {
-		result := parseLabelSelector("app==nginx")
-		if result["app"] != "nginx" {
-			t.Fatalf("expected app=nginx, got %v", result)
-		}
-	})
-
-	t.Run("comma separated", func(t *testing.T) {
-		result := parseLabelSelector("app=nginx,env=prod")
-		if result["app"] != "nginx" || result["env"] != "prod" {
-			t.Fatalf("expected app=nginx env=prod, got %v", result)
-		}
-	})
+	tests := []struct {
+		name     string
+		selector string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "empty matches everything", selector: "", want: true},
+		{name: "single equals", selector: "app=nginx", want: true},
+		{name: "double equals", selector: "app==nginx", want: true},
+		{name: "comma separated", selector: "app=nginx,env=prod", want: true},
+		{name: "space separated", selector: "app=nginx env=prod", want: true},
+		{name: "space separated mismatch", selector: "app=nginx env=dev", want: false},
+		{name: "not equals", selector: "env!=dev", want: true},
+		{name: "not equals mismatch", selector: "env!=prod", want: false},
+		{name: "not equals on missing key", selector: "zone!=a", want: true},
+		{name: "existence", selector: "tier", want: true},
+		{name: "existence missing", selector: "zone", want: false},
+		{name: "non-existence", selector: "!zone", want: true},
+		{name: "non-existence present", selector: "!tier", want: false},
+		{name: "in", selector: "env in (prod, staging)", want: true},
+		{name: "in mismatch", selector: "env in (dev,staging)", want: false},
+		{name: "notin", selector: "env notin (dev, staging)", want: true},
+		{name: "notin mismatch", selector: "env notin (prod)", want: false},
+		{name: "notin on missing key", selector: "zone notin (a)", want: true},
+		{name: "mixed whitespace and set terms", selector: "app=nginx env in (prod, staging) !zone", want: true},
+		{name: "spaced operator", selector: "app = nginx", want: true},
+		{name: "invalid", selector: "env in (prod", wantErr: true},
+	}
 
-	t.Run("space separated", func(t *testing.T) {
-		result := parseLabelSelector("app=nginx env=prod")
-		if result["app"] != "nginx" || result["env"] != "prod" {
-			t.Fatalf("expected app=nginx env=prod, got %v", result)
-		}
-	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseLabelSelector(tt.selector)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseLabelSelector(%q) expected error, got %v", tt.selector, selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseLabelSelector(%q) unexpected error: %v", tt.selector, err)
+			}
+			if got := matchLabels(nodeLabels, selector); got != tt.want {
+				t.Errorf("matchLabels(%v, %q) = %v, want %v", nodeLabels, selector, got, tt.want)
+			}
+		})
+	}
+}
 
-	t.Run("whitespace in values", func(t *testing.T) {
-		result := parseLabelSelector("app=nginx,env=prod")
-		if result["app"] != "nginx" || result["env"] != "prod" {
-			t.Fatalf("expected app=nginx env=prod, got %v", result)
-		}
-	})
+func mustParse(t *testing.T, selector string) labels.Selector {
+	t.Helper()
+	parsed, err := parseLabelSelector(selector)
+	if err != nil {
+		t.Fatalf("parseLabelSelector(%q) unexpected error: %v", selector, err)
+	}
+	return parsed
 }
 
 func TestMatchLabels(t *testing.T) {
-	labels := map[string]string{"app": "nginx", "env": "prod"}
+	podLabels := map[string]string{"app": "nginx", "env": "prod"}
 
 	t.Run("all match", func(t *testing.T) {
-		if !matchLabels(labels, map[string]string{"app": "nginx"}) {
+		if !matchLabels(podLabels, mustParse(t, "app=nginx")) {
 			t.Fatal("expected match")
 		}
 	})
 
 	t.Run("partial mismatch", func(t *testing.T) {
-		if matchLabels(labels, map[string]string{"app": "nginx", "env": "dev"}) {
+		if matchLabels(podLabels, mustParse(t, "app=nginx,env=dev")) {
 			t.Fatal("expected no match on env=dev")
 		}
 	})
 
 	t.Run("empty selector always matches", func(t *testing.T) {
-		if !matchLabels(labels, map[string]string{}) {
+		if !matchLabels(podLabels, mustParse(t, "")) {
 			t.Fatal("empty selector should always match")
 		}
 	})
 
 	t.Run("missing key", func(t *testing.T) {
-		if matchLabels(labels, map[string]string{"tier": "frontend"}) {
+		if matchLabels(podLabels, mustParse(t, "tier=frontend")) {
 			t.Fatal("expected no match on missing key")
 		}
 	})
@@ -318,19 +335,19 @@ func TestMatchesNodeSelector(t *testing.T) {
 	u.SetLabels(map[string]string{"env": "prod", "zone": "a"})
 
 	t.Run("empty selector matches all", func(t *testing.T) {
-		if !matchesNodeSelector(u, map[string]string{}) {
+		if !matchesNodeSelector(u, labels.Everything()) {
 			t.Fatal("empty selector should match")
 		}
 	})
 
 	t.Run("matching label", func(t *testing.T) {
-		if !matchesNodeSelector(u, map[string]string{"env": "prod"}) {
+		if !matchesNodeSelector(u, mustParse(t, "env=prod")) {
 			t.Fatal("expected match")
 		}
 	})
 
 	t.Run("non-matching label", func(t *testing.T) {
-		if matchesNodeSelector(u, map[string]string{"env": "dev"}) {
+		if matchesNodeSelector(u, mustParse(t, "env=dev")) {
 			t.Fatal("expected no match")
 		}
 	})
@@ -1,7 +1,9 @@
 package capacity
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestCalcPercentage(t *testing.T) {
@@ -177,6 +179,30 @@ func TestFormatLabels(t *testing.T) {
 	if got := formatLabels(map[string]string{"key": ""}); got != "key" {
 		t.Errorf("expected 'key' for empty value, got %q", got)
 	}
+	// Long multibyte values are cut on rune boundaries
+	got := formatLabels(map[string]string{"topology.kubernetes.io/zone": strings.Repeat("北京可用区", 12)})
+	if !utf8.ValidString(got) || utf8.RuneCountInString(got) != 60 || !strings.HasSuffix(got, "...") {
+		t.Errorf("expected 60 valid runes ending in '...', got %q", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s      string
+		maxLen int
+		want   string
+	}{
+		{"node-1", 25, "node-1"},
+		{"a-very-long-node-name-for-testing", 10, "a-very-..."},
+		{"节点名称很长的工作节点", 8, "节点名称很..."},
+		{"🐳🐳🐳🐳🐳", 4, "🐳..."},
+		{"节点名称", 2, "节点"},
+	}
+	for _, tt := range tests {
+		if got := truncate(tt.s, tt.maxLen); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.maxLen, got, tt.want)
+		}
+	}
 }
 
 func TestToAnySlice(t *testing.T) {
@@ -3,6 +3,7 @@ package capacity
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -262,10 +263,14 @@ func formatMemory(val int64, showRaw bool) string {
 	return fmt.Sprintf("%.2fGi", float64(val)/bytesPerGi)
 }
 
-// truncate truncates a string to the specified length
+// truncate truncates a string to maxLen runes, so multibyte characters are never split
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if utf8.RuneCountInString(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	runes := []rune(s)
+	if maxLen <= 3 {
+		return string(runes[:maxLen])
+	}
+	return string(runes[:maxLen-3]) + "..."
 }
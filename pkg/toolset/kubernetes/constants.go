@@ -17,6 +17,16 @@ const (
 	DefaultTailLines    = 100
 	PodInspectTailLines = 50
 
+	// Default number of recent events kubernetes_inspect_pod includes
+	DefaultPodInspectEvents = 20
+
+	// Default cap on kubernetes_logs output size (0 disables the cap)
+	DefaultLogMaxBytes = 1024 * 1024
+
+	// Upper bound on the pods kubernetes_logs fetches in parallel with a
+	// labelSelector; the default is steve.DefaultMultiPodLogConcurrency
+	MaxLogConcurrency = 32
+
 	// Table formatting constants
 	DefaultNameTruncateLen = 40
 	DefaultNSTruncateLen   = 20
@@ -27,14 +37,15 @@ const (
 	DefaultPage  = 1
 
 	// Watch/diff defaults
-	DefaultIntervalSeconds = 10
-	DefaultIterations      = 6
-	MinIntervalSeconds     = 1
-	MaxIntervalSeconds     = 600
-	MinIterations          = 1
-	MaxIterations          = 100
-	MaxWatchItems          = 200
-	MaxWatchOutputBytes    = 256 * 1024
+	DefaultIntervalSeconds  = 10
+	DefaultIterations       = 6
+	MinIntervalSeconds      = 1
+	MaxIntervalSeconds      = 600
+	MinIterations           = 1
+	MaxIterations           = 100
+	MaxWatchItems           = 200
+	MaxWatchOutputBytes     = 256 * 1024
+	DefaultStableIterations = 3
 
 	// Dep graph defaults
 	DefaultMaxDepth = 10
@@ -43,4 +54,28 @@ const (
 
 	// Container file operation defaults
 	DefaultMaxFileSize = "10Mi"
+
+	// Rollout status defaults
+	DefaultRolloutTimeoutSeconds = 300
+	MaxRolloutTimeoutSeconds     = 600
+	RolloutPollIntervalSeconds   = 2
+
+	// Server-side apply field manager used when none is given
+	DefaultFieldManager = "rancher-mcp-server"
+
+	// Node role labels
+	NodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	LegacyNodeRoleLabel = "kubernetes.io/role"
+
+	// Label linking an EndpointSlice to its Service
+	EndpointSliceServiceNameLabel = "kubernetes.io/service-name"
+
+	// Wait defaults
+	DefaultWaitTimeoutSeconds  = 60
+	MaxWaitTimeoutSeconds      = 600
+	DefaultWaitIntervalSeconds = 2
+
+	// Container exec defaults
+	DefaultExecTimeoutSeconds = 30
+	MaxExecTimeoutSeconds     = 600
 )
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // getHandler handles the kubernetes_get tool
@@ -28,25 +30,120 @@ func getHandler(ctx context.Context, client interface{}, params map[string]inter
 	if err != nil {
 		return "", err
 	}
-	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	names, err := extractResourceNames(params)
 	if err != nil {
 		return "", err
 	}
 	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
 	format := paramutil.ExtractFormat(params)
 	filter := paramutil.NewResourceFilterFromParams(params)
+	jp, err := parseJSONPath(paramutil.ExtractOptionalString(params, paramutil.ParamJSONPath))
+	if err != nil {
+		return "", err
+	}
+	includePaths := parseIncludePaths(paramutil.ExtractOptionalString(params, paramutil.ParamIncludePaths))
 
-	resource, err := steveClient.GetResource(ctx, cluster, kind, namespace, name)
+	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true,
+	// and any fields named by maskPaths
+	sensitiveFilter, err := paramutil.NewSensitiveDataFilterFromParams(params)
 	if err != nil {
-		return "", fmt.Errorf("failed to get resource: %w", err)
+		return "", err
+	}
+	// Decoding is a read convenience only offered once the data is revealed
+	decode := paramutil.ExtractBool(params, paramutil.ParamShowSensitiveData, false) && paramutil.ExtractBool(params, paramutil.ParamDecodeSecretData, false)
+	includeOwners := paramutil.ExtractBool(params, paramutil.ParamIncludeOwners, false)
+
+	// Keys left encoded and owners are reported beside the resource, never
+	// inside it, so the output can be applied back without extra fields.
+	// Owners are keyed by resource name, as includePaths may drop the name.
+	var undecoded []UndecodedSecretKey
+	var owners map[string][]ResourceOwner
+	if includeOwners {
+		owners = make(map[string][]ResourceOwner, len(names))
+	}
+	prepare := func(resource *unstructured.Unstructured) *unstructured.Unstructured {
+		if sensitiveFilter != nil {
+			resource = sensitiveFilter.Filter(resource)
+		}
+		if decode {
+			var keys []UndecodedSecretKey
+			resource, keys = decodeSecretData(resource)
+			undecoded = append(undecoded, keys...)
+		}
+		if includeOwners {
+			owners[resource.GetName()] = resolveOwners(ctx, steveClient, cluster, resource)
+		}
+		if jp == nil && len(includePaths) > 0 {
+			resource = projectResource(resource, includePaths)
+		}
+		return resource
 	}
 
-	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true
-	if sensitiveFilter := paramutil.NewSensitiveDataFilterFromParams(params); sensitiveFilter != nil {
-		resource = sensitiveFilter.Filter(resource)
+	// The names parameter, or several names, return the resources as a list
+	// like kubernetes_list
+	if getsResourceList(params, names) {
+		list, failures := getResources(ctx, steveClient, cluster, kind, namespace, names)
+		if len(list.Items) == 0 {
+			return "", fmt.Errorf("failed to get resources:\n  %s", strings.Join(formatGetFailureLines(failures), "\n  "))
+		}
+		fetched := make([]string, 0, len(list.Items))
+		for i := range list.Items {
+			fetched = append(fetched, list.Items[i].GetName())
+			list.Items[i] = *prepare(&list.Items[i])
+		}
+
+		// JSON and YAML report the failed names inside the output so it stays parseable
+		if jp == nil && (format == paramutil.FormatJSON || format == paramutil.FormatYAML) {
+			return formatResourceGetResult(list, failures, owners, undecoded, format, filter)
+		}
+
+		var output string
+		if jp != nil {
+			output, err = formatResourceListJSONPath(list, jp)
+		} else {
+			output, err = formatResourceList(list, format, filter, nil)
+		}
+		if err != nil {
+			return "", err
+		}
+		// CSV stays plain rows and does not report the failed names; use json to see them
+		if format == paramutil.FormatCSV && jp == nil {
+			return output, nil
+		}
+		return output + formatGetFailures(failures, len(names)) + formatOwners(fetched, owners) + formatUndecodedData(undecoded), nil
 	}
 
-	return formatResource(resource, format, filter)
+	resource, err := steveClient.GetResource(ctx, cluster, kind, namespace, names[0])
+	if err != nil {
+		return "", fmt.Errorf("failed to get resource: %w", err)
+	}
+	resource = prepare(resource)
+
+	var output string
+	switch {
+	case jp != nil:
+		output, err = formatResourceJSONPath(resource, jp)
+	case format == paramutil.FormatJSON || format == paramutil.FormatYAML:
+		if includeOwners {
+			return formatOwnedResource(resource, owners[names[0]], undecoded, format, filter)
+		}
+		// A decoded Secret is wrapped so the undecoded keys sit beside it
+		if decode && strings.EqualFold(resource.GetKind(), "Secret") {
+			return formatDecodedSecret(resource, undecoded, format, filter)
+		}
+		return formatResource(resource, format, filter)
+	default:
+		// List formats render the resource as a one-item list like kubernetes_list
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*resource}}
+		output, err = formatResourceList(list, format, filter, nil)
+	}
+	if err != nil {
+		return "", err
+	}
+	if format == paramutil.FormatCSV && jp == nil {
+		return output, nil
+	}
+	return output + formatOwners(names, owners) + formatUndecodedData(undecoded), nil
 }
 
 // listHandler handles the kubernetes_list tool
@@ -71,37 +168,170 @@ func listHandler(ctx context.Context, client interface{}, params map[string]inte
 	page := paramutil.ExtractInt64(params, paramutil.ParamPage, DefaultPage)
 	format := paramutil.ExtractFormat(params)
 	filter := paramutil.NewResourceFilterFromParams(params)
+	columns, err := parseColumns(paramutil.ExtractOptionalString(params, paramutil.ParamColumns))
+	if err != nil {
+		return "", err
+	}
+	showKeys := paramutil.ExtractBool(params, paramutil.ParamShowKeys, false)
+	continueToken := paramutil.ExtractOptionalString(params, paramutil.ParamContinue)
+	resourceSort, err := parseResourceSort(
+		paramutil.ExtractOptionalString(params, paramutil.ParamSortBy),
+		paramutil.ExtractOptionalString(params, paramutil.ParamSortOrder),
+	)
+	if err != nil {
+		return "", err
+	}
+	if resourceSort != nil && continueToken != "" {
+		return "", fmt.Errorf("sortBy cannot be combined with continue: sorting needs the full list")
+	}
+	jp, err := parseJSONPath(paramutil.ExtractOptionalString(params, paramutil.ParamJSONPath))
+	if err != nil {
+		return "", err
+	}
 
-	// Server-side: labelSelector (no limit here to allow client-side pagination)
 	opts := &steve.ListOptions{
 		LabelSelector: labelSelector,
 	}
+	// Sorting must see every item, so it always paginates client-side
+	serverSide := resourceSort == nil && useServerSidePagination(continueToken, nameFilter, limit, page)
+	if serverSide {
+		opts.Limit = limit
+		opts.Continue = continueToken
+	}
 
 	list, err := steveClient.ListResources(ctx, cluster, kind, namespace, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to list resources: %w", err)
 	}
 
-	// Client-side: name filter (K8s doesn't support partial match)
+	// Client-side: name filter (K8s doesn't support partial match).
+	// With server-side pagination it only applies within the fetched page.
 	if nameFilter != "" {
 		list = filterResourcesByName(list, nameFilter)
 	}
 
+	// Sort before paginating so pages are stable
+	applyResourceSort(list, resourceSort)
+
 	// Client-side: page pagination
-	list = paginateResourceList(list, limit, page)
+	if !serverSide {
+		list = paginateResourceList(list, limit, page)
+	}
 
-	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true
-	if sensitiveFilter := paramutil.NewSensitiveDataFilterFromParams(params); sensitiveFilter != nil {
+	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true,
+	// and any fields named by maskPaths
+	sensitiveFilter, err := paramutil.NewSensitiveDataFilterFromParams(params)
+	if err != nil {
+		return "", err
+	}
+	if sensitiveFilter != nil {
 		list = sensitiveFilter.FilterList(list)
 	}
 
-	return formatResourceList(list, format, filter)
+	if jp == nil && (format == paramutil.FormatJSON || format == paramutil.FormatYAML) {
+		return formatStructuredResourceList(params, list, format, filter)
+	}
+
+	var output string
+	switch {
+	case jp != nil:
+		output, err = formatResourceListJSONPath(list, jp)
+	case showKeys && len(columns) == 0 && (format == paramutil.FormatTable || format == paramutil.FormatWide):
+		output = formatAsKeysTable(list)
+	default:
+		output, err = formatResourceList(list, format, filter, columns)
+	}
+	if err != nil {
+		return "", err
+	}
+	// CSV stays plain rows; callers that page through it should use json instead
+	if serverSide && format != paramutil.FormatCSV {
+		output += formatContinueNote(list.GetContinue())
+	}
+	return output, nil
+}
+
+// resourceListPage is the json and yaml output of kubernetes_list. Continue is
+// the token for the next server-side page and empty on the last page.
+type resourceListPage struct {
+	Items    []map[string]interface{} `json:"items" yaml:"items"`
+	Continue string                   `json:"continue" yaml:"continue"`
+}
+
+// usePagedListShape reports whether json and yaml kubernetes_list output is
+// the {items, continue} object rather than the plain array. It depends only
+// on whether the caller paged with limit or continue, never on how many items
+// came back, so the shape of a call is known before it is made.
+func usePagedListShape(params map[string]interface{}) bool {
+	_, hasLimit := params[paramutil.ParamLimit]
+	_, hasContinue := params[paramutil.ParamContinue]
+	return hasLimit || hasContinue
+}
+
+// formatStructuredResourceList renders kubernetes_list json and yaml output:
+// the {items, continue} object when the caller pages, so the continue token
+// stays parseable, and the plain array of resources otherwise.
+func formatStructuredResourceList(params map[string]interface{}, list *unstructured.UnstructuredList, format string, filter *paramutil.ResourceFilter) (string, error) {
+	if usePagedListShape(params) {
+		return formatResourceListPage(list, format, filter)
+	}
+	return formatResourceList(list, format, filter, nil)
+}
+
+// formatResourceListPage renders a kubernetes_list page as a JSON or YAML object.
+func formatResourceListPage(list *unstructured.UnstructuredList, format string, filter *paramutil.ResourceFilter) (string, error) {
+	continueToken := list.GetContinue()
+	if filter != nil {
+		list = filter.FilterList(list)
+	}
+	return formatStructured(resourceListPage{Items: resourceListObjects(list), Continue: continueToken}, format)
+}
+
+// resourceListObjects returns the raw objects of the list items, which
+// marshal the same way in JSON and YAML.
+func resourceListObjects(list *unstructured.UnstructuredList) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(list.Items))
+	for _, item := range list.Items {
+		objects = append(objects, item.Object)
+	}
+	return objects
+}
+
+// formatStructured renders v as YAML for the yaml format and as JSON otherwise.
+func formatStructured(v interface{}, format string) (string, error) {
+	if format == paramutil.FormatYAML {
+		return paramutil.FormatAsYAML(v)
+	}
+	return paramutil.FormatAsJSON(v)
+}
+
+// useServerSidePagination reports whether kubernetes_list should page with
+// limit/continue on the API server. An explicit continue token always does;
+// otherwise only an unfiltered first page does, since a name filter needs the
+// full list to search and page>1 keeps the offset-based client-side paging.
+func useServerSidePagination(continueToken, nameFilter string, limit, page int64) bool {
+	if limit <= 0 {
+		return false
+	}
+	if continueToken != "" {
+		return true
+	}
+	return nameFilter == "" && page <= 1
+}
+
+// formatContinueNote tells the caller how to fetch the next server-side page.
+func formatContinueNote(token string) string {
+	if token == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nNote: more results available; pass continue=%q to fetch the next page", token)
 }
 
 // createHandler handles the kubernetes_create tool
 func createHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
-	// Check read-only mode
-	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+	// Check read-only mode; dry runs persist nothing and are always allowed
+	dryRun := paramutil.ExtractBool(params, paramutil.ParamDryRun, false)
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly && !dryRun {
 		return "", paramutil.ErrReadOnlyMode
 	}
 
@@ -126,10 +356,11 @@ func createHandler(ctx context.Context, client interface{}, params map[string]in
 		return "", fmt.Errorf("failed to parse resource JSON: %w", err)
 	}
 
-	created, err := steveClient.CreateResource(ctx, cluster, &resource)
+	created, err := steveClient.CreateResource(ctx, cluster, &resource, dryRun)
 	if err != nil {
 		return "", fmt.Errorf("failed to create resource: %w", err)
 	}
+	invalidateNamespaceProject(client, cluster, created.GetKind(), created.GetName())
 
 	return formatResource(created, paramutil.FormatJSON, filter)
 }
@@ -163,16 +394,101 @@ func patchHandler(ctx context.Context, client interface{}, params map[string]int
 	if err != nil {
 		return "", err
 	}
+	patchType, err := parsePatchType(paramutil.ExtractOptionalString(params, paramutil.ParamPatchType))
+	if err != nil {
+		return "", err
+	}
 	filter := paramutil.NewResourceFilterFromParams(params)
 
-	patched, err := steveClient.PatchResource(ctx, cluster, kind, namespace, name, []byte(patchStr))
+	patched, err := steveClient.PatchResource(ctx, cluster, kind, namespace, name, patchType, []byte(patchStr))
 	if err != nil {
 		return "", fmt.Errorf("failed to patch resource: %w", err)
 	}
+	invalidateNamespaceProject(client, cluster, kind, name)
 
 	return formatResource(patched, paramutil.FormatJSON, filter)
 }
 
+// patchTypes maps the kubernetes_patch patchType values to Kubernetes patch types.
+var patchTypes = map[string]types.PatchType{
+	"json":      types.JSONPatchType,
+	"merge":     types.MergePatchType,
+	"strategic": types.StrategicMergePatchType,
+}
+
+// parsePatchType resolves a patchType parameter, defaulting to JSON Patch.
+func parsePatchType(patchType string) (types.PatchType, error) {
+	if patchType == "" {
+		return types.JSONPatchType, nil
+	}
+	pt, ok := patchTypes[strings.ToLower(patchType)]
+	if !ok {
+		return "", fmt.Errorf("unsupported patchType %q: must be one of json, merge, strategic", patchType)
+	}
+	return pt, nil
+}
+
+// applyHandler handles the kubernetes_apply tool
+func applyHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	// Check read-only mode; dry runs persist nothing and are always allowed
+	dryRun := paramutil.ExtractBool(params, paramutil.ParamDryRun, false)
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly && !dryRun {
+		return "", paramutil.ErrReadOnlyMode
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	resourceJSON, err := paramutil.ExtractRequiredString(params, paramutil.ParamResource)
+	if err != nil {
+		return "", err
+	}
+	opts := steve.ApplyOptions{
+		FieldManager: paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFieldManager, DefaultFieldManager),
+		Force:        paramutil.ExtractBool(params, paramutil.ParamForce, false),
+		DryRun:       dryRun,
+	}
+	filter := paramutil.NewResourceFilterFromParams(params)
+
+	resource, err := parseApplyManifest(resourceJSON)
+	if err != nil {
+		return "", err
+	}
+
+	applied, err := steveClient.ApplyResource(ctx, cluster, resource, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to apply resource: %w", err)
+	}
+	invalidateNamespaceProject(client, cluster, applied.GetKind(), applied.GetName())
+
+	return formatResource(applied, paramutil.FormatJSON, filter)
+}
+
+// parseApplyManifest parses a resource manifest for server-side apply, which
+// needs apiVersion, kind and metadata.name to identify the target object.
+func parseApplyManifest(resourceJSON string) (*unstructured.Unstructured, error) {
+	var resource unstructured.Unstructured
+	if err := json.Unmarshal([]byte(resourceJSON), &resource.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse resource JSON: %w", err)
+	}
+
+	switch {
+	case resource.GetAPIVersion() == "":
+		return nil, fmt.Errorf("resource manifest must set apiVersion")
+	case resource.GetKind() == "":
+		return nil, fmt.Errorf("resource manifest must set kind")
+	case resource.GetName() == "":
+		return nil, fmt.Errorf("resource manifest must set metadata.name")
+	}
+	return &resource, nil
+}
+
 // deleteHandler handles the kubernetes_delete tool
 func deleteHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
 	// Check read-only mode
@@ -206,10 +522,35 @@ func deleteHandler(ctx context.Context, client interface{}, params map[string]in
 	if err := steveClient.DeleteResource(ctx, cluster, kind, namespace, name); err != nil {
 		return "", fmt.Errorf("failed to delete resource: %w", err)
 	}
+	invalidateNamespaceProject(client, cluster, kind, name)
 
 	return fmt.Sprintf("Successfully deleted %s/%s in namespace %s", kind, name, namespace), nil
 }
 
+// invalidateNamespaceProject forgets the project cached for a namespace after
+// a write to it, since the write may have changed its project annotation or
+// label. Writes to other kinds are ignored.
+func invalidateNamespaceProject(client interface{}, cluster, kind, name string) {
+	combined, ok := client.(*toolset.CombinedClient)
+	if !ok || combined.Norman == nil || !isNamespaceKind(kind) {
+		return
+	}
+	combined.Norman.InvalidateNamespaceProject(cluster, name)
+}
+
+// isNamespaceKind reports whether kind, optionally prefixed with an
+// apiVersion, names core Namespaces.
+func isNamespaceKind(kind string) bool {
+	if i := strings.LastIndex(kind, "/"); i >= 0 {
+		if kind[:i] != "v1" {
+			return false
+		}
+		kind = kind[i+1:]
+	}
+	gvr, ok := steve.GetGVR(strings.ToLower(kind))
+	return ok && gvr.Group == "" && gvr.Resource == "namespaces"
+}
+
 func extractResourceKind(params map[string]interface{}) (string, error) {
 	kind, err := paramutil.ExtractRequiredString(params, paramutil.ParamKind)
 	if err != nil {
@@ -242,8 +583,9 @@ func formatResource(resource *unstructured.Unstructured, format string, filter *
 	}
 }
 
-// formatResourceList formats a resource list as JSON, YAML, or table
-func formatResourceList(list *unstructured.UnstructuredList, format string, filter *paramutil.ResourceFilter) (string, error) {
+// formatResourceList formats a resource list as JSON, YAML, table, wide table, CSV or markdown.
+// When columns are given, the table shows them instead of the default KIND column.
+func formatResourceList(list *unstructured.UnstructuredList, format string, filter *paramutil.ResourceFilter, columns []columnPath) (string, error) {
 	// Apply filter if configured
 	if filter != nil {
 		list = filter.FilterList(list)
@@ -257,7 +599,21 @@ func formatResourceList(list *unstructured.UnstructuredList, format string, filt
 		}
 		return string(data), nil
 	case paramutil.FormatTable:
+		if len(columns) > 0 {
+			return formatAsCustomColumnsTable(list, columns), nil
+		}
 		return formatAsTable(list), nil
+	case paramutil.FormatWide:
+		if len(columns) > 0 {
+			return formatAsCustomColumnsTable(list, columns), nil
+		}
+		return formatAsWideTable(list), nil
+	case paramutil.FormatCSV, paramutil.FormatMarkdown:
+		if format == paramutil.FormatMarkdown && len(list.Items) == 0 {
+			return "No resources found", nil
+		}
+		headers, rows := resourceListRows(list, columns)
+		return paramutil.FormatRows(rows, headers, format)
 	default: // json
 		data, err := json.MarshalIndent(list.Items, "", "  ")
 		if err != nil {
@@ -272,11 +628,14 @@ func formatAsTable(list *unstructured.UnstructuredList) string {
 	if len(list.Items) == 0 {
 		return "No resources found"
 	}
+	if autoWidth, maxWidth := paramutil.TableAutoWidth(); autoWidth {
+		return formatAsAutoWidthTable(list, maxWidth)
+	}
 
 	var b strings.Builder
 	// Build table header
-	fmt.Fprintf(&b, "%-40s %-20s %-15s\n", "NAME", "NAMESPACE", "KIND")
-	fmt.Fprintf(&b, "%-40s %-20s %-15s\n", "----", "---------", "----")
+	fmt.Fprintf(&b, "%-40s %-20s %-15s %s\n", "NAME", "NAMESPACE", "KIND", "AGE")
+	fmt.Fprintf(&b, "%-40s %-20s %-15s %s\n", "----", "---------", "----", "---")
 
 	// Build table rows
 	for _, item := range list.Items {
@@ -284,21 +643,67 @@ func formatAsTable(list *unstructured.UnstructuredList) string {
 		if namespace == "" {
 			namespace = "-"
 		}
-		fmt.Fprintf(&b, "%-40s %-20s %-15s\n", truncate(item.GetName(), DefaultNameTruncateLen), truncate(namespace, DefaultNSTruncateLen), truncate(item.GetKind(), DefaultKindTruncateLen))
+		fmt.Fprintf(&b, "%-40s %-20s %-15s %s\n", truncate(item.GetName(), DefaultNameTruncateLen), truncate(namespace, DefaultNSTruncateLen), truncate(item.GetKind(), DefaultKindTruncateLen), resourceAge(item))
 	}
 
 	return b.String()
 }
 
-// truncate truncates a string to the specified length
+// formatAsAutoWidthTable renders the columns of formatAsTable sized to their
+// widest value, truncating values longer than maxWidth runes.
+func formatAsAutoWidthTable(list *unstructured.UnstructuredList, maxWidth int) string {
+	headers, rows := tableRows(list)
+	for _, row := range rows {
+		for header, value := range row {
+			row[header] = truncate(value, maxWidth)
+		}
+	}
+	return paramutil.FormatAsTable(rows, headers)
+}
+
+// resourceListRows returns the csv and markdown headers and rows of list:
+// the requested columns, or else the wide columns when the kind has them and
+// the table columns otherwise. Values are not truncated.
+func resourceListRows(list *unstructured.UnstructuredList, columns []columnPath) ([]string, []map[string]string) {
+	if len(columns) > 0 {
+		return customColumnsRows(list, columns)
+	}
+	if headers, rows, ok := wideTableRows(list); ok {
+		return headers, rows
+	}
+	return tableRows(list)
+}
+
+// tableRows returns the NAME, NAMESPACE, KIND and AGE headers and untruncated rows of list.
+func tableRows(list *unstructured.UnstructuredList) ([]string, []map[string]string) {
+	headers := []string{"NAME", "NAMESPACE", "KIND", "AGE"}
+	rows := make([]map[string]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespace := item.GetNamespace()
+		if namespace == "" {
+			namespace = "-"
+		}
+		rows = append(rows, map[string]string{
+			"NAME":      item.GetName(),
+			"NAMESPACE": namespace,
+			"KIND":      item.GetKind(),
+			"AGE":       resourceAge(item),
+		})
+	}
+	return headers, rows
+}
+
+// truncate truncates a string to maxLen runes, so multibyte characters are
+// never split. A maxLen of zero or less returns s unchanged.
 func truncate(s string, maxLen int) string {
-	if maxLen <= 0 || len(s) <= maxLen {
+	if maxLen <= 0 || utf8.RuneCountInString(s) <= maxLen {
 		return s
 	}
+	runes := []rune(s)
 	if maxLen <= 3 {
-		return s[:maxLen]
+		return string(runes[:maxLen])
 	}
-	return s[:maxLen-3] + "..."
+	return string(runes[:maxLen-3]) + "..."
 }
 
 // filterResourcesByName filters resources by name (partial match, case-insensitive).
@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// accessVerbs are the verbs kubernetes_can_i accepts.
+var accessVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// canIHandler handles the kubernetes_can_i tool
+func canIHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	verb, err := parseAccessVerb(params)
+	if err != nil {
+		return "", err
+	}
+	kind, err := extractResourceKind(params)
+	if err != nil {
+		return "", err
+	}
+	check := steve.AccessCheck{
+		Verb:        verb,
+		Kind:        kind,
+		Subresource: paramutil.ExtractOptionalString(params, paramutil.ParamSubresource),
+		Namespace:   paramutil.ExtractOptionalString(params, paramutil.ParamNamespace),
+		Name:        paramutil.ExtractOptionalString(params, paramutil.ParamName),
+	}
+
+	result, err := steveClient.CheckAccess(ctx, cluster, check)
+	if err != nil {
+		return "", fmt.Errorf("failed to check access: %w", err)
+	}
+
+	return paramutil.FormatSingleResult(accessResultToMap(check, result), format,
+		"verb", "kind", "namespace", "name", "allowed", "reason")
+}
+
+// parseAccessVerb extracts the verb parameter and checks it is supported.
+func parseAccessVerb(params map[string]interface{}) (string, error) {
+	verb, err := paramutil.ExtractRequiredString(params, paramutil.ParamVerb)
+	if err != nil {
+		return "", err
+	}
+	verb = strings.ToLower(strings.TrimSpace(verb))
+	if !slices.Contains(accessVerbs, verb) {
+		return "", fmt.Errorf("unsupported verb %q (must be one of: %s)", verb, strings.Join(accessVerbs, ", "))
+	}
+	return verb, nil
+}
+
+// accessResultToMap builds the output of kubernetes_can_i.
+func accessResultToMap(check steve.AccessCheck, result *steve.AccessResult) map[string]interface{} {
+	kind := check.Kind
+	if check.Subresource != "" {
+		kind += "/" + check.Subresource
+	}
+	data := map[string]interface{}{
+		"verb":      check.Verb,
+		"kind":      kind,
+		"namespace": check.Namespace,
+		"name":      check.Name,
+		"allowed":   result.Allowed,
+		"denied":    result.Denied,
+		"reason":    result.Reason,
+	}
+	if result.EvaluationError != "" {
+		data["evaluationError"] = result.EvaluationError
+	}
+	return data
+}
@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+)
+
+func TestParseAccessVerb(t *testing.T) {
+	tests := []struct {
+		verb    interface{}
+		want    string
+		wantErr bool
+	}{
+		{verb: "get", want: "get"},
+		{verb: " Delete ", want: "delete"},
+		{verb: "escalate", wantErr: true},
+		{verb: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		params := map[string]interface{}{}
+		if tt.verb != nil {
+			params["verb"] = tt.verb
+		}
+		got, err := parseAccessVerb(params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAccessVerb(%v) error = %v, wantErr %v", tt.verb, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseAccessVerb(%v) = %q, want %q", tt.verb, got, tt.want)
+		}
+	}
+}
+
+func TestAccessResultToMap(t *testing.T) {
+	check := steve.AccessCheck{Verb: "get", Kind: "pod", Subresource: "log", Namespace: "default"}
+
+	data := accessResultToMap(check, &steve.AccessResult{Allowed: true, Reason: "RBAC: allowed by RoleBinding"})
+	if data["kind"] != "pod/log" || data["allowed"] != true || data["reason"] != "RBAC: allowed by RoleBinding" {
+		t.Errorf("unexpected result map: %v", data)
+	}
+	if _, ok := data["evaluationError"]; ok {
+		t.Error("expected no evaluationError without one")
+	}
+
+	data = accessResultToMap(check, &steve.AccessResult{EvaluationError: "webhook unavailable"})
+	if data["evaluationError"] != "webhook unavailable" {
+		t.Errorf("expected evaluationError, got %v", data)
+	}
+}
+
+func TestCanIHandler_RequiresSteveClient(t *testing.T) {
+	_, err := canIHandler(context.Background(), nil, map[string]interface{}{"cluster": "c1", "verb": "get", "kind": "pod"})
+	if err == nil {
+		t.Fatal("expected error without a kubernetes client")
+	}
+}
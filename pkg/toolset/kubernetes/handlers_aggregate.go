@@ -60,19 +60,27 @@ func workloadHealthHandler(ctx context.Context, client interface{}, params map[s
 	kind := extractStringParam(params, "kind", "all")
 	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
 	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	name := paramutil.ExtractOptionalString(params, paramutil.ParamName)
+	image := paramutil.ExtractOptionalString(params, "image")
+	includeContainers := paramutil.ExtractBool(params, "includeContainers", false)
+	showSensitiveData := paramutil.ExtractBool(params, paramutil.ParamShowSensitiveData, false)
 	sortBy := extractStringParam(params, "sortBy", "")
 	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
 	format := paramutil.ExtractFormat(params)
 
 	analyzer := aggregate.NewWorkloadAnalyzer(steveClient)
 	result, err := analyzer.Analyze(ctx, aggregate.WorkloadParams{
-		Cluster:       cluster,
-		Kind:          kind,
-		Namespace:     namespace,
-		LabelSelector: labelSelector,
-		SortBy:        sortBy,
-		Limit:         limit,
-		Format:        format,
+		Cluster:           cluster,
+		Kind:              kind,
+		Namespace:         namespace,
+		LabelSelector:     labelSelector,
+		Name:              name,
+		Image:             image,
+		IncludeContainers: includeContainers,
+		ShowSensitiveData: showSensitiveData,
+		SortBy:            sortBy,
+		Limit:             limit,
+		Format:            format,
 	})
 	if err != nil {
 		return "", fmt.Errorf("workload health analysis failed: %w", err)
@@ -157,6 +165,275 @@ func eventSummaryHandler(ctx context.Context, client interface{}, params map[str
 	return aggregate.FormatResult(result, format)
 }
 
+// pvcStatusHandler handles the kubernetes_pvc_status tool
+func pvcStatusHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewPVCAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.PVCParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("pvc status analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// hpaStatusHandler handles the kubernetes_hpa_status tool
+func hpaStatusHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewHPAAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.HPAParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("hpa status analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// cronJobStatusHandler handles the kubernetes_cronjob_status tool
+func cronJobStatusHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewCronJobAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.CronJobParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cronjob status analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// networkPolicyListHandler handles the kubernetes_networkpolicy_list tool
+func networkPolicyListHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	checkCoverage := paramutil.ExtractBool(params, paramutil.ParamCheckCoverage, false)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewNetworkPolicyAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.NetworkPolicyParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		CheckCoverage: checkCoverage,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("networkpolicy analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// namespaceQuotaHandler handles the kubernetes_namespace_quota tool
+func namespaceQuotaHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewQuotaAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.QuotaParams{
+		Cluster:   cluster,
+		Namespace: namespace,
+		Format:    format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("namespace quota analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// imagesHandler handles the kubernetes_images tool
+func imagesHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewImageAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.ImageParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("image inventory failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// countsHandler handles the kubernetes_counts tool
+func countsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	kinds := parseKindList(paramutil.ExtractOptionalString(params, "kinds"))
+	byNamespace := paramutil.ExtractBool(params, "byNamespace", false)
+	concurrency := extractIntParam(params, paramutil.ParamConcurrency, aggregate.DefaultCountConcurrency)
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewCountAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.CountParams{
+		Cluster:        cluster,
+		Namespace:      namespace,
+		Kinds:          kinds,
+		ByNamespace:    byNamespace,
+		MaxConcurrency: concurrency,
+		Format:         format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("resource count failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// unhealthyPodsHandler handles the kubernetes_unhealthy_pods tool
+func unhealthyPodsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	pendingThreshold := aggregate.DefaultPendingThreshold
+	if value := paramutil.ExtractOptionalString(params, "pendingThreshold"); value != "" {
+		pendingThreshold, err = parseDuration(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid pendingThreshold: %w", err)
+		}
+	}
+	restartThreshold := extractIntParam(params, "restartThreshold", aggregate.DefaultRestartThreshold)
+	includeEvents := paramutil.ExtractBool(params, "includeEvents", true)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewUnhealthyPodAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.UnhealthyPodParams{
+		Cluster:          cluster,
+		Namespace:        namespace,
+		LabelSelector:    labelSelector,
+		PendingThreshold: pendingThreshold,
+		RestartThreshold: int32(restartThreshold),
+		IncludeEvents:    includeEvents,
+		Limit:            limit,
+		Format:           format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unhealthy pod triage failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
 // extractStringParam extracts a string parameter with a default value
 func extractStringParam(params map[string]interface{}, key, defaultValue string) string {
 	if val, ok := params[key].(string); ok {
@@ -181,3 +458,105 @@ func extractIntParam(params map[string]interface{}, key string, defaultValue int
 	}
 	return defaultValue
 }
+
+// deploymentHealthHandler handles the kubernetes_deployment_health tool
+func deploymentHealthHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	unhealthyOnly := paramutil.ExtractBool(params, "unhealthyOnly", false)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewDeploymentHealthAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.DeploymentHealthParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		UnhealthyOnly: unhealthyOnly,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("deployment health analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// pdbStatusHandler handles the kubernetes_pdb_status tool
+func pdbStatusHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	blockingOnly := paramutil.ExtractBool(params, "blockingOnly", false)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewPDBAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.PDBParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		BlockingOnly:  blockingOnly,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("pdb status analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
+
+// serviceHealthHandler handles the kubernetes_service_health tool
+func serviceHealthHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
+	unhealthyOnly := paramutil.ExtractBool(params, "unhealthyOnly", false)
+	limit := aggregate.ClampLimit(extractIntParam(params, paramutil.ParamLimit, aggregate.DefaultLimit))
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+
+	analyzer := aggregate.NewServiceHealthAnalyzer(steveClient)
+	result, err := analyzer.Analyze(ctx, aggregate.ServiceHealthParams{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+		UnhealthyOnly: unhealthyOnly,
+		Limit:         limit,
+		Format:        format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("service health analysis failed: %w", err)
+	}
+
+	return aggregate.FormatResult(result, format)
+}
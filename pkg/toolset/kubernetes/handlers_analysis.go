@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -43,6 +44,7 @@ func depHandler(ctx context.Context, client interface{}, params map[string]inter
 	}
 
 	depsIsDependencies := request.ResolveOptions.Direction == "dependencies"
+	result = dep.FilterKinds(result, request.Kinds, depsIsDependencies)
 
 	switch request.Format {
 	case "json":
@@ -52,6 +54,42 @@ func depHandler(ctx context.Context, client interface{}, params map[string]inter
 	}
 }
 
+// treeHandler handles the kubernetes_tree tool. It is kubernetes_dep
+// restricted to the ownerReference chains below the root.
+func treeHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := buildDepRequest(params)
+	if err != nil {
+		return "", err
+	}
+	request.ResolveOptions.Direction = "dependents"
+	request.ResolveOptions.OwnerReferencesOnly = true
+
+	result, err := dep.Resolve(
+		ctx,
+		steveClient,
+		request.Cluster,
+		request.Kind,
+		request.Namespace,
+		request.Name,
+		request.ResolveOptions,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve ownership tree: %w", err)
+	}
+
+	switch request.Format {
+	case "json":
+		return dep.FormatJSON(result, false)
+	default: // tree
+		return dep.FormatTree(result, false), nil
+	}
+}
+
 type depRequest struct {
 	Cluster        string
 	Kind           string
@@ -59,6 +97,8 @@ type depRequest struct {
 	Namespace      string
 	Format         string
 	ResolveOptions dep.ResolveOptions
+	// Kinds prunes the resolved graph before formatting.
+	Kinds dep.KindFilter
 }
 
 func buildDepRequest(params map[string]interface{}) (*depRequest, error) {
@@ -111,17 +151,49 @@ func buildDepRequest(params map[string]interface{}) (*depRequest, error) {
 			ScanNamespace:     scanNamespace,
 			MaxScannedObjects: maxScannedObjects,
 		},
+		Kinds: dep.KindFilter{
+			Include: parseKindList(paramutil.ExtractOptionalString(params, paramutil.ParamIncludeKinds)),
+			Exclude: parseKindList(paramutil.ExtractOptionalString(params, paramutil.ParamExcludeKinds)),
+		},
 	}, nil
 }
 
+// parseKindList splits a comma-separated list of kinds, dropping empty entries.
+func parseKindList(kinds string) []string {
+	var result []string
+	for _, kind := range strings.Split(kinds, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			result = append(result, kind)
+		}
+	}
+	return result
+}
+
 // NodeAnalysisResult contains the comprehensive analysis of a node.
 type NodeAnalysisResult struct {
-	Node      *unstructured.Unstructured `json:"node"`
-	Capacity  map[string]string          `json:"capacity"`
-	Allocated map[string]string          `json:"allocated"`
-	Taints    []corev1.Taint             `json:"taints"`
-	Labels    map[string]string          `json:"labels"`
-	Pods      []NodePodInfo              `json:"pods"`
+	Node       *unstructured.Unstructured `json:"node"`
+	Capacity   map[string]string          `json:"capacity"`
+	Allocated  map[string]string          `json:"allocated"`
+	Taints     []corev1.Taint             `json:"taints"`
+	Labels     map[string]string          `json:"labels"`
+	Roles      []string                   `json:"roles"`
+	Conditions []NodeCondition            `json:"conditions"`
+	// PhaseSummary counts the node's pods by phase (Running, Pending, ...).
+	PhaseSummary map[string]int `json:"phaseSummary"`
+	// Requested totals the CPU and memory requests of the node's non-terminated
+	// pods; RequestedPercent relates them to the node's allocatable resources.
+	Requested        map[string]string  `json:"requested"`
+	RequestedPercent map[string]float64 `json:"requestedPercent,omitempty"`
+	Pods             []NodePodInfo      `json:"pods"`
+}
+
+// NodeCondition is a node status condition such as Ready or MemoryPressure.
+type NodeCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
 }
 
 // NodePodInfo contains summary information about a pod running on the node.
@@ -168,12 +240,14 @@ func nodeAnalysisHandler(ctx context.Context, client interface{}, params map[str
 // buildNodeAnalysisResult aggregates node metadata and the pods scheduled on it.
 func buildNodeAnalysisResult(ctx context.Context, client *steve.Client, cluster string, node *unstructured.Unstructured, name string) (*NodeAnalysisResult, error) {
 	result := &NodeAnalysisResult{
-		Node:      node,
-		Capacity:  extractStringMap(node.Object, "status", "capacity"),
-		Allocated: extractStringMap(node.Object, "status", "allocatable"),
-		Taints:    extractNodeTaints(node.Object),
-		Labels:    node.GetLabels(),
-		Pods:      []NodePodInfo{},
+		Node:       node,
+		Capacity:   extractStringMap(node.Object, "status", "capacity"),
+		Allocated:  extractStringMap(node.Object, "status", "allocatable"),
+		Taints:     extractNodeTaints(node.Object),
+		Labels:     node.GetLabels(),
+		Roles:      extractNodeRoles(node.GetLabels()),
+		Conditions: extractNodeConditions(node.Object),
+		Pods:       []NodePodInfo{},
 	}
 
 	pods, err := client.ListResources(ctx, cluster, "pod", "", &steve.ListOptions{
@@ -184,6 +258,7 @@ func buildNodeAnalysisResult(ctx context.Context, client *steve.Client, cluster
 	}
 
 	result.Pods = extractNodePods(pods)
+	result.PhaseSummary, result.Requested, result.RequestedPercent = summarizeNodePods(pods, result.Allocated)
 	return result, nil
 }
 
@@ -228,6 +303,59 @@ func extractNodeTaints(obj map[string]interface{}) []corev1.Taint {
 	return result
 }
 
+// extractNodeRoles derives node roles from the node-role.kubernetes.io/<role>
+// labels set by Rancher (controlplane, etcd, worker) and upstream distributions
+// (control-plane, master), plus the legacy kubernetes.io/role label.
+func extractNodeRoles(labels map[string]string) []string {
+	seen := make(map[string]bool)
+	for key, value := range labels {
+		role := ""
+		switch {
+		case strings.HasPrefix(key, NodeRoleLabelPrefix):
+			if value == "false" {
+				continue
+			}
+			role = strings.TrimPrefix(key, NodeRoleLabelPrefix)
+		case key == LegacyNodeRoleLabel:
+			role = value
+		}
+		if role != "" {
+			seen[role] = true
+		}
+	}
+
+	roles := make([]string, 0, len(seen))
+	for role := range seen {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// extractNodeConditions reads the node status conditions.
+func extractNodeConditions(obj map[string]interface{}) []NodeCondition {
+	conditions, found, _ := unstructured.NestedSlice(obj, "status", "conditions")
+	if !found {
+		return []NodeCondition{}
+	}
+
+	result := make([]NodeCondition, 0, len(conditions))
+	for _, c := range conditions {
+		condMap, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cond := NodeCondition{}
+		cond.Type, _ = condMap["type"].(string)
+		cond.Status, _ = condMap["status"].(string)
+		cond.Reason, _ = condMap["reason"].(string)
+		cond.Message, _ = condMap["message"].(string)
+		cond.LastTransitionTime, _ = condMap["lastTransitionTime"].(string)
+		result = append(result, cond)
+	}
+	return result
+}
+
 // extractNodePods summarizes the pods running on a node.
 func extractNodePods(pods *unstructured.UnstructuredList) []NodePodInfo {
 	result := make([]NodePodInfo, 0, len(pods.Items))
@@ -247,14 +375,73 @@ func extractNodePods(pods *unstructured.UnstructuredList) []NodePodInfo {
 	return result
 }
 
+// summarizeNodePods counts pods by phase and totals the CPU/memory requests of
+// pods that still hold their resources (Succeeded and Failed pods do not).
+// Percentages are only reported for resources with a known allocatable amount.
+func summarizeNodePods(pods *unstructured.UnstructuredList, allocatable map[string]string) (map[string]int, map[string]string, map[string]float64) {
+	phaseSummary := make(map[string]int)
+	var totalCPU, totalMemory int64
+	for _, pod := range pods.Items {
+		phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+		if phase == "" {
+			phase = "Unknown"
+		}
+		phaseSummary[phase]++
+		if phase == string(corev1.PodSucceeded) || phase == string(corev1.PodFailed) {
+			continue
+		}
+		cpu, memory, _, _ := podResourceTotals(pod.Object)
+		totalCPU += cpu
+		totalMemory += memory
+	}
+
+	requested := map[string]string{
+		"cpu":    formatResourceQuantity(totalCPU, "cpu"),
+		"memory": formatResourceQuantity(totalMemory, "memory"),
+	}
+
+	percent := make(map[string]float64)
+	if alloc := parseCPUQuantity(allocatable["cpu"]); alloc > 0 {
+		percent["cpu"] = requestPercent(totalCPU, alloc)
+	}
+	if alloc := parseResourceQuantity(allocatable["memory"]); alloc > 0 {
+		percent["memory"] = requestPercent(totalMemory, alloc)
+	}
+	return phaseSummary, requested, percent
+}
+
+// requestPercent returns requested as a percentage of allocatable, rounded to one decimal.
+func requestPercent(requested, allocatable int64) float64 {
+	return math.Round(float64(requested)*1000/float64(allocatable)) / 10
+}
+
 // extractPodResourceUsage returns aggregated CPU/memory requests and limits for a pod.
 func extractPodResourceUsage(obj map[string]interface{}) (cpuRequest, memoryRequest, cpuLimit, memoryLimit string) {
+	totalCPURequest, totalMemoryRequest, totalCPULimit, totalMemoryLimit := podResourceTotals(obj)
+
+	if totalCPURequest > 0 {
+		cpuRequest = formatResourceQuantity(totalCPURequest, "cpu")
+	}
+	if totalMemoryRequest > 0 {
+		memoryRequest = formatResourceQuantity(totalMemoryRequest, "memory")
+	}
+	if totalCPULimit > 0 {
+		cpuLimit = formatResourceQuantity(totalCPULimit, "cpu")
+	}
+	if totalMemoryLimit > 0 {
+		memoryLimit = formatResourceQuantity(totalMemoryLimit, "memory")
+	}
+	return
+}
+
+// podResourceTotals sums CPU (millicores) and memory (bytes) requests and limits
+// across a pod's containers.
+func podResourceTotals(obj map[string]interface{}) (totalCPURequest, totalMemoryRequest, totalCPULimit, totalMemoryLimit int64) {
 	containers, found, _ := unstructured.NestedSlice(obj, "spec", "containers")
 	if !found {
 		return
 	}
 
-	var totalCPURequest, totalMemoryRequest, totalCPULimit, totalMemoryLimit int64
 	for _, c := range containers {
 		container, ok := c.(map[string]interface{})
 		if !ok {
@@ -271,19 +458,6 @@ func extractPodResourceUsage(obj map[string]interface{}) (cpuRequest, memoryRequ
 		totalCPULimit += resourceQuantityFromMap(resources, "limits", "cpu")
 		totalMemoryLimit += resourceQuantityFromMap(resources, "limits", "memory")
 	}
-
-	if totalCPURequest > 0 {
-		cpuRequest = formatResourceQuantity(totalCPURequest, "cpu")
-	}
-	if totalMemoryRequest > 0 {
-		memoryRequest = formatResourceQuantity(totalMemoryRequest, "memory")
-	}
-	if totalCPULimit > 0 {
-		cpuLimit = formatResourceQuantity(totalCPULimit, "cpu")
-	}
-	if totalMemoryLimit > 0 {
-		memoryLimit = formatResourceQuantity(totalMemoryLimit, "memory")
-	}
 	return
 }
 
@@ -297,9 +471,26 @@ func resourceQuantityFromMap(resources map[string]interface{}, section, resource
 	if !ok {
 		return 0
 	}
+	if resource == "cpu" {
+		return parseCPUQuantity(q)
+	}
 	return parseResourceQuantity(q)
 }
 
+// parseCPUQuantity parses a CPU quantity to millicores. Whole or fractional
+// cores ("2", "0.5") are scaled; "m"-suffixed values are already millicores.
+func parseCPUQuantity(q string) int64 {
+	q = strings.TrimSpace(q)
+	if q == "" || strings.HasSuffix(q, "m") {
+		return parseResourceQuantity(q)
+	}
+	f, err := parseNumericFloat(q)
+	if err != nil {
+		return 0
+	}
+	return int64(math.Round(f * MilliCPUBase))
+}
+
 // formatNodeAnalysisResult renders the analysis result as JSON or YAML.
 func formatNodeAnalysisResult(result *NodeAnalysisResult, format string) (string, error) {
 	switch format {
@@ -1,6 +1,12 @@
 package kubernetes
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
 
 func TestParseNumeric(t *testing.T) {
 	tests := []struct {
@@ -130,3 +136,166 @@ func TestBuildDepRequest_DefaultsScanNamespaceToRootNamespace(t *testing.T) {
 		t.Fatalf("expected default scan namespace to match root namespace, got %q", request.ResolveOptions.ScanNamespace)
 	}
 }
+
+func TestExtractNodeRoles(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{
+			name: "rancher role labels",
+			labels: map[string]string{
+				"node-role.kubernetes.io/controlplane": "true",
+				"node-role.kubernetes.io/etcd":         "true",
+				"node-role.kubernetes.io/worker":       "true",
+			},
+			want: []string{"controlplane", "etcd", "worker"},
+		},
+		{
+			name: "upstream labels with empty values",
+			labels: map[string]string{
+				"node-role.kubernetes.io/control-plane": "",
+				"node-role.kubernetes.io/master":        "",
+			},
+			want: []string{"control-plane", "master"},
+		},
+		{
+			name: "false value skipped and legacy label deduplicated",
+			labels: map[string]string{
+				"node-role.kubernetes.io/worker": "true",
+				"node-role.kubernetes.io/etcd":   "false",
+				"kubernetes.io/role":             "worker",
+			},
+			want: []string{"worker"},
+		},
+		{
+			name:   "no roles",
+			labels: map[string]string{"kubernetes.io/hostname": "n1"},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractNodeRoles(tt.labels)
+			if got == nil || strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("extractNodeRoles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractNodeConditions(t *testing.T) {
+	obj := map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "MemoryPressure", "status": "False", "reason": "KubeletHasSufficientMemory"},
+				map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+				"invalid",
+			},
+		},
+	}
+
+	got := extractNodeConditions(obj)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 conditions, got %d: %+v", len(got), got)
+	}
+	if got[0].Type != "MemoryPressure" || got[0].Status != "False" || got[0].Reason != "KubeletHasSufficientMemory" {
+		t.Errorf("unexpected first condition: %+v", got[0])
+	}
+	if got[1].Type != "Ready" || got[1].LastTransitionTime != "2024-01-01T00:00:00Z" {
+		t.Errorf("unexpected second condition: %+v", got[1])
+	}
+
+	if empty := extractNodeConditions(map[string]interface{}{}); empty == nil || len(empty) != 0 {
+		t.Errorf("expected empty non-nil conditions, got %#v", empty)
+	}
+}
+
+func TestParseCPUQuantity(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"", 0},
+		{"250m", 250},
+		{"2", 2000},
+		{"0.5", 500},
+		{"invalid", 0},
+	}
+	for _, tt := range tests {
+		if got := parseCPUQuantity(tt.input); got != tt.want {
+			t.Errorf("parseCPUQuantity(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeNodePods(t *testing.T) {
+	pod := func(phase, cpu, memory string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"phase": phase},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"resources": map[string]interface{}{
+							"requests": map[string]interface{}{"cpu": cpu, "memory": memory},
+						},
+					},
+				},
+			},
+		}}
+	}
+	pods := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		pod("Running", "500m", "1Gi"),
+		pod("Running", "1", "1Gi"),
+		pod("Pending", "500m", "2Gi"),
+		pod("Succeeded", "4", "8Gi"),
+		pod("", "0", "0"),
+	}}
+
+	phases, requested, percent := summarizeNodePods(pods, map[string]string{"cpu": "4", "memory": "16Gi"})
+
+	wantPhases := map[string]int{"Running": 2, "Pending": 1, "Succeeded": 1, "Unknown": 1}
+	if len(phases) != len(wantPhases) {
+		t.Errorf("phase summary = %v, want %v", phases, wantPhases)
+	}
+	for phase, n := range wantPhases {
+		if phases[phase] != n {
+			t.Errorf("phase %s = %d, want %d", phase, phases[phase], n)
+		}
+	}
+	if requested["cpu"] != "2000m (2c)" {
+		t.Errorf("requested cpu = %q, want 2000m (2c)", requested["cpu"])
+	}
+	if !strings.HasPrefix(requested["memory"], "4Gi") {
+		t.Errorf("requested memory = %q, want 4Gi", requested["memory"])
+	}
+	if percent["cpu"] != 50 || percent["memory"] != 25 {
+		t.Errorf("requested percent = %v, want cpu=50 memory=25", percent)
+	}
+
+	if _, _, percent := summarizeNodePods(pods, map[string]string{}); len(percent) != 0 {
+		t.Errorf("expected no percentages without allocatable, got %v", percent)
+	}
+}
+
+func TestBuildDepRequest_ParsesKindFilter(t *testing.T) {
+	request, err := buildDepRequest(map[string]interface{}{
+		"cluster":      "c1",
+		"kind":         "deployment",
+		"namespace":    "default",
+		"name":         "demo",
+		"includeKinds": "Service, Endpoints,",
+		"excludeKinds": "event",
+	})
+	if err != nil {
+		t.Fatalf("buildDepRequest() returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(request.Kinds.Include, []string{"Service", "Endpoints"}) {
+		t.Errorf("Include = %v, want [Service Endpoints]", request.Kinds.Include)
+	}
+	if !reflect.DeepEqual(request.Kinds.Exclude, []string{"event"}) {
+		t.Errorf("Exclude = %v, want [event]", request.Kinds.Exclude)
+	}
+}
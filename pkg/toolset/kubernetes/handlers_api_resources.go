@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// coreGroupName selects the core API group, whose actual name is empty.
+const coreGroupName = "core"
+
+var apiResourceHeaders = []string{"name", "shortNames", "kind", "group", "version", "namespaced", "singularName", "fullName"}
+
+// apiResourcesHandler handles the kubernetes_api_resources tool.
+// It lists the resource kinds the cluster serves, like kubectl api-resources.
+func apiResourcesHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+	group := paramutil.ExtractOptionalString(params, "group")
+	var namespaced *bool
+	if v, ok := params["namespaced"].(bool); ok {
+		namespaced = &v
+	}
+
+	resources, err := steveClient.ListAPIResources(ctx, cluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to list API resources: %w", err)
+	}
+
+	rows := apiResourceRows(filterAPIResources(resources, group, namespaced))
+	return paramutil.FormatOutput(rows, format, apiResourceHeaders, nil)
+}
+
+// filterAPIResources keeps the resources of group, where "core" selects the
+// core group and empty selects every group, and, when namespaced is set, of
+// that scope.
+func filterAPIResources(resources []steve.APIResourceInfo, group string, namespaced *bool) []steve.APIResourceInfo {
+	var filtered []steve.APIResourceInfo
+	for _, r := range resources {
+		if group != "" && !strings.EqualFold(r.Group, group) && !(group == coreGroupName && r.Group == "") {
+			continue
+		}
+		if namespaced != nil && r.Namespaced != *namespaced {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+// apiResourceRows renders resources sorted by group and name. fullName is the
+// resource.group form the other kubernetes tools accept as kind.
+func apiResourceRows(resources []steve.APIResourceInfo) []map[string]string {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Group != resources[j].Group {
+			return resources[i].Group < resources[j].Group
+		}
+		return resources[i].Name < resources[j].Name
+	})
+
+	rows := make([]map[string]string, 0, len(resources))
+	for _, r := range resources {
+		fullName := r.Name
+		if r.Group != "" {
+			fullName += "." + r.Group
+		}
+		rows = append(rows, map[string]string{
+			"name":         r.Name,
+			"shortNames":   strings.Join(r.ShortNames, ","),
+			"kind":         r.Kind,
+			"group":        r.Group,
+			"version":      r.Version,
+			"namespaced":   strconv.FormatBool(r.Namespaced),
+			"singularName": r.SingularName,
+			"fullName":     fullName,
+		})
+	}
+	return rows
+}
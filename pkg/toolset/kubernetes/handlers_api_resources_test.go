@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+)
+
+func TestFilterAPIResources(t *testing.T) {
+	resources := []steve.APIResourceInfo{
+		{Name: "pods", Namespaced: true},
+		{Name: "nodes"},
+		{Name: "deployments", Group: "apps", Namespaced: true},
+		{Name: "certificates", Group: "cert-manager.io", Namespaced: true},
+		{Name: "clusterissuers", Group: "cert-manager.io"},
+	}
+	yes, no := true, false
+
+	tests := []struct {
+		name       string
+		group      string
+		namespaced *bool
+		want       []string
+	}{
+		{name: "no filter", want: []string{"pods", "nodes", "deployments", "certificates", "clusterissuers"}},
+		{name: "core group", group: "core", want: []string{"pods", "nodes"}},
+		{name: "named group", group: "cert-manager.io", want: []string{"certificates", "clusterissuers"}},
+		{name: "namespaced only", namespaced: &yes, want: []string{"pods", "deployments", "certificates"}},
+		{name: "cluster-scoped in group", group: "cert-manager.io", namespaced: &no, want: []string{"clusterissuers"}},
+		{name: "unknown group", group: "example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterAPIResources(resources, tt.group, tt.namespaced)
+			if len(got) != len(tt.want) {
+				t.Fatalf("filterAPIResources() = %+v, want %v", got, tt.want)
+			}
+			for i, r := range got {
+				if r.Name != tt.want[i] {
+					t.Errorf("resource %d = %s, want %s", i, r.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAPIResourceRows(t *testing.T) {
+	rows := apiResourceRows([]steve.APIResourceInfo{
+		{Name: "deployments", SingularName: "deployment", Kind: "Deployment", Group: "apps", Version: "v1", Namespaced: true, ShortNames: []string{"deploy"}},
+		{Name: "services", SingularName: "service", Kind: "Service", Version: "v1", Namespaced: true, ShortNames: []string{"svc"}},
+		{Name: "apps", SingularName: "app", Kind: "App", Group: "catalog.cattle.io", Version: "v1", Namespaced: true},
+	})
+
+	wantOrder := []string{"services", "deployments", "apps"}
+	for i, row := range rows {
+		if row["name"] != wantOrder[i] {
+			t.Fatalf("row %d = %s, want %s (sorted by group, then name)", i, row["name"], wantOrder[i])
+		}
+	}
+	if rows[0]["fullName"] != "services" || rows[0]["group"] != "" || rows[0]["shortNames"] != "svc" {
+		t.Errorf("core row = %v", rows[0])
+	}
+	if rows[1]["fullName"] != "deployments.apps" || rows[1]["namespaced"] != "true" || rows[1]["kind"] != "Deployment" {
+		t.Errorf("apps row = %v", rows[1])
+	}
+	if rows[2]["fullName"] != "apps.catalog.cattle.io" || rows[2]["shortNames"] != "" {
+		t.Errorf("CRD row = %v", rows[2])
+	}
+}
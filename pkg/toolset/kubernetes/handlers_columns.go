@@ -0,0 +1,153 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// noneValue is printed for fields that are absent from a resource.
+const noneValue = "<none>"
+
+// columnSegment is one step of a column path: a map key, optionally followed
+// by an array index (e.g. "containerStatuses[0]").
+type columnSegment struct {
+	key   string
+	index int
+}
+
+// columnPath is a parsed column expression such as ".status.phase".
+type columnPath struct {
+	expr     string
+	segments []columnSegment
+}
+
+// parseColumns parses a comma-separated list of column expressions.
+func parseColumns(columns string) ([]columnPath, error) {
+	if strings.TrimSpace(columns) == "" {
+		return nil, nil
+	}
+
+	var paths []columnPath
+	for _, expr := range strings.Split(columns, ",") {
+		expr = strings.TrimSpace(expr)
+		if expr == "" {
+			continue
+		}
+		path, err := parseColumnPath(expr)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// parseColumnPath parses a single expression like ".status.containerStatuses[0].restartCount".
+func parseColumnPath(expr string) (columnPath, error) {
+	trimmed := strings.TrimPrefix(expr, ".")
+	if trimmed == "" {
+		return columnPath{}, fmt.Errorf("invalid column expression %q", expr)
+	}
+
+	var segments []columnSegment
+	for _, part := range strings.Split(trimmed, ".") {
+		segment := columnSegment{key: part, index: -1}
+		if open := strings.Index(part, "["); open >= 0 {
+			if !strings.HasSuffix(part, "]") {
+				return columnPath{}, fmt.Errorf("invalid column expression %q: unterminated index", expr)
+			}
+			index, err := strconv.Atoi(part[open+1 : len(part)-1])
+			if err != nil || index < 0 {
+				return columnPath{}, fmt.Errorf("invalid column expression %q: bad index in %q", expr, part)
+			}
+			segment = columnSegment{key: part[:open], index: index}
+		}
+		if segment.key == "" {
+			return columnPath{}, fmt.Errorf("invalid column expression %q: empty field name", expr)
+		}
+		segments = append(segments, segment)
+	}
+	return columnPath{expr: expr, segments: segments}, nil
+}
+
+// lookup resolves the column path against a resource and renders it as a string.
+// Missing fields and out-of-range indexes render as <none>.
+func (p columnPath) lookup(obj map[string]interface{}) string {
+	var current interface{} = obj
+	for _, segment := range p.segments {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return noneValue
+		}
+		value, found, err := unstructured.NestedFieldCopy(m, segment.key)
+		if err != nil || !found {
+			return noneValue
+		}
+		if segment.index >= 0 {
+			items, ok := value.([]interface{})
+			if !ok || segment.index >= len(items) {
+				return noneValue
+			}
+			value = items[segment.index]
+		}
+		current = value
+	}
+	return columnValueString(current)
+}
+
+// columnValueString renders a field value for table output.
+func columnValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return noneValue
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return noneValue
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatAsCustomColumnsTable renders NAME and NAMESPACE followed by the requested columns.
+func formatAsCustomColumnsTable(list *unstructured.UnstructuredList, columns []columnPath) string {
+	if len(list.Items) == 0 {
+		return "No resources found"
+	}
+	headers, rows := customColumnsRows(list, columns)
+	return paramutil.FormatAsTable(rows, headers)
+}
+
+// customColumnsRows returns the NAME, NAMESPACE and requested column headers and rows of list.
+func customColumnsRows(list *unstructured.UnstructuredList, columns []columnPath) ([]string, []map[string]string) {
+	headers := []string{"NAME", "NAMESPACE"}
+	for _, column := range columns {
+		headers = append(headers, column.expr)
+	}
+
+	rows := make([]map[string]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespace := item.GetNamespace()
+		if namespace == "" {
+			namespace = "-"
+		}
+		row := map[string]string{
+			"NAME":      item.GetName(),
+			"NAMESPACE": namespace,
+		}
+		for _, column := range columns {
+			row[column.expr] = column.lookup(item.Object)
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", columns: "", want: nil},
+		{name: "single", columns: ".status.phase", want: []string{".status.phase"}},
+		{name: "multiple with spaces", columns: ".status.phase, .spec.nodeName", want: []string{".status.phase", ".spec.nodeName"}},
+		{name: "array index", columns: ".status.containerStatuses[0].restartCount", want: []string{".status.containerStatuses[0].restartCount"}},
+		{name: "bad index", columns: ".status.containerStatuses[x]", wantErr: true},
+		{name: "unterminated index", columns: ".status.containerStatuses[0", wantErr: true},
+		{name: "empty segment", columns: ".status..phase", wantErr: true},
+		{name: "dot only", columns: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseColumns(tt.columns)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseColumns(%q) expected error", tt.columns)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseColumns(%q) unexpected error: %v", tt.columns, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseColumns(%q) returned %d columns, want %d", tt.columns, len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].expr != tt.want[i] {
+					t.Errorf("column[%d] = %q, want %q", i, got[i].expr, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestColumnPathLookup(t *testing.T) {
+	obj := map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "app", "restartCount": int64(3)},
+			},
+			"conditions": []interface{}{"Ready"},
+		},
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app": "web"},
+		},
+	}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: ".status.phase", want: "Running"},
+		{expr: ".status.containerStatuses[0].restartCount", want: "3"},
+		{expr: ".status.containerStatuses[1].restartCount", want: "<none>"},
+		{expr: ".status.phase[0]", want: "<none>"},
+		{expr: ".status.conditions[0].type", want: "<none>"},
+		{expr: ".spec.nodeName", want: "<none>"},
+		{expr: ".metadata.labels", want: `{"app":"web"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := parseColumnPath(tt.expr)
+			if err != nil {
+				t.Fatalf("parseColumnPath(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got := path.lookup(obj); got != tt.want {
+				t.Errorf("lookup(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatResourceListWithColumns(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{Object: map[string]interface{}{
+				"kind":     "Pod",
+				"metadata": map[string]interface{}{"name": "web-1", "namespace": "default"},
+				"status":   map[string]interface{}{"phase": "Running"},
+			}},
+			{Object: map[string]interface{}{
+				"kind":     "Pod",
+				"metadata": map[string]interface{}{"name": "web-2", "namespace": "default"},
+			}},
+		},
+	}
+
+	columns, err := parseColumns(".status.phase")
+	if err != nil {
+		t.Fatalf("parseColumns() unexpected error: %v", err)
+	}
+
+	out, err := formatResourceList(list, "table", nil, columns)
+	if err != nil {
+		t.Fatalf("formatResourceList() unexpected error: %v", err)
+	}
+	for _, want := range []string{"NAME", "NAMESPACE", ".status.phase", "web-1", "Running", "web-2", "<none>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "KIND") {
+		t.Errorf("custom columns output should not include default KIND column, got:\n%s", out)
+	}
+}
@@ -0,0 +1,204 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Condition status values.
+const (
+	conditionTrue  = "True"
+	conditionFalse = "False"
+)
+
+// negativeConditionTypes are condition types that report a problem when True,
+// so their healthy status is False.
+var negativeConditionTypes = map[string]bool{
+	// Node
+	"MemoryPressure":     true,
+	"DiskPressure":       true,
+	"PIDPressure":        true,
+	"NetworkUnavailable": true,
+	// node-problem-detector
+	"KernelDeadlock":     true,
+	"ReadonlyFilesystem": true,
+	// Workloads and jobs
+	"ReplicaFailure": true,
+	"Failed":         true,
+	// kstatus and common operator conventions
+	"Stalled":  true,
+	"Degraded": true,
+}
+
+// ResourceCondition is one entry of an object's status.conditions.
+type ResourceCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	// Expected is the status of a healthy object: False for types such as
+	// DiskPressure or Failed, True otherwise.
+	Expected           string `json:"expected"`
+	Healthy            bool   `json:"healthy"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+// ConditionsResult lists the status conditions of an object.
+type ConditionsResult struct {
+	Kind       string              `json:"kind"`
+	Name       string              `json:"name"`
+	Namespace  string              `json:"namespace,omitempty"`
+	Unhealthy  int                 `json:"unhealthy"`
+	Conditions []ResourceCondition `json:"conditions"`
+}
+
+// conditionsHandler handles the kubernetes_conditions tool
+func conditionsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+	if err := paramutil.ValidateFormat(format); err != nil {
+		return "", err
+	}
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	kind, err := extractResourceKind(params)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+
+	obj, err := steveClient.GetResource(ctx, cluster, kind, namespace, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get resource: %w", err)
+	}
+	return formatConditions(summarizeConditions(obj), format)
+}
+
+// summarizeConditions extracts the status conditions of obj and flags those
+// not at their expected status.
+func summarizeConditions(obj *unstructured.Unstructured) *ConditionsResult {
+	result := &ConditionsResult{
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Conditions: extractConditions(obj),
+	}
+	for _, c := range result.Conditions {
+		if !c.Healthy {
+			result.Unhealthy++
+		}
+	}
+	return result
+}
+
+// extractConditions returns the entries of obj's status.conditions in order.
+// Entries without a type are skipped. Conditions that carry lastUpdateTime
+// instead of lastTransitionTime, as Rancher's do, report that time.
+func extractConditions(obj *unstructured.Unstructured) []ResourceCondition {
+	raw, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := make([]ResourceCondition, 0, len(raw))
+	for _, entry := range raw {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condition := ResourceCondition{
+			Type:               conditionField(m, "type"),
+			Status:             conditionField(m, "status"),
+			Reason:             conditionField(m, "reason"),
+			Message:            conditionField(m, "message"),
+			LastTransitionTime: conditionField(m, "lastTransitionTime"),
+		}
+		if condition.Type == "" {
+			continue
+		}
+		if condition.LastTransitionTime == "" {
+			condition.LastTransitionTime = conditionField(m, "lastUpdateTime")
+		}
+		condition.Expected = expectedConditionStatus(condition.Type)
+		condition.Healthy = condition.Status == condition.Expected
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// expectedConditionStatus returns the status a healthy object reports for a
+// condition type.
+func expectedConditionStatus(conditionType string) string {
+	if negativeConditionTypes[conditionType] {
+		return conditionFalse
+	}
+	return conditionTrue
+}
+
+func conditionField(m map[string]interface{}, key string) string {
+	if v, ok := m[key]; ok && v != nil {
+		return paramutil.GetStringValue(v)
+	}
+	return ""
+}
+
+// formatConditions renders the conditions as a table, markdown, CSV, JSON or YAML.
+func formatConditions(result *ConditionsResult, format string) (string, error) {
+	headers := []string{"type", "status", "healthy", "reason", "message", "lastTransitionTime"}
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(result)
+	case paramutil.FormatTable, paramutil.FormatMarkdown:
+		ref := result.Name
+		if result.Namespace != "" {
+			ref = result.Namespace + "/" + result.Name
+		}
+		if len(result.Conditions) == 0 {
+			return fmt.Sprintf("%s %s has no status conditions\n", result.Kind, ref), nil
+		}
+		table, err := paramutil.FormatRows(conditionRows(result.Conditions, true), headers, format)
+		if err != nil {
+			return "", err
+		}
+		if result.Unhealthy == 0 {
+			return table + fmt.Sprintf("\nAll %d conditions of %s %s are healthy\n", len(result.Conditions), result.Kind, ref), nil
+		}
+		return table + fmt.Sprintf("\n%d of %d conditions of %s %s are not at their expected status\n", result.Unhealthy, len(result.Conditions), result.Kind, ref), nil
+	case paramutil.FormatCSV:
+		return paramutil.FormatAsCSV(conditionRows(result.Conditions, false), headers)
+	default: // json
+		return paramutil.FormatAsJSON(result)
+	}
+}
+
+// conditionRows flattens the conditions into rows. display renders the
+// transition times in the configured time display mode for tables; CSV keeps RFC 3339.
+func conditionRows(conditions []ResourceCondition, display bool) []map[string]string {
+	rows := make([]map[string]string, 0, len(conditions))
+	for _, c := range conditions {
+		lastTransition := c.LastTransitionTime
+		if display && c.LastTransitionTime != "" {
+			lastTransition = paramutil.FormatTime(c.LastTransitionTime)
+		}
+		rows = append(rows, map[string]string{
+			"type":               c.Type,
+			"status":             c.Status,
+			"healthy":            strconv.FormatBool(c.Healthy),
+			"reason":             c.Reason,
+			"message":            c.Message,
+			"lastTransitionTime": lastTransition,
+		})
+	}
+	return rows
+}
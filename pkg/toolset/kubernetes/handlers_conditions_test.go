@@ -0,0 +1,137 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeConditionsObject(kind, namespace, name string, conditions ...map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+	}}
+	if len(conditions) > 0 {
+		raw := make([]interface{}, 0, len(conditions))
+		for _, c := range conditions {
+			raw = append(raw, c)
+		}
+		obj.Object["status"] = map[string]interface{}{"conditions": raw}
+	}
+	return obj
+}
+
+func TestSummarizeConditions(t *testing.T) {
+	node := makeConditionsObject("Node", "", "node-1",
+		map[string]interface{}{"type": "Ready", "status": "True", "reason": "KubeletReady", "lastTransitionTime": "2024-01-15T10:30:00Z"},
+		map[string]interface{}{"type": "DiskPressure", "status": "True", "reason": "KubeletHasDiskPressure"},
+		map[string]interface{}{"type": "MemoryPressure", "status": "False"},
+		map[string]interface{}{"status": "True"},
+		map[string]interface{}{"type": "Provisioned", "status": "Unknown", "lastUpdateTime": "2024-01-16T08:00:00Z"},
+	)
+
+	result := summarizeConditions(node)
+	if len(result.Conditions) != 4 {
+		t.Fatalf("expected 4 typed conditions, got %d: %+v", len(result.Conditions), result.Conditions)
+	}
+	if result.Unhealthy != 2 {
+		t.Errorf("expected 2 unhealthy conditions, got %d", result.Unhealthy)
+	}
+
+	tests := []struct {
+		index    int
+		typ      string
+		expected string
+		healthy  bool
+	}{
+		{index: 0, typ: "Ready", expected: "True", healthy: true},
+		{index: 1, typ: "DiskPressure", expected: "False", healthy: false},
+		{index: 2, typ: "MemoryPressure", expected: "False", healthy: true},
+		{index: 3, typ: "Provisioned", expected: "True", healthy: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			c := result.Conditions[tt.index]
+			if c.Type != tt.typ || c.Expected != tt.expected || c.Healthy != tt.healthy {
+				t.Errorf("condition %d = %+v, want type %s expected %s healthy %v", tt.index, c, tt.typ, tt.expected, tt.healthy)
+			}
+		})
+	}
+	if got := result.Conditions[3].LastTransitionTime; got != "2024-01-16T08:00:00Z" {
+		t.Errorf("expected lastUpdateTime fallback, got %q", got)
+	}
+}
+
+func TestFormatConditions(t *testing.T) {
+	deployment := makeConditionsObject("Deployment", "default", "web",
+		map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable", "message": "Deployment does not have minimum availability."},
+		map[string]interface{}{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"},
+	)
+	result := summarizeConditions(deployment)
+
+	t.Run("table", func(t *testing.T) {
+		out, err := formatConditions(result, paramutil.FormatTable)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, want := range []string{"MinimumReplicasUnavailable", "Progressing", "1 of 2 conditions of Deployment default/web are not at their expected status"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("expected %q in output:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		out, err := formatConditions(result, paramutil.FormatCSV)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		if lines[0] != "type,status,healthy,reason,message,lastTransitionTime" || len(lines) != 3 {
+			t.Errorf("unexpected csv output:\n%s", out)
+		}
+		if !strings.HasPrefix(lines[1], "Available,False,false,") {
+			t.Errorf("expected Available to be flagged, got %q", lines[1])
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := formatConditions(result, paramutil.FormatJSON)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(out, `"unhealthy": 1`) || !strings.Contains(out, `"expected": "True"`) {
+			t.Errorf("unexpected json output:\n%s", out)
+		}
+	})
+
+	t.Run("no conditions", func(t *testing.T) {
+		cm := summarizeConditions(makeConditionsObject("ConfigMap", "default", "settings"))
+		out, err := formatConditions(cm, paramutil.FormatTable)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out != "ConfigMap default/settings has no status conditions\n" {
+			t.Errorf("unexpected output %q", out)
+		}
+	})
+
+	t.Run("all healthy", func(t *testing.T) {
+		node := summarizeConditions(makeConditionsObject("Node", "", "node-1",
+			map[string]interface{}{"type": "Ready", "status": "True"},
+		))
+		out, err := formatConditions(node, paramutil.FormatTable)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(out, "All 1 conditions of Node node-1 are healthy") {
+			t.Errorf("unexpected output:\n%s", out)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"gopkg.in/yaml.v3"
@@ -41,8 +42,13 @@ func describeHandler(ctx context.Context, client interface{}, params map[string]
 		return "", fmt.Errorf("failed to describe resource: %w", err)
 	}
 
-	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true
-	if sensitiveFilter := paramutil.NewSensitiveDataFilterFromParams(params); sensitiveFilter != nil {
+	// Mask sensitive data (e.g., Secret data) unless showSensitiveData is true,
+	// and any fields named by maskPaths
+	sensitiveFilter, err := paramutil.NewSensitiveDataFilterFromParams(params)
+	if err != nil {
+		return "", err
+	}
+	if sensitiveFilter != nil {
 		result.Resource = sensitiveFilter.Filter(result.Resource)
 	}
 
@@ -72,15 +78,24 @@ func eventsHandler(ctx context.Context, client interface{}, params map[string]in
 	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
 	nameFilter := paramutil.ExtractOptionalString(params, paramutil.ParamName)
 	kindFilter := paramutil.ExtractOptionalString(params, paramutil.ParamKind)
+	opts := &steve.ListOptions{
+		LabelSelector: paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector),
+		FieldSelector: paramutil.ExtractOptionalString(params, paramutil.ParamFieldSelector),
+	}
 	limit := paramutil.ExtractInt64(params, paramutil.ParamLimit, 50)
 	page := paramutil.ExtractInt64(params, paramutil.ParamPage, 1)
 	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatTable)
+	sinceTime, untilTime, err := extractEventTimeWindow(params, time.Now())
+	if err != nil {
+		return "", err
+	}
 
-	events, err := steveClient.GetEvents(ctx, cluster, namespace, nameFilter, kindFilter)
+	events, err := steveClient.GetEvents(ctx, cluster, namespace, nameFilter, kindFilter, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to get events: %w", err)
 	}
 
+	events = filterEventsByTimeWindow(events, sinceTime, untilTime)
 	sortEventsByTime(events)
 
 	events, _ = paramutil.ApplyPagination(events, limit, page)
@@ -116,6 +131,59 @@ func eventTime(e corev1.Event) time.Time {
 	return e.EventTime.Time
 }
 
+// extractEventTimeWindow parses the since/until duration parameters into
+// absolute bounds relative to now. Either bound may be nil.
+func extractEventTimeWindow(params map[string]interface{}, now time.Time) (*time.Time, *time.Time, error) {
+	var sinceTime, untilTime *time.Time
+
+	if since := paramutil.ExtractOptionalString(params, paramutil.ParamSince); since != "" {
+		duration, err := parseDuration(since)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid since duration: %w", err)
+		}
+		t := now.Add(-duration)
+		sinceTime = &t
+	}
+	if until := paramutil.ExtractOptionalString(params, paramutil.ParamUntil); until != "" {
+		duration, err := parseDuration(until)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid until duration: %w", err)
+		}
+		t := now.Add(-duration)
+		untilTime = &t
+	}
+
+	if sinceTime != nil && untilTime != nil && untilTime.Before(*sinceTime) {
+		return nil, nil, fmt.Errorf("invalid time window: since must be a longer duration than until")
+	}
+	return sinceTime, untilTime, nil
+}
+
+// filterEventsByTimeWindow keeps events whose eventTime falls within the
+// inclusive [since, until] window. Events without a timestamp are dropped
+// when any bound is set.
+func filterEventsByTimeWindow(events []corev1.Event, sinceTime, untilTime *time.Time) []corev1.Event {
+	if sinceTime == nil && untilTime == nil {
+		return events
+	}
+
+	filtered := make([]corev1.Event, 0, len(events))
+	for _, e := range events {
+		t := eventTime(e)
+		if t.IsZero() {
+			continue
+		}
+		if sinceTime != nil && t.Before(*sinceTime) {
+			continue
+		}
+		if untilTime != nil && t.After(*untilTime) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered
+}
+
 // sortEventsByTime sorts events by timestamp, most recent first.
 func sortEventsByTime(events []corev1.Event) {
 	sort.Slice(events, func(i, j int) bool {
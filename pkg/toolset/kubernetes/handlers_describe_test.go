@@ -116,3 +116,96 @@ func TestSortEventsByTime(t *testing.T) {
 		t.Errorf("expected oldest last, got %v", eventTime(events[2]))
 	}
 }
+
+func TestExtractEventTimeWindow(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no bounds", func(t *testing.T) {
+		since, until, err := extractEventTimeWindow(map[string]interface{}{}, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if since != nil || until != nil {
+			t.Errorf("expected nil bounds, got since=%v until=%v", since, until)
+		}
+	})
+
+	t.Run("since and until", func(t *testing.T) {
+		since, until, err := extractEventTimeWindow(map[string]interface{}{"since": "1h", "until": "10m"}, now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !since.Equal(now.Add(-time.Hour)) {
+			t.Errorf("since = %v, want %v", since, now.Add(-time.Hour))
+		}
+		if !until.Equal(now.Add(-10 * time.Minute)) {
+			t.Errorf("until = %v, want %v", until, now.Add(-10*time.Minute))
+		}
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		_, _, err := extractEventTimeWindow(map[string]interface{}{"since": "abc"}, now)
+		if err == nil || !strings.Contains(err.Error(), "invalid since duration") {
+			t.Errorf("expected invalid since error, got %v", err)
+		}
+	})
+
+	t.Run("invalid until", func(t *testing.T) {
+		_, _, err := extractEventTimeWindow(map[string]interface{}{"until": "5x"}, now)
+		if err == nil || !strings.Contains(err.Error(), "invalid until duration") {
+			t.Errorf("expected invalid until error, got %v", err)
+		}
+	})
+
+	t.Run("inverted window", func(t *testing.T) {
+		_, _, err := extractEventTimeWindow(map[string]interface{}{"since": "10m", "until": "1h"}, now)
+		if err == nil {
+			t.Error("expected error for inverted window")
+		}
+	})
+}
+
+func TestFilterEventsByTimeWindow(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	mk := func(name string, ago time.Duration) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: name},
+			LastTimestamp: metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	events := []corev1.Event{
+		mk("old", 2*time.Hour),
+		mk("edge-since", time.Hour),
+		mk("middle", 30*time.Minute),
+		mk("edge-until", 10*time.Minute),
+		mk("recent", time.Minute),
+		{ObjectMeta: metav1.ObjectMeta{Name: "no-time"}},
+	}
+
+	names := func(events []corev1.Event) []string {
+		var out []string
+		for _, e := range events {
+			out = append(out, e.Name)
+		}
+		return out
+	}
+
+	since := now.Add(-time.Hour)
+	until := now.Add(-10 * time.Minute)
+
+	got := names(filterEventsByTimeWindow(events, &since, &until))
+	want := "edge-since,middle,edge-until"
+	if strings.Join(got, ",") != want {
+		t.Errorf("window filter = %v, want %s", got, want)
+	}
+
+	got = names(filterEventsByTimeWindow(events, &since, nil))
+	want = "edge-since,middle,edge-until,recent"
+	if strings.Join(got, ",") != want {
+		t.Errorf("since-only filter = %v, want %s", got, want)
+	}
+
+	if got := filterEventsByTimeWindow(events, nil, nil); len(got) != len(events) {
+		t.Errorf("expected no filtering without bounds, got %d events", len(got))
+	}
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"github.com/futuretea/rancher-mcp-server/pkg/watchdiff"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // diffHandler handles the kubernetes_diff tool.
-// It compares two Kubernetes resource versions and shows the differences as a git-style diff.
+// It compares two Kubernetes resource versions and shows the differences as a
+// git-style diff, or as a structured list of changes with format=json.
 func diffHandler(_ context.Context, _ interface{}, params map[string]interface{}) (string, error) {
 	// Extract required parameters
 	resource1JSON, err := paramutil.ExtractRequiredString(params, "resource1")
@@ -24,8 +29,18 @@ func diffHandler(_ context.Context, _ interface{}, params map[string]interface{}
 		return "", err
 	}
 
-	ignoreStatus := paramutil.ExtractBool(params, "ignoreStatus", false)
-	ignoreMeta := paramutil.ExtractBool(params, "ignoreMeta", false)
+	format, err := extractDiffFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	opts := diffOptions{
+		ignoreStatus: paramutil.ExtractBool(params, "ignoreStatus", false),
+		ignoreMeta:   paramutil.ExtractBool(params, "ignoreMeta", false),
+		ignorePaths:  parseIncludePaths(paramutil.ExtractOptionalString(params, paramutil.ParamIgnorePaths)),
+		onlyPaths:    parseIncludePaths(paramutil.ExtractOptionalString(params, paramutil.ParamOnlyPaths)),
+		normalize:    paramutil.ExtractBool(params, paramutil.ParamNormalize, false),
+	}
 
 	// Parse resource1
 	var resource1 unstructured.Unstructured
@@ -39,7 +54,7 @@ func diffHandler(_ context.Context, _ interface{}, params map[string]interface{}
 		return "", fmt.Errorf("failed to parse resource2 JSON: %w", err)
 	}
 
-	return diffResources(&resource1, &resource2, ignoreStatus, ignoreMeta)
+	return renderDiff(&resource1, &resource2, opts, format)
 }
 
 func resourceDiffHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
@@ -62,8 +77,15 @@ func resourceDiffHandler(ctx context.Context, client interface{}, params map[str
 		return "", err
 	}
 
-	ignoreStatus := paramutil.ExtractBool(params, "ignoreStatus", false)
-	ignoreMeta := paramutil.ExtractBool(params, "ignoreMeta", true)
+	format, err := extractDiffFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	opts := diffOptions{
+		ignoreStatus: paramutil.ExtractBool(params, "ignoreStatus", false),
+		ignoreMeta:   paramutil.ExtractBool(params, "ignoreMeta", true),
+	}
 
 	leftResource, err := steveClient.GetResource(ctx, left.Cluster, kind, left.Namespace, left.Name)
 	if err != nil {
@@ -74,28 +96,133 @@ func resourceDiffHandler(ctx context.Context, client interface{}, params map[str
 		return "", fmt.Errorf("failed to get right resource: %w", err)
 	}
 
-	return diffResources(leftResource, rightResource, ignoreStatus, ignoreMeta)
+	return renderDiff(leftResource, rightResource, opts, format)
 }
 
-func diffResources(resource1, resource2 *unstructured.Unstructured, ignoreStatus, ignoreMeta bool) (string, error) {
-	// Create a printer for diff output
-	printer := watchdiff.NewPrinter(false)
+// liveDiffHandler handles the kubernetes_diff_live tool.
+// It previews what applying a manifest would change by diffing the live object against it.
+func liveDiffHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	manifestJSON, err := paramutil.ExtractRequiredString(params, "manifest")
+	if err != nil {
+		return "", err
+	}
+
+	format, err := extractDiffFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	opts := diffOptions{
+		ignoreStatus: paramutil.ExtractBool(params, "ignoreStatus", true),
+		ignoreMeta:   paramutil.ExtractBool(params, "ignoreMeta", true),
+	}
+
+	var manifest unstructured.Unstructured
+	if err := json.Unmarshal([]byte(manifestJSON), &manifest.Object); err != nil {
+		return "", fmt.Errorf("failed to parse manifest JSON: %w", err)
+	}
+
+	target := resolveLiveDiffTarget(params, &manifest)
+	if target.Kind == "" || target.Name == "" {
+		return "", fmt.Errorf("kind and name are required, either as parameters or in the manifest")
+	}
+
+	live, err := steveClient.GetResource(ctx, cluster, target.Kind, target.Namespace, target.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get live resource: %w", err)
+		}
+		// A missing object diffs as a new resource with the whole manifest added
+		live = nil
+	}
 
-	// Make copies for potential modifications
-	oldCopy := resource1.DeepCopy()
-	newCopy := resource2.DeepCopy()
+	return renderDiff(live, &manifest, opts, format)
+}
+
+// liveDiffTarget identifies the live object compared by kubernetes_diff_live.
+type liveDiffTarget struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
 
-	// Apply ignore options
-	if ignoreStatus {
-		delete(oldCopy.Object, "status")
-		delete(newCopy.Object, "status")
+// resolveLiveDiffTarget takes kind, apiVersion, namespace and name from params,
+// falling back to the manifest's own values for any that are not given.
+func resolveLiveDiffTarget(params map[string]interface{}, manifest *unstructured.Unstructured) liveDiffTarget {
+	kind := paramutil.ExtractOptionalString(params, paramutil.ParamKind)
+	apiVersion := paramutil.ExtractOptionalString(params, paramutil.ParamAPIVersion)
+	if kind == "" {
+		kind = manifest.GetKind()
+		if apiVersion == "" {
+			apiVersion = manifest.GetAPIVersion()
+		}
+	}
+	if kind != "" {
+		kind = steve.KindWithAPIVersion(apiVersion, kind)
 	}
 
-	if ignoreMeta {
-		trimMetadataForDiff(oldCopy)
-		trimMetadataForDiff(newCopy)
+	namespace := paramutil.ExtractOptionalString(params, paramutil.ParamNamespace)
+	if namespace == "" {
+		namespace = manifest.GetNamespace()
+	}
+	name := paramutil.ExtractOptionalString(params, paramutil.ParamName)
+	if name == "" {
+		name = manifest.GetName()
 	}
 
+	return liveDiffTarget{Kind: kind, Namespace: namespace, Name: name}
+}
+
+// diffOptions controls which parts of two resources diffResources compares.
+type diffOptions struct {
+	ignoreStatus bool
+	ignoreMeta   bool
+	// ignorePaths are removed from both resources.
+	ignorePaths [][]string
+	// onlyPaths, when set, restricts the comparison to these paths (plus the
+	// resource identity fields).
+	onlyPaths [][]string
+	// normalize sorts order-insensitive lists (see normalizedLists) first.
+	normalize bool
+}
+
+// normalizedLists maps list field names to the element keys they are sorted by
+// when diffing with normalize. The first key present in every element is used;
+// lists whose elements lack all keys keep their order.
+var normalizedLists = map[string][]string{
+	"containers":          {"name"},
+	"initContainers":      {"name"},
+	"ephemeralContainers": {"name"},
+	"env":                 {"name"},
+	"ports":               {"containerPort", "port"},
+	"volumes":             {"name"},
+	"volumeMounts":        {"mountPath"},
+}
+
+// diffIdentityPaths are kept by onlyPaths so the diff still names the resource.
+var diffIdentityPaths = [][]string{
+	{"apiVersion"},
+	{"kind"},
+	{"metadata", "name"},
+	{"metadata", "namespace"},
+}
+
+func diffResources(resource1, resource2 *unstructured.Unstructured, opts diffOptions) (string, error) {
+	// Create a printer for diff output
+	printer := watchdiff.NewPrinter(false)
+
+	oldCopy := prepareForDiff(resource1, opts)
+	newCopy := prepareForDiff(resource2, opts)
+
 	// Generate the diff
 	diffText, err := printer.Diff(oldCopy, newCopy)
 	if err != nil {
@@ -109,6 +236,76 @@ func diffResources(resource1, resource2 *unstructured.Unstructured, ignoreStatus
 	return diffText, nil
 }
 
+// prepareForDiff returns a copy of obj with the diff options applied: the
+// onlyPaths projection first, then status and metadata trimming, then
+// ignorePaths removal. A nil obj stands for a resource that does not exist.
+func prepareForDiff(obj *unstructured.Unstructured, opts diffOptions) *unstructured.Unstructured {
+	if obj == nil {
+		return nil
+	}
+	if len(opts.onlyPaths) > 0 {
+		obj = projectResource(obj, append(slices.Clone(diffIdentityPaths), opts.onlyPaths...))
+	} else {
+		obj = obj.DeepCopy()
+	}
+	if opts.ignoreStatus {
+		delete(obj.Object, "status")
+	}
+	if opts.ignoreMeta {
+		trimMetadataForDiff(obj)
+	}
+	removeResourcePaths(obj, opts.ignorePaths)
+	if opts.normalize {
+		normalizeLists(obj.Object)
+	}
+	return obj
+}
+
+// normalizeLists recursively sorts the lists named in normalizedLists so that
+// reordered but equivalent elements compare equal.
+func normalizeLists(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, child := range v {
+			if list, ok := child.([]interface{}); ok {
+				if keys, ok := normalizedLists[field]; ok {
+					sortListByKey(list, keys)
+				}
+			}
+			normalizeLists(child)
+		}
+	case []interface{}:
+		for _, item := range v {
+			normalizeLists(item)
+		}
+	}
+}
+
+// sortListByKey sorts list elements by the first of keys that every element has.
+func sortListByKey(list []interface{}, keys []string) {
+	for _, key := range keys {
+		if !allHaveKey(list, key) {
+			continue
+		}
+		sort.SliceStable(list, func(i, j int) bool {
+			a := fmt.Sprint(list[i].(map[string]interface{})[key])
+			b := fmt.Sprint(list[j].(map[string]interface{})[key])
+			return compareFieldValues(a, b) < 0
+		})
+		return
+	}
+}
+
+func allHaveKey(list []interface{}, key string) bool {
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok || m[key] == nil {
+			return false
+		}
+	}
+	return true
+}
+
 type diffTarget struct {
 	Cluster   string
 	Namespace string
@@ -0,0 +1,136 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Operations reported in a structured diff.
+const (
+	diffOpAdd     = "add"
+	diffOpRemove  = "remove"
+	diffOpReplace = "replace"
+)
+
+// diffChange is one entry of a structured diff.
+type diffChange struct {
+	// Path is a dotted field path with [i] list indexes; empty for the whole resource.
+	Path      string      `json:"path"`
+	Operation string      `json:"operation"`
+	OldValue  interface{} `json:"oldValue,omitempty"`
+	NewValue  interface{} `json:"newValue,omitempty"`
+}
+
+// structuredDiff is the JSON output of the diff tools.
+type structuredDiff struct {
+	Changes []diffChange `json:"changes"`
+}
+
+// extractDiffFormat returns the diff output format: text (git-style) or json.
+func extractDiffFormat(params map[string]interface{}) (string, error) {
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, paramutil.FormatText)
+	switch format {
+	case paramutil.FormatText, paramutil.FormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %s (supported: text, json)", paramutil.ErrInvalidFormat, format)
+	}
+}
+
+// renderDiff compares two resources and renders the result as a git-style
+// text diff or, for FormatJSON, as a structured list of changes.
+func renderDiff(resource1, resource2 *unstructured.Unstructured, opts diffOptions, format string) (string, error) {
+	if format != paramutil.FormatJSON {
+		return diffResources(resource1, resource2, opts)
+	}
+	changes := computeDiffChanges(prepareForDiff(resource1, opts), prepareForDiff(resource2, opts))
+	return paramutil.FormatAsJSON(structuredDiff{Changes: changes})
+}
+
+// computeDiffChanges lists the field-level changes from old to new. A nil
+// resource stands for one that does not exist.
+func computeDiffChanges(oldObj, newObj *unstructured.Unstructured) []diffChange {
+	changes := []diffChange{}
+	switch {
+	case oldObj == nil && newObj == nil:
+	case oldObj == nil:
+		changes = append(changes, diffChange{Operation: diffOpAdd, NewValue: newObj.Object})
+	case newObj == nil:
+		changes = append(changes, diffChange{Operation: diffOpRemove, OldValue: oldObj.Object})
+	default:
+		changes = compareDiffValues("", oldObj.Object, newObj.Object, changes)
+	}
+	return changes
+}
+
+// compareDiffValues appends the changes between a and b at path. Maps are
+// compared key by key and lists index by index; anything else that differs
+// is a replace.
+func compareDiffValues(path string, a, b interface{}, changes []diffChange) []diffChange {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		if bv, ok := b.(map[string]interface{}); ok {
+			for _, key := range unionKeys(av, bv) {
+				childPath := joinDiffPath(path, key)
+				oldValue, inOld := av[key]
+				newValue, inNew := bv[key]
+				switch {
+				case !inOld:
+					changes = append(changes, diffChange{Path: childPath, Operation: diffOpAdd, NewValue: newValue})
+				case !inNew:
+					changes = append(changes, diffChange{Path: childPath, Operation: diffOpRemove, OldValue: oldValue})
+				default:
+					changes = compareDiffValues(childPath, oldValue, newValue, changes)
+				}
+			}
+			return changes
+		}
+	case []interface{}:
+		if bv, ok := b.([]interface{}); ok {
+			for i := 0; i < max(len(av), len(bv)); i++ {
+				childPath := fmt.Sprintf("%s[%d]", path, i)
+				switch {
+				case i >= len(av):
+					changes = append(changes, diffChange{Path: childPath, Operation: diffOpAdd, NewValue: bv[i]})
+				case i >= len(bv):
+					changes = append(changes, diffChange{Path: childPath, Operation: diffOpRemove, OldValue: av[i]})
+				default:
+					changes = compareDiffValues(childPath, av[i], bv[i], changes)
+				}
+			}
+			return changes
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, diffChange{Path: path, Operation: diffOpReplace, OldValue: a, NewValue: b})
+	}
+	return changes
+}
+
+// unionKeys returns the keys of both maps in sorted order.
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinDiffPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return strings.Join([]string{path, key}, ".")
+}
@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestComputeDiffChanges(t *testing.T) {
+	oldObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Deployment",
+		"metadata": map[string]interface{}{"name": "web", "labels": map[string]interface{}{"tier": "frontend"}},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"args":     []interface{}{"--a", "--b"},
+		},
+	}}
+	newObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Deployment",
+		"metadata": map[string]interface{}{"name": "web"},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"args":     []interface{}{"--a", "--c", "--d"},
+			"paused":   true,
+		},
+	}}
+
+	got := computeDiffChanges(oldObj, newObj)
+	want := []diffChange{
+		{Path: "metadata.labels", Operation: diffOpRemove, OldValue: map[string]interface{}{"tier": "frontend"}},
+		{Path: "spec.args[1]", Operation: diffOpReplace, OldValue: "--b", NewValue: "--c"},
+		{Path: "spec.args[2]", Operation: diffOpAdd, NewValue: "--d"},
+		{Path: "spec.paused", Operation: diffOpAdd, NewValue: true},
+		{Path: "spec.replicas", Operation: diffOpReplace, OldValue: int64(2), NewValue: int64(3)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeDiffChanges() =\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestComputeDiffChanges_MissingResource(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "ConfigMap"}}
+
+	added := computeDiffChanges(nil, obj)
+	if len(added) != 1 || added[0].Operation != diffOpAdd || added[0].Path != "" {
+		t.Errorf("missing old resource: got %#v, want a single root add", added)
+	}
+	removed := computeDiffChanges(obj, nil)
+	if len(removed) != 1 || removed[0].Operation != diffOpRemove || removed[0].Path != "" {
+		t.Errorf("missing new resource: got %#v, want a single root remove", removed)
+	}
+	if same := computeDiffChanges(obj, obj.DeepCopy()); len(same) != 0 {
+		t.Errorf("identical resources: got %#v, want no changes", same)
+	}
+}
+
+func TestDiffHandler_JSONFormat(t *testing.T) {
+	params := map[string]interface{}{
+		"resource1":  `{"kind":"ConfigMap","metadata":{"name":"cfg","resourceVersion":"1"},"data":{"a":"1"}}`,
+		"resource2":  `{"kind":"ConfigMap","metadata":{"name":"cfg","resourceVersion":"2"},"data":{"a":"2"}}`,
+		"ignoreMeta": true,
+		"format":     "json",
+	}
+
+	out, err := diffHandler(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("diffHandler() error = %v", err)
+	}
+	var result structuredDiff
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	want := []diffChange{{Path: "data.a", Operation: diffOpReplace, OldValue: "1", NewValue: "2"}}
+	if !reflect.DeepEqual(result.Changes, want) {
+		t.Errorf("changes = %#v, want %#v", result.Changes, want)
+	}
+}
+
+func TestDiffHandler_InvalidFormat(t *testing.T) {
+	params := map[string]interface{}{
+		"resource1": `{}`,
+		"resource2": `{}`,
+		"format":    "yaml",
+	}
+	if _, err := diffHandler(context.Background(), nil, params); !errors.Is(err, paramutil.ErrInvalidFormat) {
+		t.Errorf("diffHandler() error = %v, want ErrInvalidFormat", err)
+	}
+}
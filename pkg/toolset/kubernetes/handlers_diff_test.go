@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
@@ -126,7 +127,7 @@ func TestResourceDiffHandler_AcceptsCombinedClientWithSteve(t *testing.T) {
 	}
 
 	combinedClient := &toolset.CombinedClient{
-		Steve: steve.NewClient("https://example.com", "token", "", "", false),
+		Steve: steve.NewClient("https://example.com", "token", "", "", false, 0),
 	}
 
 	_, err := resourceDiffHandler(context.Background(), combinedClient, params)
@@ -149,3 +150,223 @@ func TestExtractDiffTarget_UsesOptionalNamespaceSemantics(t *testing.T) {
 		t.Fatalf("expected empty namespace for cluster-scoped lookups, got %q", target.Namespace)
 	}
 }
+
+func TestResolveLiveDiffTarget(t *testing.T) {
+	manifest := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "prod"},
+	}}
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		want   liveDiffTarget
+	}{
+		{
+			name:   "defaults from manifest",
+			params: map[string]interface{}{},
+			want:   liveDiffTarget{Kind: "apps/v1/Deployment", Namespace: "prod", Name: "web"},
+		},
+		{
+			name:   "params override manifest",
+			params: map[string]interface{}{"kind": "deployment", "namespace": "staging", "name": "web-canary"},
+			want:   liveDiffTarget{Kind: "deployment", Namespace: "staging", Name: "web-canary"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveLiveDiffTarget(tt.params, manifest)
+			if got != tt.want {
+				t.Errorf("resolveLiveDiffTarget() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffResources_MissingLiveShowsNewResource(t *testing.T) {
+	manifest := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "prod"},
+		"spec":       map[string]interface{}{"replicas": int64(3)},
+	}}
+
+	out, err := diffResources(nil, manifest, diffOptions{ignoreStatus: true, ignoreMeta: true})
+	if err != nil {
+		t.Fatalf("diffResources() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "New Resource") || !strings.Contains(out, "replicas") {
+		t.Errorf("expected whole manifest shown as added, got:\n%s", out)
+	}
+}
+
+func TestLiveDiffHandler_CombinedClientNilSteve(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":  "c1",
+		"manifest": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"demo"}}`,
+	}
+
+	_, err := liveDiffHandler(context.Background(), &toolset.CombinedClient{}, params)
+	if err != paramutil.ErrSteveNotConfigured {
+		t.Fatalf("liveDiffHandler() error = %v, want %v", err, paramutil.ErrSteveNotConfigured)
+	}
+}
+
+func TestDiffResources_PathFilters(t *testing.T) {
+	deployment := func(image, restartedAt string, replicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "prod", "resourceVersion": "1"},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{"kubectl.kubernetes.io/restartedAt": restartedAt},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{map[string]interface{}{"name": "app", "image": image}},
+					},
+				},
+			},
+		}}
+	}
+	before := deployment("nginx:1.25", "2026-01-01T00:00:00Z", 2)
+
+	tests := []struct {
+		name     string
+		after    *unstructured.Unstructured
+		opts     diffOptions
+		contains []string
+		absent   []string
+	}{
+		{
+			name:     "ignorePaths hides restart churn",
+			after:    deployment("nginx:1.25", "2026-02-01T00:00:00Z", 2),
+			opts:     diffOptions{ignorePaths: parseIncludePaths("spec.template.metadata.annotations")},
+			contains: []string{"No differences found"},
+		},
+		{
+			name:     "onlyPaths focuses on containers",
+			after:    deployment("nginx:1.26", "2026-02-01T00:00:00Z", 5),
+			opts:     diffOptions{onlyPaths: parseIncludePaths("spec.template.spec.containers")},
+			contains: []string{"nginx:1.26"},
+			absent:   []string{"restartedAt", "replicas"},
+		},
+		{
+			name:     "composes with ignoreMeta",
+			after:    deployment("nginx:1.25", "2026-01-01T00:00:00Z", 2),
+			opts:     diffOptions{ignoreMeta: true, ignorePaths: parseIncludePaths("spec.replicas")},
+			contains: []string{"No differences found"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := tt.after
+			if tt.opts.ignoreMeta {
+				_ = unstructured.SetNestedField(after.Object, "2", "metadata", "resourceVersion")
+			}
+			out, err := diffResources(before, after, tt.opts)
+			if err != nil {
+				t.Fatalf("diffResources() unexpected error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected diff to contain %q, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.absent {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("expected diff not to contain %q, got:\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+	if _, found, _ := unstructured.NestedMap(before.Object, "spec", "template", "metadata", "annotations"); !found {
+		t.Error("diffResources must not modify its inputs")
+	}
+}
+
+func TestDiffResources_Normalize(t *testing.T) {
+	pod := func(containers ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": "web"},
+			"spec":       map[string]interface{}{"containers": containers},
+		}}
+	}
+	container := func(name string, env []interface{}, ports []interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": name, "env": env, "ports": ports}
+	}
+	env := func(names ...string) []interface{} {
+		var out []interface{}
+		for _, n := range names {
+			out = append(out, map[string]interface{}{"name": n, "value": n + "-value"})
+		}
+		return out
+	}
+	ports := func(numbers ...int64) []interface{} {
+		var out []interface{}
+		for _, p := range numbers {
+			out = append(out, map[string]interface{}{"containerPort": p})
+		}
+		return out
+	}
+
+	before := pod(
+		container("app", env("A", "B"), ports(80, 443)),
+		container("sidecar", env("C"), ports(9090)),
+	)
+	reordered := pod(
+		container("sidecar", env("C"), ports(9090)),
+		container("app", env("B", "A"), ports(443, 80)),
+	)
+
+	out, err := diffResources(before, reordered, diffOptions{normalize: true})
+	if err != nil {
+		t.Fatalf("diffResources() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "No differences found") {
+		t.Errorf("expected reordering to be ignored, got:\n%s", out)
+	}
+
+	out, err = diffResources(before, reordered, diffOptions{})
+	if err != nil {
+		t.Fatalf("diffResources() unexpected error: %v", err)
+	}
+	if strings.Contains(out, "No differences found") {
+		t.Error("expected reordering to be reported without normalize")
+	}
+
+	changed := pod(
+		container("sidecar", env("C"), ports(9091)),
+		container("app", env("B", "A"), ports(443, 80)),
+	)
+	out, err = diffResources(before, changed, diffOptions{normalize: true})
+	if err != nil {
+		t.Fatalf("diffResources() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "9091") {
+		t.Errorf("expected real change to be reported, got:\n%s", out)
+	}
+}
+
+func TestSortListByKey_PortsFallBackToPort(t *testing.T) {
+	list := []interface{}{
+		map[string]interface{}{"name": "https", "port": int64(443)},
+		map[string]interface{}{"name": "http", "port": int64(80)},
+		map[string]interface{}{"name": "metrics", "port": int64(9090)},
+	}
+	sortListByKey(list, normalizedLists["ports"])
+
+	var got []string
+	for _, item := range list {
+		got = append(got, item.(map[string]interface{})["name"].(string))
+	}
+	if strings.Join(got, ",") != "http,https,metrics" {
+		t.Errorf("order = %v, want http,https,metrics", got)
+	}
+}
@@ -0,0 +1,253 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Sources an endpoint address can be read from.
+const (
+	endpointSourceSlice     = "EndpointSlice"
+	endpointSourceEndpoints = "Endpoints"
+)
+
+// EndpointAddress is a single backend address of a Service.
+type EndpointAddress struct {
+	Address string `json:"address"`
+	Ready   bool   `json:"ready"`
+	Pod     string `json:"pod,omitempty"`
+	Node    string `json:"node,omitempty"`
+	Ports   string `json:"ports,omitempty"`
+}
+
+// ServiceEndpointsResult lists the backend addresses of a Service.
+type ServiceEndpointsResult struct {
+	Service   string            `json:"service"`
+	Namespace string            `json:"namespace"`
+	Source    string            `json:"source"`
+	Ready     int               `json:"ready"`
+	NotReady  int               `json:"notReady"`
+	Addresses []EndpointAddress `json:"addresses"`
+}
+
+// endpointsHandler handles the kubernetes_endpoints tool
+func endpointsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := steveClient.GetResource(ctx, cluster, "service", namespace, name); err != nil {
+		return "", fmt.Errorf("failed to get service: %w", err)
+	}
+
+	result, err := fetchServiceEndpoints(ctx, steveClient, cluster, namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	return formatServiceEndpoints(result, format)
+}
+
+// fetchServiceEndpoints reads the backends of a Service from its EndpointSlices,
+// falling back to the legacy Endpoints object when no slices are found (for
+// example on clusters without the discovery.k8s.io API).
+func fetchServiceEndpoints(ctx context.Context, client steve.ResourceReader, cluster, namespace, name string) (*ServiceEndpointsResult, error) {
+	result := &ServiceEndpointsResult{Service: name, Namespace: namespace}
+
+	slices, err := client.ListResources(ctx, cluster, "endpointslice", namespace, &steve.ListOptions{
+		LabelSelector: EndpointSliceServiceNameLabel + "=" + name,
+	})
+	if err == nil && len(slices.Items) > 0 {
+		result.Source = endpointSourceSlice
+		result.Addresses = addressesFromEndpointSlices(slices.Items)
+	} else {
+		endpoints, epErr := client.GetResource(ctx, cluster, "endpoints", namespace, name)
+		if epErr != nil {
+			return nil, fmt.Errorf("failed to get endpoints: %w", epErr)
+		}
+		result.Source = endpointSourceEndpoints
+		result.Addresses = addressesFromEndpoints(endpoints)
+	}
+
+	sortEndpointAddresses(result.Addresses)
+	for _, a := range result.Addresses {
+		if a.Ready {
+			result.Ready++
+		} else {
+			result.NotReady++
+		}
+	}
+	return result, nil
+}
+
+// addressesFromEndpointSlices flattens the endpoints of discovery.k8s.io/v1 EndpointSlices.
+// An endpoint whose ready condition is unset is treated as ready, as the API specifies.
+func addressesFromEndpointSlices(slices []unstructured.Unstructured) []EndpointAddress {
+	var result []EndpointAddress
+	for _, slice := range slices {
+		rawPorts, _, _ := unstructured.NestedSlice(slice.Object, "ports")
+		ports := formatEndpointPorts(rawPorts)
+
+		endpoints, _, _ := unstructured.NestedSlice(slice.Object, "endpoints")
+		for _, e := range endpoints {
+			endpoint, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ready, found, _ := unstructured.NestedBool(endpoint, "conditions", "ready")
+			if !found {
+				ready = true
+			}
+			node, _, _ := unstructured.NestedString(endpoint, "nodeName")
+			pod := endpointTargetPod(endpoint)
+
+			addresses, _, _ := unstructured.NestedStringSlice(endpoint, "addresses")
+			for _, addr := range addresses {
+				result = append(result, EndpointAddress{Address: addr, Ready: ready, Pod: pod, Node: node, Ports: ports})
+			}
+		}
+	}
+	return result
+}
+
+// addressesFromEndpoints flattens the ready and not-ready addresses of a v1 Endpoints object.
+func addressesFromEndpoints(endpoints *unstructured.Unstructured) []EndpointAddress {
+	var result []EndpointAddress
+	subsets, _, _ := unstructured.NestedSlice(endpoints.Object, "subsets")
+	for _, s := range subsets {
+		subset, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rawPorts, _, _ := unstructured.NestedSlice(subset, "ports")
+		ports := formatEndpointPorts(rawPorts)
+
+		for _, group := range []struct {
+			field string
+			ready bool
+		}{{"addresses", true}, {"notReadyAddresses", false}} {
+			addresses, _, _ := unstructured.NestedSlice(subset, group.field)
+			for _, a := range addresses {
+				address, ok := a.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				ip, _, _ := unstructured.NestedString(address, "ip")
+				node, _, _ := unstructured.NestedString(address, "nodeName")
+				result = append(result, EndpointAddress{
+					Address: ip,
+					Ready:   group.ready,
+					Pod:     endpointTargetPod(address),
+					Node:    node,
+					Ports:   ports,
+				})
+			}
+		}
+	}
+	return result
+}
+
+// endpointTargetPod returns the pod name of an endpoint's targetRef, if it refers to a pod.
+func endpointTargetPod(endpoint map[string]interface{}) string {
+	kind, _, _ := unstructured.NestedString(endpoint, "targetRef", "kind")
+	if kind != "Pod" {
+		return ""
+	}
+	name, _, _ := unstructured.NestedString(endpoint, "targetRef", "name")
+	return name
+}
+
+// formatEndpointPorts renders endpoint ports as "name:port/protocol" joined by commas.
+func formatEndpointPorts(ports []interface{}) string {
+	parts := make([]string, 0, len(ports))
+	for _, p := range ports {
+		port, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		number, _, _ := unstructured.NestedInt64(port, "port")
+		protocol, _, _ := unstructured.NestedString(port, "protocol")
+		if protocol == "" {
+			protocol = "TCP"
+		}
+		part := strconv.FormatInt(number, 10) + "/" + protocol
+		if name, _, _ := unstructured.NestedString(port, "name"); name != "" {
+			part = name + ":" + part
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ",")
+}
+
+// sortEndpointAddresses lists not-ready addresses first, then orders by address.
+func sortEndpointAddresses(addresses []EndpointAddress) {
+	sort.SliceStable(addresses, func(i, j int) bool {
+		if addresses[i].Ready != addresses[j].Ready {
+			return !addresses[i].Ready
+		}
+		return addresses[i].Address < addresses[j].Address
+	})
+}
+
+// formatServiceEndpoints renders the endpoints result as a table, markdown, CSV, JSON or YAML.
+func formatServiceEndpoints(result *ServiceEndpointsResult, format string) (string, error) {
+	headers := []string{"address", "ready", "pod", "node", "ports"}
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(result)
+	case paramutil.FormatTable, paramutil.FormatMarkdown:
+		if len(result.Addresses) == 0 {
+			return fmt.Sprintf("Service %s/%s has no endpoints (source: %s)\n", result.Namespace, result.Service, result.Source), nil
+		}
+		table, err := paramutil.FormatRows(endpointAddressRows(result.Addresses), headers, format)
+		if err != nil {
+			return "", err
+		}
+		return table + fmt.Sprintf("\n%d ready, %d not ready (source: %s)\n", result.Ready, result.NotReady, result.Source), nil
+	case paramutil.FormatCSV:
+		return paramutil.FormatAsCSV(endpointAddressRows(result.Addresses), headers)
+	default: // json
+		return paramutil.FormatAsJSON(result)
+	}
+}
+
+func endpointAddressRows(addresses []EndpointAddress) []map[string]string {
+	rows := make([]map[string]string, 0, len(addresses))
+	for _, a := range addresses {
+		rows = append(rows, map[string]string{
+			"address": a.Address,
+			"ready":   strconv.FormatBool(a.Ready),
+			"pod":     a.Pod,
+			"node":    a.Node,
+			"ports":   a.Ports,
+		})
+	}
+	return rows
+}
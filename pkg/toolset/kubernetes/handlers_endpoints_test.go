@@ -0,0 +1,174 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeEndpointSlice(name, service string, endpoints ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "EndpointSlice",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+			"labels":    map[string]interface{}{EndpointSliceServiceNameLabel: service},
+		},
+		"ports":     []interface{}{map[string]interface{}{"name": "http", "port": int64(8080), "protocol": "TCP"}},
+		"endpoints": endpoints,
+	}}
+}
+
+func makeSliceEndpoint(ip, pod, node string, ready interface{}) map[string]interface{} {
+	endpoint := map[string]interface{}{
+		"addresses": []interface{}{ip},
+		"nodeName":  node,
+		"targetRef": map[string]interface{}{"kind": "Pod", "name": pod},
+	}
+	if ready != nil {
+		endpoint["conditions"] = map[string]interface{}{"ready": ready}
+	}
+	return endpoint
+}
+
+func TestAddressesFromEndpointSlices(t *testing.T) {
+	slice := makeEndpointSlice("web-abc", "web",
+		makeSliceEndpoint("10.0.0.1", "web-1", "node-a", true),
+		makeSliceEndpoint("10.0.0.2", "web-2", "node-b", false),
+		makeSliceEndpoint("10.0.0.3", "web-3", "node-b", nil),
+	)
+
+	got := addressesFromEndpointSlices([]unstructured.Unstructured{*slice})
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 addresses, got %+v", got)
+	}
+	if got[0].Pod != "web-1" || got[0].Node != "node-a" || got[0].Ports != "http:8080/TCP" || !got[0].Ready {
+		t.Errorf("unexpected first address: %+v", got[0])
+	}
+	if got[1].Ready {
+		t.Errorf("expected %s to be not ready", got[1].Address)
+	}
+	if !got[2].Ready {
+		t.Errorf("expected %s with unset condition to be ready", got[2].Address)
+	}
+}
+
+func TestAddressesFromEndpoints(t *testing.T) {
+	endpoints := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Endpoints",
+		"subsets": []interface{}{
+			map[string]interface{}{
+				"addresses": []interface{}{
+					map[string]interface{}{"ip": "10.0.0.1", "nodeName": "node-a", "targetRef": map[string]interface{}{"kind": "Pod", "name": "web-1"}},
+				},
+				"notReadyAddresses": []interface{}{
+					map[string]interface{}{"ip": "10.0.0.2", "nodeName": "node-b", "targetRef": map[string]interface{}{"kind": "Pod", "name": "web-2"}},
+				},
+				"ports": []interface{}{map[string]interface{}{"port": int64(80)}},
+			},
+		},
+	}}
+
+	got := addressesFromEndpoints(endpoints)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 addresses, got %+v", got)
+	}
+	if !got[0].Ready || got[0].Pod != "web-1" || got[0].Ports != "80/TCP" {
+		t.Errorf("unexpected ready address: %+v", got[0])
+	}
+	if got[1].Ready || got[1].Pod != "web-2" {
+		t.Errorf("unexpected not-ready address: %+v", got[1])
+	}
+}
+
+func TestFetchServiceEndpoints(t *testing.T) {
+	t.Run("endpoint slices", func(t *testing.T) {
+		client := fake.NewClient()
+		client.AddResource(makeEndpointSlice("web-abc", "web",
+			makeSliceEndpoint("10.0.0.1", "web-1", "node-a", true),
+			makeSliceEndpoint("10.0.0.2", "web-2", "node-b", false),
+		))
+		client.AddResource(makeEndpointSlice("api-abc", "api", makeSliceEndpoint("10.0.1.1", "api-1", "node-a", true)))
+
+		result, err := fetchServiceEndpoints(context.Background(), client, "c1", "default", "web")
+		if err != nil {
+			t.Fatalf("fetchServiceEndpoints() unexpected error: %v", err)
+		}
+		if result.Source != endpointSourceSlice || result.Ready != 1 || result.NotReady != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if result.Addresses[0].Address != "10.0.0.2" {
+			t.Errorf("expected not-ready address first, got %+v", result.Addresses)
+		}
+	})
+
+	t.Run("falls back to endpoints", func(t *testing.T) {
+		client := fake.NewClient()
+		client.AddResource(&unstructured.Unstructured{Object: map[string]interface{}{
+			"kind":     "Endpoints",
+			"metadata": map[string]interface{}{"name": "web", "namespace": "default"},
+		}})
+
+		result, err := fetchServiceEndpoints(context.Background(), client, "c1", "default", "web")
+		if err != nil {
+			t.Fatalf("fetchServiceEndpoints() unexpected error: %v", err)
+		}
+		if result.Source != endpointSourceEndpoints || len(result.Addresses) != 0 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+
+		out, err := formatServiceEndpoints(result, paramutil.FormatTable)
+		if err != nil {
+			t.Fatalf("formatServiceEndpoints() unexpected error: %v", err)
+		}
+		if !strings.Contains(out, "has no endpoints") {
+			t.Errorf("expected no-endpoints message, got %q", out)
+		}
+	})
+
+	t.Run("missing endpoints", func(t *testing.T) {
+		if _, err := fetchServiceEndpoints(context.Background(), fake.NewClient(), "c1", "default", "web"); err == nil {
+			t.Fatal("expected error when neither slices nor endpoints exist")
+		}
+	})
+}
+
+func TestFormatServiceEndpoints_Table(t *testing.T) {
+	result := &ServiceEndpointsResult{
+		Service: "web", Namespace: "default", Source: endpointSourceSlice, Ready: 1,
+		Addresses: []EndpointAddress{{Address: "10.0.0.1", Ready: true, Pod: "web-1", Node: "node-a", Ports: "80/TCP"}},
+	}
+
+	out, err := formatServiceEndpoints(result, paramutil.FormatTable)
+	if err != nil {
+		t.Fatalf("formatServiceEndpoints() unexpected error: %v", err)
+	}
+	for _, want := range []string{"address", "10.0.0.1", "web-1", "node-a", "1 ready, 0 not ready"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatServiceEndpoints_CSV(t *testing.T) {
+	result := &ServiceEndpointsResult{
+		Service: "web", Namespace: "default", Source: endpointSourceSlice, Ready: 1,
+		Addresses: []EndpointAddress{{Address: "10.0.0.1", Ready: true, Pod: "web-1", Node: "node-a", Ports: "80/TCP,443/TCP"}},
+	}
+
+	out, err := formatServiceEndpoints(result, paramutil.FormatCSV)
+	if err != nil {
+		t.Fatalf("formatServiceEndpoints() unexpected error: %v", err)
+	}
+	want := "address,ready,pod,node,ports\n10.0.0.1,true,web-1,node-a,\"80/TCP,443/TCP\"\n"
+	if out != want {
+		t.Errorf("formatServiceEndpoints() = %q, want %q", out, want)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	clientexec "k8s.io/client-go/util/exec"
 
@@ -17,6 +19,13 @@ type execResponse struct {
 	ExitCode int    `json:"exitCode"`
 	Stdout   string `json:"stdout"`
 	Stderr   string `json:"stderr"`
+	TimedOut bool   `json:"timedOut,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// podExecutor runs a command in a pod container, as steve.Client does.
+type podExecutor interface {
+	ExecInPod(ctx context.Context, clusterID, namespace, podName, container string, command []string, stdin io.Reader) ([]byte, []byte, error)
 }
 
 func handleExec(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
@@ -28,7 +37,13 @@ func handleExec(ctx context.Context, client interface{}, params map[string]inter
 	if err != nil {
 		return "", err
 	}
+	return execWithExecutor(ctx, steveClient, params)
+}
 
+// execWithExecutor runs the kubernetes_exec command through executor. A
+// command still running at the timeout is not an error: the output written
+// so far is returned with TimedOut set.
+func execWithExecutor(ctx context.Context, executor podExecutor, params map[string]interface{}) (string, error) {
 	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
 	if err != nil {
 		return "", err
@@ -46,8 +61,16 @@ func handleExec(ctx context.Context, client interface{}, params map[string]inter
 		return "", err
 	}
 
+	timeout, err := extractExecTimeout(params)
+	if err != nil {
+		return "", err
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	container := paramutil.ExtractOptionalString(params, paramutil.ParamContainer)
-	stdout, stderr, err := steveClient.ExecInPod(ctx, cluster, namespace, name, container, command, nil)
+	stdout, stderr, err := executor.ExecInPod(execCtx, cluster, namespace, name, container, command, nil)
 	resp := execResponse{
 		ExitCode: 0,
 		Stdout:   string(stdout),
@@ -55,10 +78,17 @@ func handleExec(ctx context.Context, client interface{}, params map[string]inter
 	}
 	if err != nil {
 		exitCode, ok := execExitCode(err)
-		if !ok {
+		switch {
+		case ok:
+			resp.ExitCode = exitCode
+		case errors.Is(execCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+			// Report what the command wrote before the deadline rather than failing outright
+			resp.ExitCode = -1
+			resp.TimedOut = true
+			resp.Message = fmt.Sprintf("command timed out after %s; stdout and stderr hold the partial output written before the deadline", timeout)
+		default:
 			return "", execTransportError(err, stderr)
 		}
-		resp.ExitCode = exitCode
 	}
 
 	data, err := json.Marshal(resp)
@@ -100,6 +130,16 @@ func parseExecCommand(params map[string]interface{}) ([]string, error) {
 	return command, nil
 }
 
+// extractExecTimeout returns the exec deadline from timeoutSeconds, defaulting
+// to DefaultExecTimeoutSeconds.
+func extractExecTimeout(params map[string]interface{}) (time.Duration, error) {
+	seconds := paramutil.ExtractInt64(params, paramutil.ParamTimeoutSeconds, DefaultExecTimeoutSeconds)
+	if seconds < 1 || seconds > MaxExecTimeoutSeconds {
+		return 0, fmt.Errorf("timeoutSeconds must be between 1 and %d, got %d", MaxExecTimeoutSeconds, seconds)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 func execExitCode(err error) (int, bool) {
 	var exitErr clientexec.ExitError
 	if errors.As(err, &exitErr) {
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
+	"time"
 
 	clientexec "k8s.io/client-go/util/exec"
 
@@ -111,7 +113,7 @@ func TestHandleExec_InvalidClientType(t *testing.T) {
 }
 
 func TestHandleExec_MissingRequiredParams(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 	tests := []struct {
 		name        string
 		params      map[string]interface{}
@@ -192,6 +194,57 @@ func TestExecTransportErrorIncludesStderr(t *testing.T) {
 	}
 }
 
+// blockingExecutor writes partial output, then blocks until the context ends.
+type blockingExecutor struct {
+	stdout string
+}
+
+func (e *blockingExecutor) ExecInPod(ctx context.Context, _, _, _, _ string, _ []string, _ io.Reader) ([]byte, []byte, error) {
+	<-ctx.Done()
+	return []byte(e.stdout), nil, ctx.Err()
+}
+
+func TestExecWithExecutor_TimedOut(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":        "c1",
+		"namespace":      "ns",
+		"name":           "pod",
+		"command":        []interface{}{"sh", "-c", "echo started; sleep 60"},
+		"timeoutSeconds": float64(1),
+	}
+
+	out, err := execWithExecutor(context.Background(), &blockingExecutor{stdout: "started\n"}, params)
+	if err != nil {
+		t.Fatalf("execWithExecutor() unexpected error: %v", err)
+	}
+	var resp execResponse
+	if err := json.Unmarshal([]byte(out), &resp); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v\n%s", err, out)
+	}
+	if !resp.TimedOut || resp.ExitCode != -1 || resp.Stdout != "started\n" {
+		t.Fatalf("response = %+v, want timedOut with exitCode -1 and the partial stdout", resp)
+	}
+	if !containsString(resp.Message, "timed out after 1s") || !containsString(resp.Message, "partial output") {
+		t.Fatalf("message = %q, want the timeout and partial output explained", resp.Message)
+	}
+}
+
+func TestExecWithExecutor_CallerCancelled(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":   "c1",
+		"namespace": "ns",
+		"name":      "pod",
+		"command":   []interface{}{"sleep", "60"},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A cancelled call is a failure, not a timeout
+	if _, err := execWithExecutor(ctx, &blockingExecutor{}, params); err == nil {
+		t.Fatal("execWithExecutor() expected an error for a cancelled call")
+	}
+}
+
 func TestExecResponse_JSONMarshal(t *testing.T) {
 	response := execResponse{
 		ExitCode: 3,
@@ -218,3 +271,36 @@ func TestExecResponse_JSONMarshal(t *testing.T) {
 		t.Fatalf("stderr = %v, want err", decoded["stderr"])
 	}
 }
+
+func TestExtractExecTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "default", params: map[string]interface{}{}, want: DefaultExecTimeoutSeconds * time.Second},
+		{name: "explicit", params: map[string]interface{}{"timeoutSeconds": float64(5)}, want: 5 * time.Second},
+		{name: "max", params: map[string]interface{}{"timeoutSeconds": float64(MaxExecTimeoutSeconds)}, want: MaxExecTimeoutSeconds * time.Second},
+		{name: "zero", params: map[string]interface{}{"timeoutSeconds": float64(0)}, wantErr: true},
+		{name: "too large", params: map[string]interface{}{"timeoutSeconds": float64(MaxExecTimeoutSeconds + 1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractExecTimeout(tt.params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("extractExecTimeout() expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extractExecTimeout() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("extractExecTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
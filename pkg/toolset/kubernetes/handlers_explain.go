@@ -0,0 +1,237 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// openAPISchemaRefPrefix prefixes references between OpenAPI v3 component schemas.
+const openAPISchemaRefPrefix = "#/components/schemas/"
+
+// maxSchemaDerefs bounds $ref/allOf chains so a malformed schema cannot loop forever.
+const maxSchemaDerefs = 16
+
+// explainHandler handles the kubernetes_explain tool.
+// It documents a kind, or a field of it, from the cluster's OpenAPI schema like kubectl explain.
+func explainHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	kind, err := extractResourceKind(params)
+	if err != nil {
+		return "", err
+	}
+	field := paramutil.ExtractOptionalString(params, paramutil.ParamField)
+
+	kindSchema, err := steveClient.GetKindSchema(ctx, cluster, kind)
+	if err != nil {
+		return "", fmt.Errorf("failed to get schema for %s: %w", kind, err)
+	}
+	return explainKindSchema(kindSchema, field)
+}
+
+// explainKindSchema renders the documentation of the field at the dotted path
+// field of a kind (the kind itself when field is empty). A leading segment
+// naming the kind is ignored, so both "spec.strategy" and
+// "deployment.spec.strategy" work. Lists and maps are stepped through to
+// their element type.
+func explainKindSchema(ks *steve.KindSchema, field string) (string, error) {
+	schemas := ks.Schemas
+	root, _ := schemas[ks.Name].(map[string]interface{})
+	if root == nil {
+		return "", fmt.Errorf("schema %s not found", ks.Name)
+	}
+
+	var segments []string
+	for _, s := range strings.Split(field, ".") {
+		if s = strings.TrimSpace(s); s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) > 0 && strings.EqualFold(segments[0], ks.GVK.Kind) {
+		segments = segments[1:]
+	}
+
+	// property is the schema as declared in its parent, which may carry the
+	// description and the list/map wrapping; current is its element type.
+	property := root
+	current := derefSchema(schemas, root)
+	for i, segment := range segments {
+		props, _ := current["properties"].(map[string]interface{})
+		child, ok := props[segment].(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("field %q does not exist in %s", strings.Join(segments[:i+1], "."), ks.GVK.Kind)
+		}
+		property = child
+		current = elementSchema(schemas, child)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "KIND:     %s\n", ks.GVK.Kind)
+	fmt.Fprintf(&b, "VERSION:  %s\n\n", ks.GVK.GroupVersion().String())
+	if len(segments) > 0 {
+		fmt.Fprintf(&b, "FIELD:    %s <%s>\n\n", segments[len(segments)-1], schemaTypeName(schemas, property))
+	}
+
+	b.WriteString("DESCRIPTION:\n")
+	description := schemaDescription(schemas, property)
+	if description == "" {
+		description = "<empty>"
+	}
+	writeIndented(&b, description, "    ")
+
+	props, _ := current["properties"].(map[string]interface{})
+	if len(props) == 0 {
+		return b.String(), nil
+	}
+	required := schemaRequired(current)
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b.WriteString("\nFIELDS:\n")
+	for _, name := range names {
+		prop, _ := props[name].(map[string]interface{})
+		fmt.Fprintf(&b, "  %s\t<%s>", name, schemaTypeName(schemas, prop))
+		if slices.Contains(required, name) {
+			b.WriteString(" -required-")
+		}
+		b.WriteString("\n")
+		if enum := schemaEnum(derefSchema(schemas, prop)); enum != "" {
+			fmt.Fprintf(&b, "    enum: %s\n", enum)
+		}
+		if description := schemaDescription(schemas, prop); description != "" {
+			writeIndented(&b, description, "    ")
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n", nil
+}
+
+// derefSchema follows $ref and single-element allOf wrappers to the schema
+// they point at.
+func derefSchema(schemas map[string]interface{}, s map[string]interface{}) map[string]interface{} {
+	for i := 0; i < maxSchemaDerefs && s != nil; i++ {
+		if ref, ok := s["$ref"].(string); ok {
+			s, _ = schemas[strings.TrimPrefix(ref, openAPISchemaRefPrefix)].(map[string]interface{})
+			continue
+		}
+		if allOf, ok := s["allOf"].([]interface{}); ok && len(allOf) == 1 {
+			s, _ = allOf[0].(map[string]interface{})
+			continue
+		}
+		break
+	}
+	if s == nil {
+		return map[string]interface{}{}
+	}
+	return s
+}
+
+// elementSchema dereferences s and steps through list items and map values
+// to the schema of the elements.
+func elementSchema(schemas map[string]interface{}, s map[string]interface{}) map[string]interface{} {
+	for i := 0; i < maxSchemaDerefs; i++ {
+		s = derefSchema(schemas, s)
+		if items, ok := s["items"].(map[string]interface{}); ok && s["type"] == "array" {
+			s = items
+			continue
+		}
+		if values, ok := s["additionalProperties"].(map[string]interface{}); ok && s["properties"] == nil {
+			s = values
+			continue
+		}
+		break
+	}
+	return s
+}
+
+// schemaTypeName renders a schema type the way kubectl explain does, e.g.
+// "string", "[]Container", "map[string]string" or "Object".
+func schemaTypeName(schemas map[string]interface{}, s map[string]interface{}) string {
+	for i := 0; i < maxSchemaDerefs && s != nil; i++ {
+		if ref, ok := s["$ref"].(string); ok {
+			name := strings.TrimPrefix(ref, openAPISchemaRefPrefix)
+			return name[strings.LastIndex(name, ".")+1:]
+		}
+		allOf, ok := s["allOf"].([]interface{})
+		if !ok || len(allOf) != 1 {
+			break
+		}
+		s, _ = allOf[0].(map[string]interface{})
+	}
+	if s == nil {
+		return "Object"
+	}
+
+	if intOrString, _ := s["x-kubernetes-int-or-string"].(bool); intOrString {
+		return "IntOrString"
+	}
+	switch t, _ := s["type"].(string); t {
+	case "array":
+		items, _ := s["items"].(map[string]interface{})
+		return "[]" + schemaTypeName(schemas, items)
+	case "object", "":
+		if values, ok := s["additionalProperties"].(map[string]interface{}); ok && s["properties"] == nil {
+			return "map[string]" + schemaTypeName(schemas, values)
+		}
+		return "Object"
+	default:
+		return t
+	}
+}
+
+// schemaDescription returns the description of s, falling back to the
+// description of the schema it references.
+func schemaDescription(schemas map[string]interface{}, s map[string]interface{}) string {
+	if description, _ := s["description"].(string); description != "" {
+		return description
+	}
+	description, _ := derefSchema(schemas, s)["description"].(string)
+	return description
+}
+
+func schemaRequired(s map[string]interface{}) []string {
+	raw, _ := s["required"].([]interface{})
+	required := make([]string, 0, len(raw))
+	for _, r := range raw {
+		if name, ok := r.(string); ok {
+			required = append(required, name)
+		}
+	}
+	return required
+}
+
+func schemaEnum(s map[string]interface{}) string {
+	raw, _ := s["enum"].([]interface{})
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		values = append(values, fmt.Sprint(v))
+	}
+	return strings.Join(values, ", ")
+}
+
+// writeIndented writes text with every line prefixed by indent.
+func writeIndented(b *strings.Builder, text, indent string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		b.WriteString(indent)
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+}
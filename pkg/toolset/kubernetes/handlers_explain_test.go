@@ -0,0 +1,122 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func testDeploymentSchema() *steve.KindSchema {
+	ref := func(name string) map[string]interface{} {
+		return map[string]interface{}{"$ref": openAPISchemaRefPrefix + name}
+	}
+	return &steve.KindSchema{
+		GVK:  schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Name: "io.k8s.api.apps.v1.Deployment",
+		Schemas: map[string]interface{}{
+			"io.k8s.api.apps.v1.Deployment": map[string]interface{}{
+				"description": "Deployment enables declarative updates for Pods and ReplicaSets.",
+				"properties": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"allOf":       []interface{}{ref("io.k8s.api.apps.v1.DeploymentSpec")},
+						"description": "Specification of the desired behavior of the Deployment.",
+					},
+				},
+			},
+			"io.k8s.api.apps.v1.DeploymentSpec": map[string]interface{}{
+				"required": []interface{}{"selector"},
+				"properties": map[string]interface{}{
+					"replicas": map[string]interface{}{"type": "integer", "description": "Number of desired pods."},
+					"selector": map[string]interface{}{"allOf": []interface{}{ref("io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector")}},
+					"strategy": map[string]interface{}{"allOf": []interface{}{ref("io.k8s.api.apps.v1.DeploymentStrategy")}},
+					"containers": map[string]interface{}{
+						"type":  "array",
+						"items": ref("io.k8s.api.core.v1.Container"),
+					},
+					"nodeSelector": map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+			"io.k8s.api.apps.v1.DeploymentStrategy": map[string]interface{}{
+				"description": "DeploymentStrategy describes how to replace existing pods with new ones.",
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{
+						"type":        "string",
+						"enum":        []interface{}{"Recreate", "RollingUpdate"},
+						"description": "Type of deployment.",
+					},
+				},
+			},
+			"io.k8s.api.core.v1.Container": map[string]interface{}{
+				"description": "A single application container.",
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{"type": "string", "description": "Name of the container."},
+				},
+			},
+			"io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector": map[string]interface{}{
+				"description": "A label selector.",
+			},
+		},
+	}
+}
+
+func TestExplainKindSchema(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  []string
+	}{
+		{
+			name:  "kind",
+			field: "",
+			want:  []string{"KIND:     Deployment", "VERSION:  apps/v1", "Deployment enables declarative updates", "spec\t<DeploymentSpec>"},
+		},
+		{
+			name:  "field with types and required marker",
+			field: "spec",
+			want: []string{
+				"FIELD:    spec <DeploymentSpec>",
+				"Specification of the desired behavior",
+				"containers\t<[]Container>",
+				"nodeSelector\t<map[string]string>",
+				"replicas\t<integer>",
+				"selector\t<LabelSelector> -required-",
+				"    A label selector.",
+			},
+		},
+		{
+			name:  "leading kind segment and enum",
+			field: "deployment.spec.strategy",
+			want:  []string{"FIELD:    strategy <DeploymentStrategy>", "DeploymentStrategy describes", "type\t<string>", "enum: Recreate, RollingUpdate"},
+		},
+		{
+			name:  "steps through lists",
+			field: "spec.containers.name",
+			want:  []string{"FIELD:    name <string>", "Name of the container."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := explainKindSchema(testDeploymentSchema(), tt.field)
+			if err != nil {
+				t.Fatalf("explainKindSchema() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output missing %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestExplainKindSchema_UnknownField(t *testing.T) {
+	_, err := explainKindSchema(testDeploymentSchema(), "spec.replica")
+	if err == nil || !strings.Contains(err.Error(), `"spec.replica"`) {
+		t.Errorf("expected unknown field error, got %v", err)
+	}
+}
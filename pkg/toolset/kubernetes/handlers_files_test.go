@@ -62,7 +62,7 @@ func TestHandleDownloadFile_MissingRequiredParams(t *testing.T) {
 	}
 
 	// Use a mock steve client to bypass client validation
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,7 +131,7 @@ func TestHandleDownloadFile_InvalidClientType(t *testing.T) {
 // TestHandleDownloadFile_InvalidMaxFileSize tests that handleDownloadFile returns
 // an error when maxFileSize is invalid.
 func TestHandleDownloadFile_InvalidMaxFileSize(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	params := map[string]interface{}{
 		"cluster":     "c1",
@@ -197,7 +197,7 @@ func TestHandleUploadFile_MissingRequiredParams(t *testing.T) {
 	}
 
 	// Use a mock steve client to bypass client validation
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -284,7 +284,7 @@ func TestHandleUploadFile_InvalidClientType(t *testing.T) {
 // TestHandleUploadFile_InvalidBase64Content tests that handleUploadFile returns
 // an error when the content is not valid base64.
 func TestHandleUploadFile_InvalidBase64Content(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	tests := []struct {
 		name    string
@@ -329,7 +329,7 @@ func TestHandleUploadFile_InvalidBase64Content(t *testing.T) {
 // TestHandleUploadFile_InvalidMaxFileSize tests that handleUploadFile returns
 // an error when maxFileSize is invalid.
 func TestHandleUploadFile_InvalidMaxFileSize(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	params := map[string]interface{}{
 		"cluster":     "c1",
@@ -353,7 +353,7 @@ func TestHandleUploadFile_InvalidMaxFileSize(t *testing.T) {
 // TestHandleUploadFile_ContentExceedsMaxFileSize tests that handleUploadFile returns
 // an error when the content exceeds the maximum file size.
 func TestHandleUploadFile_ContentExceedsMaxFileSize(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	// Create content that is 100 bytes (larger than 10 bytes limit)
 	largeContent := make([]byte, 100)
@@ -385,7 +385,7 @@ func TestHandleUploadFile_ContentExceedsMaxFileSize(t *testing.T) {
 func TestHandleUploadFile_ValidBase64Content(t *testing.T) {
 	// This test verifies that the base64 decoding succeeds
 	// It will fail on the client call, but that's expected without a real cluster
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	// Valid base64 content
 	testContent := "Hello, World!"
@@ -467,7 +467,7 @@ func TestUploadFileResponse_JSONMarshal(t *testing.T) {
 
 // TestHandleDownloadFile_CombinedClient tests that handleDownloadFile works with CombinedClient.
 func TestHandleDownloadFile_CombinedClient(t *testing.T) {
-	mockSteveClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockSteveClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 	combinedClient := &toolset.CombinedClient{
 		Norman: nil,
 		Steve:  mockSteveClient,
@@ -490,7 +490,7 @@ func TestHandleDownloadFile_CombinedClient(t *testing.T) {
 
 // TestHandleUploadFile_CombinedClient tests that handleUploadFile works with CombinedClient.
 func TestHandleUploadFile_CombinedClient(t *testing.T) {
-	mockSteveClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockSteveClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 	combinedClient := &toolset.CombinedClient{
 		Norman: nil,
 		Steve:  mockSteveClient,
@@ -556,7 +556,7 @@ func TestHandleUploadFile_CombinedClientNilSteve(t *testing.T) {
 
 // TestHandleUploadFile_ReadOnlyFalse tests that handleUploadFile proceeds when readOnly is false.
 func TestHandleUploadFile_ReadOnlyFalse(t *testing.T) {
-	mockClient := steve.NewClient("https://example.com", "token", "", "", false)
+	mockClient := steve.NewClient("https://example.com", "token", "", "", false, 0)
 
 	params := map[string]interface{}{
 		"cluster":   "c1",
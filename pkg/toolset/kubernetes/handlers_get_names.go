@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// extractResourceNames returns the names kubernetes_get should fetch: the
+// entries of the names array followed by the comma-separated name list, with
+// blanks and duplicates dropped.
+func extractResourceNames(params map[string]interface{}) ([]string, error) {
+	var raw []string
+	switch values := params[paramutil.ParamNames].(type) {
+	case nil:
+	case []string:
+		raw = append(raw, values...)
+	case []interface{}:
+		for i, value := range values {
+			name, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid names[%d]: expected string", i)
+			}
+			raw = append(raw, name)
+		}
+	default:
+		return nil, fmt.Errorf("invalid names: expected array of strings")
+	}
+	raw = append(raw, strings.Split(paramutil.ExtractOptionalString(params, paramutil.ParamName), ",")...)
+
+	names := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, name := range raw {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: %s", paramutil.ErrMissingParameter, paramutil.ParamName)
+	}
+	return names, nil
+}
+
+// getsResourceList reports whether kubernetes_get returns its result as a
+// list: whenever the names parameter is used or name lists several names.
+// json and yaml output is then always {items, failures}, even for one name,
+// so its shape follows the parameters rather than how many names there are.
+func getsResourceList(params map[string]interface{}, names []string) bool {
+	if len(names) > 1 {
+		return true
+	}
+	switch values := params[paramutil.ParamNames].(type) {
+	case []string:
+		return len(values) > 0
+	case []interface{}:
+		return len(values) > 0
+	}
+	return false
+}
+
+// GetFailure is a name kubernetes_get could not fetch, with the reason.
+type GetFailure struct {
+	Name  string `json:"name" yaml:"name"`
+	Error string `json:"error" yaml:"error"`
+}
+
+// resourceGetResult is the json and yaml output of a multi-name kubernetes_get.
+// Failures is empty when every name was fetched. Owners maps each item's name
+// to its owners and is only set with includeOwners. UndecodedData lists the
+// Secret keys decodeSecretData left encoded and is omitted when there are none.
+type resourceGetResult struct {
+	Items         []map[string]interface{}   `json:"items" yaml:"items"`
+	Failures      []GetFailure               `json:"failures" yaml:"failures"`
+	Owners        map[string][]ResourceOwner `json:"owners,omitempty" yaml:"owners,omitempty"`
+	UndecodedData []UndecodedSecretKey       `json:"undecodedData,omitempty" yaml:"undecodedData,omitempty"`
+}
+
+// getResources fetches each named resource in turn. Resources that cannot be
+// fetched are reported as failures rather than failing the call.
+func getResources(ctx context.Context, client steve.ResourceReader, cluster, kind, namespace string, names []string) (*unstructured.UnstructuredList, []GetFailure) {
+	list := &unstructured.UnstructuredList{Items: make([]unstructured.Unstructured, 0, len(names))}
+	var failures []GetFailure
+	for _, name := range names {
+		resource, err := client.GetResource(ctx, cluster, kind, namespace, name)
+		if err != nil {
+			failures = append(failures, GetFailure{Name: name, Error: err.Error()})
+			continue
+		}
+		list.Items = append(list.Items, *resource.DeepCopy())
+	}
+	return list, failures
+}
+
+// formatResourceGetResult renders the fetched resources, the failed names, the
+// owners of each resource and any undecoded Secret keys as a JSON or YAML
+// object.
+func formatResourceGetResult(list *unstructured.UnstructuredList, failures []GetFailure, owners map[string][]ResourceOwner, undecoded []UndecodedSecretKey, format string, filter *paramutil.ResourceFilter) (string, error) {
+	if filter != nil {
+		list = filter.FilterList(list)
+	}
+	result := resourceGetResult{Items: resourceListObjects(list), Failures: failures, Owners: owners, UndecodedData: undecoded}
+	if result.Failures == nil {
+		result.Failures = []GetFailure{}
+	}
+	return formatStructured(result, format)
+}
+
+// formatGetFailureLines renders failures as "name: error" lines.
+func formatGetFailureLines(failures []GetFailure) []string {
+	lines := make([]string, 0, len(failures))
+	for _, f := range failures {
+		lines = append(lines, f.Name+": "+f.Error)
+	}
+	return lines
+}
+
+// formatGetFailures returns a trailing note listing the names that could not
+// be fetched, or an empty string when every name succeeded. It is only used
+// for text output; json and yaml carry the failures in the result.
+func formatGetFailures(failures []GetFailure, total int) string {
+	if len(failures) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\nNote: failed to get %d of %d resources:\n  %s", len(failures), total, strings.Join(formatGetFailureLines(failures), "\n  "))
+}
@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExtractResourceNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    []string
+		wantErr string
+	}{
+		{name: "single name", params: map[string]interface{}{"name": "web"}, want: []string{"web"}},
+		{name: "comma-separated", params: map[string]interface{}{"name": "web, api,,db "}, want: []string{"web", "api", "db"}},
+		{name: "names array", params: map[string]interface{}{"names": []interface{}{"web", "api"}}, want: []string{"web", "api"}},
+		{name: "string slice", params: map[string]interface{}{"names": []string{"web"}}, want: []string{"web"}},
+		{name: "names and name deduplicated", params: map[string]interface{}{"names": []interface{}{"web", "api"}, "name": "api,db"}, want: []string{"web", "api", "db"}},
+		{name: "missing", params: map[string]interface{}{}, wantErr: "missing required parameter"},
+		{name: "only blanks", params: map[string]interface{}{"name": " , "}, wantErr: "missing required parameter"},
+		{name: "non-string entry", params: map[string]interface{}{"names": []interface{}{"web", 1}}, wantErr: "invalid names[1]"},
+		{name: "not an array", params: map[string]interface{}{"names": "web"}, wantErr: "invalid names"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractResourceNames(tt.params)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if tt.wantErr == "missing required parameter" && !errors.Is(err, paramutil.ErrMissingParameter) {
+					t.Errorf("expected ErrMissingParameter, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("extractResourceNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetsResourceList(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		want   bool
+	}{
+		{name: "single name", params: map[string]interface{}{"name": "web"}, want: false},
+		{name: "comma-separated", params: map[string]interface{}{"name": "web,api"}, want: true},
+		{name: "one entry in names", params: map[string]interface{}{"names": []interface{}{"web"}}, want: true},
+		{name: "string slice", params: map[string]interface{}{"names": []string{"web"}}, want: true},
+		{name: "empty names", params: map[string]interface{}{"names": []interface{}{}, "name": "web"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, err := extractResourceNames(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := getsResourceList(tt.params, names); got != tt.want {
+				t.Errorf("getsResourceList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetResources(t *testing.T) {
+	client := fake.NewClient()
+	addGetTestResource(client, "web", "default", "Pod")
+	addGetTestResource(client, "api", "default", "Pod")
+	addGetTestResource(client, "db", "other", "Pod")
+
+	names := []string{"web", "missing", "api", "db"}
+	list, failures := getResources(context.Background(), client, "c1", "pod", "default", names)
+
+	var got []string
+	for _, item := range list.Items {
+		got = append(got, item.GetName())
+	}
+	if !slices.Equal(got, []string{"web", "api"}) {
+		t.Errorf("expected web and api in order, got %v", got)
+	}
+	if len(failures) != 2 || failures[0].Name != "missing" || failures[1].Name != "db" || failures[0].Error == "" {
+		t.Errorf("expected failures for missing and db, got %v", failures)
+	}
+
+	note := formatGetFailures(failures, len(names))
+	if !strings.Contains(note, "failed to get 2 of 4 resources") || !strings.Contains(note, "\n  missing: ") {
+		t.Errorf("unexpected failure note: %q", note)
+	}
+	if formatGetFailures(nil, 2) != "" {
+		t.Error("expected no note without failures")
+	}
+
+	// The fetched items are copies, so masking them leaves the source untouched
+	list.Items[0].SetLabels(map[string]string{"changed": "true"})
+	source, _ := client.GetResource(context.Background(), "c1", "pod", "default", "web")
+	if len(source.GetLabels()) != 0 {
+		t.Errorf("expected source resource to be unchanged, got labels %v", source.GetLabels())
+	}
+}
+
+func TestGetResources_FormatsLikeList(t *testing.T) {
+	client := fake.NewClient()
+	addGetTestResource(client, "web", "default", "Pod")
+	addGetTestResource(client, "api", "default", "Pod")
+
+	list, _ := getResources(context.Background(), client, "c1", "pod", "default", []string{"web", "api"})
+	out, err := formatResourceList(list, paramutil.FormatTable, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "NAME") || !strings.Contains(out, "web") || !strings.Contains(out, "api") {
+		t.Errorf("expected a table of both resources, got:\n%s", out)
+	}
+}
+
+func TestFormatResourceGetResult(t *testing.T) {
+	client := fake.NewClient()
+	addGetTestResource(client, "web", "default", "Pod")
+	list, failures := getResources(context.Background(), client, "c1", "pod", "default", []string{"web", "missing"})
+
+	tests := []struct {
+		name         string
+		failures     []GetFailure
+		wantFailures int
+	}{
+		{name: "with failures", failures: failures, wantFailures: 1},
+		{name: "without failures", wantFailures: 0},
+	}
+	for _, tt := range tests {
+		for _, format := range []string{paramutil.FormatJSON, paramutil.FormatYAML} {
+			t.Run(tt.name+"/"+format, func(t *testing.T) {
+				out, err := formatResourceGetResult(list, tt.failures, nil, nil, format, nil)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				var result resourceGetResult
+				if format == paramutil.FormatJSON {
+					err = json.Unmarshal([]byte(out), &result)
+				} else {
+					err = yaml.Unmarshal([]byte(out), &result)
+				}
+				if err != nil {
+					t.Fatalf("output is not parseable: %v\n%s", err, out)
+				}
+				if len(result.Items) != 1 || len(result.Failures) != tt.wantFailures {
+					t.Fatalf("result = %+v", result)
+				}
+				if tt.wantFailures > 0 && result.Failures[0].Name != "missing" {
+					t.Errorf("failures = %+v", result.Failures)
+				}
+				// The failures key is always present so the shape does not change
+				if !strings.Contains(out, "failures") {
+					t.Errorf("expected a failures key in:\n%s", out)
+				}
+			})
+		}
+	}
+}
+
+func addGetTestResource(client *fake.Client, name, namespace, kind string) {
+	item := makeUnstructuredItem(name, namespace, kind)
+	client.AddResource(&item)
+}
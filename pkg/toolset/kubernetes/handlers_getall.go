@@ -32,8 +32,10 @@ func getAllHandler(ctx context.Context, client interface{}, params map[string]in
 	labelSelector := paramutil.ExtractOptionalString(params, paramutil.ParamLabelSelector)
 	excludeEvents := paramutil.ExtractBool(params, "excludeEvents", true)
 	scope := paramutil.ExtractOptionalString(params, "scope")
-	since := paramutil.ExtractOptionalString(params, "since")
+	customResourcesOnly := paramutil.ExtractBool(params, "customResourcesOnly", false)
+	since := paramutil.ExtractOptionalString(params, paramutil.ParamSince)
 	limit := paramutil.ExtractInt64(params, paramutil.ParamLimit, 0)
+	concurrency := paramutil.ExtractInt64(params, paramutil.ParamConcurrency, steve.DefaultGetAllConcurrency)
 
 	// Validate scope parameter
 	if scope != "" && scope != "namespaced" && scope != "cluster" {
@@ -53,10 +55,12 @@ func getAllHandler(ctx context.Context, client interface{}, params map[string]in
 
 	// Use the Steve client's GetAllResources method
 	opts := &steve.GetAllOptions{
-		Namespace:     namespace,
-		ExcludeEvents: excludeEvents,
-		Scope:         scope,
-		Limit:         limit,
+		Namespace:           namespace,
+		ExcludeEvents:       excludeEvents,
+		Scope:               scope,
+		Limit:               limit,
+		CustomResourcesOnly: customResourcesOnly,
+		MaxConcurrency:      int(concurrency),
 	}
 
 	result, err := steveClient.GetAllResources(ctx, cluster, opts)
@@ -68,7 +72,7 @@ func getAllHandler(ctx context.Context, client interface{}, params map[string]in
 	filteredItems := filterAllResources(result.Items, nameFilter, labelSelector, sinceTime)
 
 	// Format and return result
-	return formatAllResources(filteredItems, format)
+	return formatAllResources(filteredItems, result.PermissionDenied, format)
 }
 
 // filterAllResources applies client-side filters to the resource list.
@@ -152,17 +156,40 @@ func matchesLabelSelector(labels map[string]string, selector string) bool {
 }
 
 // formatAllResources formats the all resources result in the requested format.
-func formatAllResources(items []steve.AllResourceItem, format string) (string, error) {
+// Resource types skipped for lack of permissions follow the table, while json
+// and yaml are always an {items, skippedDueToPermissions} object, even when
+// nothing was skipped.
+func formatAllResources(items []steve.AllResourceItem, permissionDenied []string, format string) (string, error) {
 	switch format {
 	case paramutil.FormatTable:
-		return formatAllResourcesAsTable(items), nil
+		return formatAllResourcesAsTable(items) + formatPermissionDeniedTable(permissionDenied), nil
 	case paramutil.FormatYAML:
-		return formatAllResourcesAsYAML(items)
+		return formatAllResourcesAsYAML(items, permissionDenied)
 	default: // json
-		return formatAllResourcesAsJSON(items)
+		return formatAllResourcesAsJSON(items, permissionDenied)
 	}
 }
 
+// skippedResources summarizes resource types skipped due to permissions.
+type skippedResources struct {
+	Count     int      `json:"count" yaml:"count"`
+	Resources []string `json:"resources" yaml:"resources"`
+}
+
+// formatPermissionDeniedTable renders the "skipped due to permissions" section
+// appended to table output.
+func formatPermissionDeniedTable(permissionDenied []string) string {
+	if len(permissionDenied) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\nSkipped due to permissions: %d resource types\n", len(permissionDenied))
+	for _, name := range permissionDenied {
+		fmt.Fprintf(&b, "  %s\n", name)
+	}
+	return b.String()
+}
+
 // formatAllResourcesAsTable formats all resources as a table.
 func formatAllResourcesAsTable(items []steve.AllResourceItem) string {
 	if len(items) == 0 {
@@ -191,32 +218,50 @@ func formatAllResourcesAsTable(items []steve.AllResourceItem) string {
 	return b.String()
 }
 
-// formatAllResourcesAsJSON formats all resources as JSON.
-func formatAllResourcesAsJSON(items []steve.AllResourceItem) (string, error) {
-	// Create a simplified structure for output
-	type simpleItem struct {
-		Name       string                 `json:"name"`
-		Namespace  string                 `json:"namespace,omitempty"`
-		Kind       string                 `json:"kind"`
-		APIVersion string                 `json:"apiVersion"`
-		Resource   map[string]interface{} `json:"resource,omitempty"`
-	}
+// allResourceItem is a simplified resource in the json and yaml output.
+type allResourceItem struct {
+	Name       string                 `json:"name" yaml:"name"`
+	Namespace  string                 `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Kind       string                 `json:"kind" yaml:"kind"`
+	APIVersion string                 `json:"apiVersion" yaml:"apiVersion"`
+	Resource   map[string]interface{} `json:"resource,omitempty" yaml:"resource,omitempty"`
+}
 
-	output := make([]simpleItem, 0, len(items))
+// allResourcesResult is the json and yaml output of kubernetes_get_all. The
+// skipped list is always present, with a zero count when nothing was denied,
+// so the shape does not depend on RBAC.
+type allResourcesResult struct {
+	Items                   []allResourceItem `json:"items" yaml:"items"`
+	SkippedDueToPermissions skippedResources  `json:"skippedDueToPermissions" yaml:"skippedDueToPermissions"`
+}
+
+// newAllResourcesResult builds the json and yaml output from the collected items.
+func newAllResourcesResult(items []steve.AllResourceItem, permissionDenied []string) allResourcesResult {
+	result := allResourcesResult{
+		Items:                   make([]allResourceItem, 0, len(items)),
+		SkippedDueToPermissions: skippedResources{Count: len(permissionDenied), Resources: permissionDenied},
+	}
+	if result.SkippedDueToPermissions.Resources == nil {
+		result.SkippedDueToPermissions.Resources = []string{}
+	}
 	for _, item := range items {
-		si := simpleItem{
+		ri := allResourceItem{
 			Name:       item.Name,
 			Namespace:  item.Namespace,
 			Kind:       item.Kind,
 			APIVersion: item.APIVersion,
 		}
 		if item.Resource != nil {
-			si.Resource = item.Resource.Object
+			ri.Resource = item.Resource.Object
 		}
-		output = append(output, si)
+		result.Items = append(result.Items, ri)
 	}
+	return result
+}
 
-	data, err := json.MarshalIndent(output, "", "  ")
+// formatAllResourcesAsJSON formats all resources as JSON.
+func formatAllResourcesAsJSON(items []steve.AllResourceItem, permissionDenied []string) (string, error) {
+	data, err := json.MarshalIndent(newAllResourcesResult(items, permissionDenied), "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to format as JSON: %w", err)
 	}
@@ -224,31 +269,8 @@ func formatAllResourcesAsJSON(items []steve.AllResourceItem) (string, error) {
 }
 
 // formatAllResourcesAsYAML formats all resources as YAML.
-func formatAllResourcesAsYAML(items []steve.AllResourceItem) (string, error) {
-	// Create a simplified structure for output
-	type simpleItem struct {
-		Name       string                 `yaml:"name"`
-		Namespace  string                 `yaml:"namespace,omitempty"`
-		Kind       string                 `yaml:"kind"`
-		APIVersion string                 `yaml:"apiVersion"`
-		Resource   map[string]interface{} `yaml:"resource,omitempty"`
-	}
-
-	output := make([]simpleItem, 0, len(items))
-	for _, item := range items {
-		si := simpleItem{
-			Name:       item.Name,
-			Namespace:  item.Namespace,
-			Kind:       item.Kind,
-			APIVersion: item.APIVersion,
-		}
-		if item.Resource != nil {
-			si.Resource = item.Resource.Object
-		}
-		output = append(output, si)
-	}
-
-	data, err := yaml.Marshal(output)
+func formatAllResourcesAsYAML(items []steve.AllResourceItem, permissionDenied []string) (string, error) {
+	data, err := yaml.Marshal(newAllResourcesResult(items, permissionDenied))
 	if err != nil {
 		return "", fmt.Errorf("failed to format as YAML: %w", err)
 	}
@@ -1,11 +1,13 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"gopkg.in/yaml.v3"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -69,7 +71,7 @@ func TestFormatAllResources(t *testing.T) {
 	}
 
 	t.Run("json", func(t *testing.T) {
-		out, err := formatAllResources(items, "json")
+		out, err := formatAllResources(items, nil, "json")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -79,7 +81,7 @@ func TestFormatAllResources(t *testing.T) {
 	})
 
 	t.Run("yaml", func(t *testing.T) {
-		out, err := formatAllResources(items, "yaml")
+		out, err := formatAllResources(items, nil, "yaml")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -89,7 +91,7 @@ func TestFormatAllResources(t *testing.T) {
 	})
 
 	t.Run("table", func(t *testing.T) {
-		out, err := formatAllResources(items, "table")
+		out, err := formatAllResources(items, nil, "table")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -102,7 +104,7 @@ func TestFormatAllResources(t *testing.T) {
 	})
 
 	t.Run("table with namespace shows dash", func(t *testing.T) {
-		out, err := formatAllResources(items, "table")
+		out, err := formatAllResources(items, nil, "table")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -113,7 +115,7 @@ func TestFormatAllResources(t *testing.T) {
 }
 
 func TestFormatAllResources_Empty(t *testing.T) {
-	out, err := formatAllResources(nil, "table")
+	out, err := formatAllResources(nil, nil, "table")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -134,7 +136,7 @@ func TestFormatAllResources_JSON_WithResource(t *testing.T) {
 		},
 	}
 
-	out, err := formatAllResources(items, "json")
+	out, err := formatAllResources(items, nil, "json")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -143,6 +145,57 @@ func TestFormatAllResources_JSON_WithResource(t *testing.T) {
 	}
 }
 
+func TestFormatAllResources_PermissionDenied(t *testing.T) {
+	items := []steve.AllResourceItem{{Name: "pod-1", Namespace: "default", Kind: "Pod", APIVersion: "v1"}}
+	denied := []string{"clusters.management.cattle.io/v3", "secrets/v1"}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{format: "json", want: []string{`"items"`, `"skippedDueToPermissions"`, `"count": 2`, `"secrets/v1"`}},
+		{format: "yaml", want: []string{"items:", "skippedDueToPermissions:", "count: 2", "- secrets/v1"}},
+		{format: "table", want: []string{"pod-1", "Skipped due to permissions: 2 resource types", "clusters.management.cattle.io/v3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			out, err := formatAllResources(items, denied, tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+		})
+	}
+
+	t.Run("same shape without denials", func(t *testing.T) {
+		for _, format := range []string{"json", "yaml"} {
+			out, err := formatAllResources(items, nil, format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var result allResourcesResult
+			if format == "json" {
+				err = json.Unmarshal([]byte(out), &result)
+			} else {
+				err = yaml.Unmarshal([]byte(out), &result)
+			}
+			if err != nil {
+				t.Fatalf("%s output is not an object: %v\n%s", format, err, out)
+			}
+			if len(result.Items) != 1 || result.SkippedDueToPermissions.Count != 0 || result.SkippedDueToPermissions.Resources == nil {
+				t.Errorf("%s result = %+v", format, result)
+			}
+			if !strings.Contains(out, "skippedDueToPermissions") {
+				t.Errorf("expected the skipped list in %s output:\n%s", format, out)
+			}
+		}
+	})
+}
+
 func TestParseDuration(t *testing.T) {
 	tests := []struct {
 		s    string
@@ -0,0 +1,51 @@
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// parseJSONPath compiles a kubectl-style JSONPath expression. Like kubectl,
+// a bare path such as `.status.podIP` is accepted and wrapped in braces.
+// Missing fields render as empty rather than failing.
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, nil
+	}
+	if !strings.Contains(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+
+	jp := jsonpath.New("jsonPath").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, fmt.Errorf("invalid jsonPath %q: %w", expr, err)
+	}
+	return jp, nil
+}
+
+// formatResourceJSONPath renders the JSONPath expression against a single resource.
+func formatResourceJSONPath(resource *unstructured.Unstructured, jp *jsonpath.JSONPath) (string, error) {
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, resource.Object); err != nil {
+		return "", fmt.Errorf("failed to evaluate jsonPath on %s: %w", resource.GetName(), err)
+	}
+	return buf.String(), nil
+}
+
+// formatResourceListJSONPath renders the JSONPath expression against each item, one line per item.
+func formatResourceListJSONPath(list *unstructured.UnstructuredList, jp *jsonpath.JSONPath) (string, error) {
+	lines := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		line, err := formatResourceJSONPath(&list.Items[i], jp)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), nil
+}
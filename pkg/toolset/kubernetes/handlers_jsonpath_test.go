@@ -0,0 +1,92 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newJSONPathTestPod(name, podIP string) unstructured.Unstructured {
+	pod := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"status":     map[string]interface{}{"phase": "Running"},
+	}}
+	if podIP != "" {
+		_ = unstructured.SetNestedField(pod.Object, podIP, "status", "podIP")
+	}
+	return pod
+}
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "empty", expr: "", wantNil: true},
+		{name: "braced", expr: "{.status.podIP}"},
+		{name: "bare path", expr: ".status.podIP"},
+		{name: "range", expr: "{range .spec.containers[*]}{.name}{end}"},
+		{name: "unclosed", expr: "{.status.podIP", wantErr: true},
+		{name: "bad filter", expr: "{.items[?(@.x==)]}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jp, err := parseJSONPath(tt.expr)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "invalid jsonPath") {
+					t.Fatalf("parseJSONPath(%q) error = %v, want invalid jsonPath error", tt.expr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJSONPath(%q) unexpected error: %v", tt.expr, err)
+			}
+			if (jp == nil) != tt.wantNil {
+				t.Fatalf("parseJSONPath(%q) = %v, wantNil %v", tt.expr, jp, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestFormatResourceJSONPath(t *testing.T) {
+	pod := newJSONPathTestPod("web-1", "10.0.0.5")
+
+	jp, err := parseJSONPath(".status.podIP")
+	if err != nil {
+		t.Fatalf("parseJSONPath() unexpected error: %v", err)
+	}
+	got, err := formatResourceJSONPath(&pod, jp)
+	if err != nil {
+		t.Fatalf("formatResourceJSONPath() unexpected error: %v", err)
+	}
+	if got != "10.0.0.5" {
+		t.Fatalf("formatResourceJSONPath() = %q, want 10.0.0.5", got)
+	}
+}
+
+func TestFormatResourceListJSONPath(t *testing.T) {
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		newJSONPathTestPod("web-1", "10.0.0.5"),
+		newJSONPathTestPod("web-2", ""),
+	}}
+
+	jp, err := parseJSONPath("{.metadata.name} {.status.podIP}")
+	if err != nil {
+		t.Fatalf("parseJSONPath() unexpected error: %v", err)
+	}
+	got, err := formatResourceListJSONPath(list, jp)
+	if err != nil {
+		t.Fatalf("formatResourceListJSONPath() unexpected error: %v", err)
+	}
+	// Missing fields render empty, matching kubectl's default
+	want := "web-1 10.0.0.5\nweb-2 "
+	if got != want {
+		t.Fatalf("formatResourceListJSONPath() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// dataKeyFields are the fields of Secrets and ConfigMaps whose keys name the stored entries.
+var dataKeyFields = []string{"data", "stringData", "binaryData"}
+
+// dataKeys returns the sorted key names of an object's data, stringData and
+// binaryData maps. Values are never read, so the keys can be shown even when
+// the data itself is hidden.
+func dataKeys(obj map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, field := range dataKeyFields {
+		data, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range data {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatAsKeysTable renders NAME, NAMESPACE, KIND, the data key names and AGE
+// of each resource, e.g. to see which keys Secrets hold without their values.
+func formatAsKeysTable(list *unstructured.UnstructuredList) string {
+	if len(list.Items) == 0 {
+		return "No resources found"
+	}
+
+	rows := make([]map[string]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespace := item.GetNamespace()
+		if namespace == "" {
+			namespace = "-"
+		}
+		keys := strings.Join(dataKeys(item.Object), ",")
+		if keys == "" {
+			keys = "<none>"
+		}
+		rows = append(rows, map[string]string{
+			"NAME":      item.GetName(),
+			"NAMESPACE": namespace,
+			"KIND":      item.GetKind(),
+			"KEYS":      keys,
+			"AGE":       resourceAge(item),
+		})
+	}
+	return paramutil.FormatAsTable(rows, []string{"NAME", "NAMESPACE", "KIND", "KEYS", "AGE"})
+}
@@ -0,0 +1,58 @@
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDataKeys(t *testing.T) {
+	obj := map[string]interface{}{
+		"data":       map[string]interface{}{"password": "c2VjcmV0", "username": "YWRtaW4="},
+		"stringData": map[string]interface{}{"token": "abc", "username": "admin"},
+		"binaryData": map[string]interface{}{"cert.der": "AAEC"},
+	}
+	want := []string{"cert.der", "password", "token", "username"}
+	if got := dataKeys(obj); !reflect.DeepEqual(got, want) {
+		t.Errorf("dataKeys() = %v, want %v", got, want)
+	}
+	if got := dataKeys(map[string]interface{}{}); got != nil {
+		t.Errorf("dataKeys() of an empty object = %v, want nil", got)
+	}
+}
+
+func TestFormatAsKeysTable_NeverShowsValues(t *testing.T) {
+	secret := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Secret",
+		"metadata": map[string]interface{}{"name": "creds", "namespace": "default"},
+		"data":     map[string]interface{}{"password": "c2VjcmV0"},
+	}}
+	empty := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Secret",
+		"metadata": map[string]interface{}{"name": "empty", "namespace": "default"},
+	}}
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{secret, empty}}
+
+	// Keys are read the same way whether or not the values were masked first
+	filter, err := paramutil.NewSensitiveDataFilterFromParams(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, l := range []*unstructured.UnstructuredList{list, filter.FilterList(list)} {
+		out := formatAsKeysTable(l)
+		for _, want := range []string{"KEYS", "password", "<none>"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("expected table to contain %q, got:\n%s", want, out)
+			}
+		}
+		for _, unwanted := range []string{"c2VjcmV0", "***"} {
+			if strings.Contains(out, unwanted) {
+				t.Errorf("table must not contain %q, got:\n%s", unwanted, out)
+			}
+		}
+	}
+}
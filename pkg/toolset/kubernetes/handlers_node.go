@@ -0,0 +1,126 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// cordonHandler handles the kubernetes_cordon tool
+func cordonHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	return setNodeSchedulingHandler(ctx, client, params, true)
+}
+
+// uncordonHandler handles the kubernetes_uncordon tool
+func uncordonHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	return setNodeSchedulingHandler(ctx, client, params, false)
+}
+
+func setNodeSchedulingHandler(ctx context.Context, client interface{}, params map[string]interface{}, unschedulable bool) (string, error) {
+	// Check read-only mode
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+		return "", paramutil.ErrReadOnlyMode
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+
+	node, err := setNodeUnschedulable(ctx, steveClient, cluster, name, unschedulable)
+	if err != nil {
+		return "", err
+	}
+
+	return formatNodeSchedulingState(node), nil
+}
+
+// drainHandler handles the kubernetes_drain tool
+func drainHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	// Check read-only mode
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+		return "", paramutil.ErrReadOnlyMode
+	}
+	// Check destructive operations
+	if disableDestructive, ok := params["disableDestructive"].(bool); ok && disableDestructive {
+		return "", paramutil.ErrDestructiveDisabled
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+	opts := steve.DrainOptions{
+		GracePeriodSeconds: paramutil.ExtractOptionalInt64(params, paramutil.ParamGracePeriodSeconds),
+		IgnoreDaemonSets:   paramutil.ExtractBool(params, paramutil.ParamIgnoreDaemonSets, false),
+		DeleteEmptyDir:     paramutil.ExtractBool(params, paramutil.ParamDeleteEmptyDir, false),
+		Force:              paramutil.ExtractBool(params, paramutil.ParamForce, false),
+	}
+	if opts.GracePeriodSeconds != nil && *opts.GracePeriodSeconds < 0 {
+		return "", fmt.Errorf("gracePeriodSeconds must be non-negative, got %d", *opts.GracePeriodSeconds)
+	}
+
+	pods, err := steveClient.ListNodePods(ctx, cluster, name)
+	if err != nil {
+		return "", err
+	}
+
+	// Check for blocking pods before cordoning so a refused drain leaves the node untouched
+	evict, skipped, err := steve.ClassifyPodsForDrain(pods, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := setNodeUnschedulable(ctx, steveClient, cluster, name, true); err != nil {
+		return "", err
+	}
+
+	result := steveClient.DrainNode(ctx, cluster, name, evict, skipped, opts)
+	return result.ToJSON()
+}
+
+// setNodeUnschedulable patches spec.unschedulable on a node and returns the patched node.
+func setNodeUnschedulable(ctx context.Context, steveClient *steve.Client, cluster, name string, unschedulable bool) (*unstructured.Unstructured, error) {
+	patch := fmt.Appendf(nil, `[{"op":"add","path":"/spec/unschedulable","value":%t}]`, unschedulable)
+	node, err := steveClient.PatchResource(ctx, cluster, "node", "", name, types.JSONPatchType, patch)
+	if err != nil {
+		action := "uncordon"
+		if unschedulable {
+			action = "cordon"
+		}
+		return nil, fmt.Errorf("failed to %s node %s: %w", action, name, err)
+	}
+	return node, nil
+}
+
+// formatNodeSchedulingState describes whether a node accepts new pods.
+func formatNodeSchedulingState(node *unstructured.Unstructured) string {
+	unschedulable, _, _ := unstructured.NestedBool(node.Object, "spec", "unschedulable")
+	if unschedulable {
+		return fmt.Sprintf("Node %s is cordoned: SchedulingDisabled (spec.unschedulable=true)", node.GetName())
+	}
+	return fmt.Sprintf("Node %s is uncordoned: scheduling enabled (spec.unschedulable=false)", node.GetName())
+}
@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFormatNodeSchedulingState(t *testing.T) {
+	cordoned := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "node-1"},
+		"spec":     map[string]interface{}{"unschedulable": true},
+	}}
+	if got := formatNodeSchedulingState(cordoned); !strings.Contains(got, "node-1 is cordoned") {
+		t.Errorf("formatNodeSchedulingState(cordoned) = %q", got)
+	}
+
+	schedulable := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "node-1"},
+		"spec":     map[string]interface{}{},
+	}}
+	if got := formatNodeSchedulingState(schedulable); !strings.Contains(got, "node-1 is uncordoned") {
+		t.Errorf("formatNodeSchedulingState(schedulable) = %q", got)
+	}
+}
+
+func TestCordonHandlers_ReadOnlyMode(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":  "c1",
+		"name":     "node-1",
+		"readOnly": true,
+	}
+
+	if _, err := cordonHandler(context.Background(), nil, params); !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Errorf("cordonHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+	if _, err := uncordonHandler(context.Background(), nil, params); !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Errorf("uncordonHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+}
+
+func TestDrainHandler_Guards(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		want   error
+	}{
+		{
+			name:   "read-only",
+			params: map[string]interface{}{"cluster": "c1", "name": "node-1", "readOnly": true},
+			want:   paramutil.ErrReadOnlyMode,
+		},
+		{
+			name:   "destructive disabled",
+			params: map[string]interface{}{"cluster": "c1", "name": "node-1", "disableDestructive": true},
+			want:   paramutil.ErrDestructiveDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := drainHandler(context.Background(), nil, tt.params); !errors.Is(err, tt.want) {
+				t.Fatalf("drainHandler() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/dep"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ResourceOwner is an object named in another object's ownerReferences.
+type ResourceOwner struct {
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string `json:"kind" yaml:"kind"`
+	Name       string `json:"name" yaml:"name"`
+	// Namespace is empty for cluster-scoped owners.
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Controller bool   `json:"controller" yaml:"controller"`
+	Ready      string `json:"ready,omitempty" yaml:"ready,omitempty"`
+	Status     string `json:"status,omitempty" yaml:"status,omitempty"`
+	// Error says why the owner could not be resolved; the fields above then
+	// come from the ownerReference alone.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// resolveOwners fetches each owner named in obj's ownerReferences. An owner is
+// looked up in obj's namespace first and then as a cluster-scoped object, as
+// namespaced objects may be owned by either. Owners that are missing, in
+// another namespace or recreated under a different UID are reported with an
+// Error, as the garbage collector treats them as absent too.
+func resolveOwners(ctx context.Context, client steve.ResourceReader, cluster string, obj *unstructured.Unstructured) []ResourceOwner {
+	refs := obj.GetOwnerReferences()
+	owners := make([]ResourceOwner, 0, len(refs))
+	for _, ref := range refs {
+		owner := ResourceOwner{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			Controller: ref.Controller != nil && *ref.Controller,
+		}
+
+		kind := steve.KindWithAPIVersion(ref.APIVersion, ref.Kind)
+		found, err := client.GetResource(ctx, cluster, kind, obj.GetNamespace(), ref.Name)
+		if err != nil && obj.GetNamespace() != "" {
+			if clusterScoped, clusterErr := client.GetResource(ctx, cluster, kind, "", ref.Name); clusterErr == nil {
+				found, err = clusterScoped, nil
+			}
+		}
+		switch {
+		case err != nil:
+			owner.Error = err.Error()
+		case found.GetNamespace() != "" && found.GetNamespace() != obj.GetNamespace():
+			owner.Error = fmt.Sprintf("owner is in namespace %s, not %s", found.GetNamespace(), obj.GetNamespace())
+		case ref.UID != "" && found.GetUID() != ref.UID:
+			owner.Error = fmt.Sprintf("owner UID is %s, not %s: it was recreated", found.GetUID(), ref.UID)
+		default:
+			owner.Namespace = found.GetNamespace()
+			owner.Ready = dep.ResourceReady(found.GetKind(), found.Object)
+			owner.Status = dep.ResourceStatus(found.GetKind(), found.Object)
+		}
+		owners = append(owners, owner)
+	}
+	return owners
+}
+
+// ownedResourceResult is the json and yaml output of a single-name
+// kubernetes_get with includeOwners. The owners sit beside the resource, which
+// is left as the API server returned it. UndecodedData lists the Secret keys
+// decodeSecretData left encoded and is omitted when there are none.
+type ownedResourceResult struct {
+	Resource      map[string]interface{} `json:"resource" yaml:"resource"`
+	Owners        []ResourceOwner        `json:"owners" yaml:"owners"`
+	UndecodedData []UndecodedSecretKey   `json:"undecodedData,omitempty" yaml:"undecodedData,omitempty"`
+}
+
+// formatOwnedResource renders a resource and its owners as a JSON or YAML
+// object.
+func formatOwnedResource(resource *unstructured.Unstructured, owners []ResourceOwner, undecoded []UndecodedSecretKey, format string, filter *paramutil.ResourceFilter) (string, error) {
+	if filter != nil {
+		resource = filter.Filter(resource)
+	}
+	result := ownedResourceResult{Resource: resource.Object, Owners: owners, UndecodedData: undecoded}
+	if result.Owners == nil {
+		result.Owners = []ResourceOwner{}
+	}
+	return formatStructured(result, format)
+}
+
+// formatOwners returns a trailing note listing the owners of each named
+// resource, or an empty string when owners were not requested. It is only
+// used for text output; json and yaml carry the owners beside the resources.
+func formatOwners(names []string, owners map[string][]ResourceOwner) string {
+	if owners == nil {
+		return ""
+	}
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		resourceOwners := owners[name]
+		if len(resourceOwners) == 0 {
+			lines = append(lines, name+": none")
+			continue
+		}
+		for _, o := range resourceOwners {
+			lines = append(lines, fmt.Sprintf("%s: %s/%s%s", name, o.Kind, o.Name, describeOwner(o)))
+		}
+	}
+	return "\n\nOwners:\n  " + strings.Join(lines, "\n  ")
+}
+
+// describeOwner renders the controller flag, readiness, status and error of
+// an owner as a parenthesized suffix, or an empty string when none are set.
+func describeOwner(o ResourceOwner) string {
+	var parts []string
+	if o.Controller {
+		parts = append(parts, "controller")
+	}
+	if o.Ready != "" {
+		parts = append(parts, "ready "+o.Ready)
+	}
+	if o.Status != "" {
+		parts = append(parts, o.Status)
+	}
+	if o.Error != "" {
+		parts = append(parts, "error: "+o.Error)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
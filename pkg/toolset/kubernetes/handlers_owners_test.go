@@ -0,0 +1,145 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"gopkg.in/yaml.v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func makeOwnerTestObject(kind, namespace, name, uid string, status map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name": name,
+			"uid":  uid,
+		},
+	}}
+	if namespace != "" {
+		obj.SetNamespace(namespace)
+	}
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
+func TestResolveOwners(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeOwnerTestObject("ReplicaSet", "default", "web-abc", "rs-uid", map[string]interface{}{
+		"replicas":      int64(3),
+		"readyReplicas": int64(2),
+	}))
+	client.AddResource(makeOwnerTestObject("Node", "", "node-1", "node-uid", nil))
+	client.AddResource(makeOwnerTestObject("ConfigMap", "other", "elsewhere", "cm-uid", nil))
+	client.AddResource(makeOwnerTestObject("Secret", "default", "recreated", "new-uid", nil))
+
+	controller := true
+	pod := makeOwnerTestObject("Pod", "default", "web-abc-1", "pod-uid", nil)
+	pod.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", UID: types.UID("rs-uid"), Controller: &controller},
+		{APIVersion: "v1", Kind: "Node", Name: "node-1", UID: types.UID("node-uid")},
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "elsewhere", UID: types.UID("cm-uid")},
+		{APIVersion: "v1", Kind: "Secret", Name: "recreated", UID: types.UID("old-uid")},
+		{APIVersion: "v1", Kind: "Service", Name: "missing"},
+	})
+
+	owners := resolveOwners(context.Background(), client, "c1", pod)
+	if len(owners) != 5 {
+		t.Fatalf("expected 5 owners, got %d: %+v", len(owners), owners)
+	}
+
+	rs := owners[0]
+	if !rs.Controller || rs.Namespace != "default" || rs.Ready != "2/3" || rs.Error != "" {
+		t.Errorf("unexpected controller owner: %+v", rs)
+	}
+	node := owners[1]
+	if node.Controller || node.Namespace != "" || node.Error != "" {
+		t.Errorf("expected a resolved cluster-scoped owner, got %+v", node)
+	}
+	if !strings.Contains(owners[2].Error, "namespace other") {
+		t.Errorf("expected cross-namespace owner to be rejected, got %+v", owners[2])
+	}
+	if !strings.Contains(owners[3].Error, "recreated") {
+		t.Errorf("expected UID mismatch to be reported, got %+v", owners[3])
+	}
+	if owners[4].Error == "" || owners[4].Name != "missing" {
+		t.Errorf("expected missing owner to be reported, got %+v", owners[4])
+	}
+}
+
+func TestFormatOwnedResource(t *testing.T) {
+	pod := makeOwnerTestObject("Pod", "default", "web", "pod-uid", nil)
+	owners := []ResourceOwner{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-abc", Namespace: "default", Controller: true, Ready: "1/1"},
+		{APIVersion: "v1", Kind: "Service", Name: "missing", Error: "not found"},
+	}
+
+	for _, format := range []string{paramutil.FormatJSON, paramutil.FormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			out, err := formatOwnedResource(pod, owners, nil, format, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var result struct {
+				Resource      map[string]interface{}   `json:"resource" yaml:"resource"`
+				Owners        []map[string]interface{} `json:"owners" yaml:"owners"`
+				UndecodedData []UndecodedSecretKey     `json:"undecodedData" yaml:"undecodedData"`
+			}
+			if format == paramutil.FormatJSON {
+				err = json.Unmarshal([]byte(out), &result)
+			} else {
+				err = yaml.Unmarshal([]byte(out), &result)
+			}
+			if err != nil {
+				t.Fatalf("failed to parse output: %v\n%s", err, out)
+			}
+			if _, ok := result.Resource["owners"]; ok {
+				t.Error("expected the resource to be left without an owners field")
+			}
+			if result.Resource["kind"] != "Pod" || len(result.Owners) != 2 {
+				t.Fatalf("unexpected result: %s", out)
+			}
+			if first := result.Owners[0]; first["apiVersion"] != "apps/v1" || first["controller"] != true || first["ready"] != "1/1" {
+				t.Errorf("unexpected first owner: %v", first)
+			}
+			if second := result.Owners[1]; second["namespace"] != nil || second["error"] != "not found" {
+				t.Errorf("expected empty fields to be omitted, got %v", second)
+			}
+			if strings.Contains(out, "undecodedData") {
+				t.Errorf("expected undecodedData to be omitted without decoded Secrets:\n%s", out)
+			}
+		})
+	}
+
+	out, err := formatOwnedResource(pod, nil, nil, paramutil.FormatJSON, nil)
+	if err != nil || !strings.Contains(out, `"owners": []`) {
+		t.Errorf("expected an empty owners array, got %q (err=%v)", out, err)
+	}
+}
+
+func TestFormatOwners(t *testing.T) {
+	if got := formatOwners([]string{"web"}, nil); got != "" {
+		t.Errorf("expected no note without includeOwners, got %q", got)
+	}
+
+	got := formatOwners([]string{"web", "db"}, map[string][]ResourceOwner{
+		"web": {
+			{Kind: "ReplicaSet", Name: "web-abc", Controller: true, Ready: "1/1"},
+			{Kind: "Service", Name: "missing", Error: "not found"},
+		},
+	})
+	want := "\n\nOwners:\n  web: ReplicaSet/web-abc (controller, ready 1/1)\n  web: Service/missing (error: not found)\n  db: none"
+	if got != want {
+		t.Errorf("formatOwners() = %q, want %q", got, want)
+	}
+}
@@ -3,9 +3,11 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
@@ -18,6 +20,8 @@ type LogEntry struct {
 	Content   string
 	Pod       string
 	Container string
+	// Type is the kind of container that wrote the line.
+	Type steve.ContainerType
 }
 
 // multiPodLogClient is the subset of *steve.Client used by getMultiPodLogs.
@@ -52,11 +56,27 @@ func logsHandler(ctx context.Context, client interface{}, params map[string]inte
 	sinceSeconds := paramutil.ExtractOptionalInt64(params, paramutil.ParamSinceSeconds)
 	timestamps := paramutil.ExtractBool(params, paramutil.ParamTimestamps, false)
 	previous := paramutil.ExtractBool(params, paramutil.ParamPrevious, false)
+	includeInit := paramutil.ExtractBool(params, paramutil.ParamIncludeInitContainers, false)
 	keyword := paramutil.ExtractOptionalString(params, paramutil.ParamKeyword)
+	keywordRegex := paramutil.ExtractBool(params, paramutil.ParamKeywordRegex, false)
+	excludeKeyword := paramutil.ExtractOptionalString(params, paramutil.ParamExcludeKeyword)
+	lineFilter, err := newLogLineFilter(keyword, keywordRegex, excludeKeyword)
+	if err != nil {
+		return "", err
+	}
+	maxConcurrency := paramutil.ExtractInt64(params, paramutil.ParamMaxConcurrency, steve.DefaultMultiPodLogConcurrency)
+	maxBytes := paramutil.ExtractInt64(params, paramutil.ParamMaxBytes, DefaultLogMaxBytes)
+	if maxBytes < 0 {
+		return "", fmt.Errorf("maxBytes must be non-negative, got %d", maxBytes)
+	}
 
 	// If labelSelector is provided, get logs from multiple pods
 	if labelSelector != "" {
-		return getMultiPodLogs(ctx, steveClient, cluster, namespace, labelSelector, container, tailLines, sinceSeconds, previous, keyword, timestamps)
+		logs, err := getMultiPodLogs(ctx, steveClient, cluster, namespace, labelSelector, container, tailLines, sinceSeconds, previous, includeInit, maxConcurrency, lineFilter, timestamps)
+		if err != nil {
+			return "", err
+		}
+		return truncateLogOutput(logs, maxBytes), nil
 	}
 
 	// If name is not provided and no labelSelector, return error
@@ -65,7 +85,7 @@ func logsHandler(ctx context.Context, client interface{}, params map[string]inte
 	}
 
 	if container != "" {
-		// Get logs for specific container
+		// Get logs for specific container; the log API accepts init container names too
 		opts := &steve.PodLogOptions{
 			Container:    container,
 			TailLines:    &tailLines,
@@ -77,28 +97,37 @@ func logsHandler(ctx context.Context, client interface{}, params map[string]inte
 		if err != nil {
 			return "", fmt.Errorf("failed to get pod logs: %w", err)
 		}
-		logs = filterLogsByKeyword(logs, keyword)
+		logs = filterLogsByKeyword(logs, lineFilter)
 		// Sort logs by timestamp when timestamps are enabled
 		logs = sortLogsByTime(logs, timestamps)
-		return logs, nil
+		return truncateLogOutput(logs, maxBytes), nil
 	}
 
-	return getAllContainerLogs(ctx, steveClient, cluster, namespace, name, &steve.PodLogOptions{
-		TailLines:    &tailLines,
-		SinceSeconds: sinceSeconds,
-		Timestamps:   timestamps,
-		Previous:     previous,
-	}, keyword)
+	logs, err := getAllContainerLogs(ctx, steveClient, cluster, namespace, name, &steve.PodLogOptions{
+		TailLines:             &tailLines,
+		SinceSeconds:          sinceSeconds,
+		Timestamps:            timestamps,
+		Previous:              previous,
+		IncludeInitContainers: includeInit,
+	}, lineFilter)
+	if err != nil {
+		return "", err
+	}
+	return truncateLogOutput(logs, maxBytes), nil
 }
 
 // getMultiPodLogs retrieves and merges logs from multiple pods matching the label selector
-// Logs are sorted by timestamp when timestamps is true.
-func getMultiPodLogs(ctx context.Context, client multiPodLogClient, cluster, namespace, labelSelector, container string, tailLines int64, sinceSeconds *int64, previous bool, keyword string, timestamps bool) (string, error) {
+// Logs are sorted by timestamp when timestamps is true. maxConcurrency is
+// clamped to 1..MaxLogConcurrency.
+func getMultiPodLogs(ctx context.Context, client multiPodLogClient, cluster, namespace, labelSelector, container string, tailLines int64, sinceSeconds *int64, previous, includeInit bool, maxConcurrency int64, lineFilter *logLineFilter, timestamps bool) (string, error) {
 	opts := &steve.PodLogOptions{
-		TailLines:    &tailLines,
-		SinceSeconds: sinceSeconds,
-		Timestamps:   timestamps,
-		Previous:     previous,
+		Container:             container,
+		TailLines:             &tailLines,
+		SinceSeconds:          sinceSeconds,
+		Timestamps:            timestamps,
+		Previous:              previous,
+		IncludeInitContainers: includeInit,
+		MaxConcurrency:        int(max(1, min(maxConcurrency, MaxLogConcurrency))),
 	}
 
 	results, err := client.GetMultiPodLogs(ctx, cluster, namespace, labelSelector, opts)
@@ -114,62 +143,49 @@ func getMultiPodLogs(ctx context.Context, client multiPodLogClient, cluster, nam
 	var allEntries []LogEntry
 
 	for _, result := range results {
-		// If a specific container is requested, filter to that container only
-		if container != "" {
-			if containerLogs, ok := result.Logs[container]; ok {
-				filteredLogs := filterLogsByKeyword(containerLogs, keyword)
-				lines := strings.Split(filteredLogs, "\n")
-				for _, line := range lines {
-					if line == "" {
-						continue
-					}
-					ts, content := parseLogTimestamp(line)
-					allEntries = append(allEntries, LogEntry{
-						Timestamp: ts,
-						Content:   content,
-						Pod:       result.Pod,
-						Container: container,
-					})
-				}
-			}
-		} else {
-			// Process all containers in this pod
-			for containerName, containerLogs := range result.Logs {
-				filteredLogs := containerLogs
-				if keyword != "" {
-					filteredLogs = filterLogsByKeyword(containerLogs, keyword)
-				}
-				lines := strings.Split(filteredLogs, "\n")
-				for _, line := range lines {
-					if line == "" {
-						continue
-					}
-					ts, content := parseLogTimestamp(line)
-					allEntries = append(allEntries, LogEntry{
-						Timestamp: ts,
-						Content:   content,
-						Pod:       result.Pod,
-						Container: containerName,
-					})
-				}
+		for key, containerLogs := range result.Logs {
+			// If a specific container is requested, filter to that container only
+			if name, _ := steve.SplitContainerLogKey(key); container != "" && name != container {
+				continue
 			}
+			allEntries = appendLogEntries(allEntries, result.Pod, key, containerLogs, lineFilter)
 		}
 	}
 
+	note := formatMultiPodLogErrors(results)
+
 	if len(allEntries) == 0 {
-		return "No log entries found", nil
+		if lineFilter != nil {
+			return lineFilter.emptyMessage() + note, nil
+		}
+		return "No log entries found" + note, nil
 	}
 
 	return formatLogEntries(allEntries, timestamps, func(entry LogEntry) string {
 		if timestamps && !entry.Timestamp.IsZero() {
-			return fmt.Sprintf("[%s/%s] %s", entry.Pod, entry.Container, formatTimestampedContent(entry.Timestamp, entry.Content))
+			return fmt.Sprintf("[%s/%s]%s %s", entry.Pod, entry.Container, containerTypeMarker(entry), formatTimestampedContent(entry.Timestamp, entry.Content))
 		}
-		return fmt.Sprintf("[%s/%s] %s", entry.Pod, entry.Container, entry.Content)
-	}), nil
+		return fmt.Sprintf("[%s/%s]%s %s", entry.Pod, entry.Container, containerTypeMarker(entry), entry.Content)
+	}) + note, nil
+}
+
+// formatMultiPodLogErrors returns a trailing note listing pods whose logs could
+// not be fetched, or an empty string when every pod succeeded.
+func formatMultiPodLogErrors(results []steve.MultiPodLogResult) string {
+	var failed []string
+	for _, result := range results {
+		if result.Error != "" {
+			failed = append(failed, fmt.Sprintf("%s: %s", result.Pod, result.Error))
+		}
+	}
+	if len(failed) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\nNote: failed to get logs from %d of %d pods:\n  %s", len(failed), len(results), strings.Join(failed, "\n  "))
 }
 
 // getAllContainerLogs retrieves and formats logs from all containers in a pod.
-func getAllContainerLogs(ctx context.Context, client allContainerLogClient, cluster, namespace, name string, opts *steve.PodLogOptions, keyword string) (string, error) {
+func getAllContainerLogs(ctx context.Context, client allContainerLogClient, cluster, namespace, name string, opts *steve.PodLogOptions, lineFilter *logLineFilter) (string, error) {
 	logs, err := client.GetAllContainerLogs(ctx, cluster, namespace, name, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to get pod logs: %w", err)
@@ -181,37 +197,97 @@ func getAllContainerLogs(ctx context.Context, client allContainerLogClient, clus
 	}
 
 	var allEntries []LogEntry
-	for containerName, containerLogs := range logs {
-		filteredLogs := filterLogsByKeyword(containerLogs, keyword)
-		lines := strings.Split(filteredLogs, "\n")
-		for _, line := range lines {
-			if line == "" {
-				continue
-			}
-			ts, content := parseLogTimestamp(line)
-			allEntries = append(allEntries, LogEntry{
-				Timestamp: ts,
-				Content:   content,
-				Container: containerName,
-			})
-		}
+	for key, containerLogs := range logs {
+		allEntries = appendLogEntries(allEntries, "", key, containerLogs, lineFilter)
+	}
+	if len(allEntries) == 0 && lineFilter != nil {
+		return lineFilter.emptyMessage(), nil
 	}
 
 	return formatLogEntries(allEntries, timestamps, func(entry LogEntry) string {
 		if timestamps && !entry.Timestamp.IsZero() {
-			return fmt.Sprintf("[%s] %s", entry.Container, formatTimestampedContent(entry.Timestamp, entry.Content))
+			return fmt.Sprintf("[%s]%s %s", entry.Container, containerTypeMarker(entry), formatTimestampedContent(entry.Timestamp, entry.Content))
 		}
-		return fmt.Sprintf("[%s] %s", entry.Container, entry.Content)
+		return fmt.Sprintf("[%s]%s %s", entry.Container, containerTypeMarker(entry), entry.Content)
 	}), nil
 }
 
+// appendLogEntries filters and parses the logs of one container, keyed as in
+// steve.Client.GetAllContainerLogs, and appends a LogEntry per line.
+func appendLogEntries(entries []LogEntry, pod, key, logs string, lineFilter *logLineFilter) []LogEntry {
+	container, containerType := steve.SplitContainerLogKey(key)
+	for _, line := range filterLogLines(logs, lineFilter) {
+		if line == "" {
+			continue
+		}
+		ts, content := parseLogTimestamp(line)
+		entries = append(entries, LogEntry{
+			Timestamp: ts,
+			Content:   content,
+			Pod:       pod,
+			Container: container,
+			Type:      containerType,
+		})
+	}
+	return entries
+}
+
+// containerTypeMarker labels lines written by init and ephemeral containers in merged output.
+func containerTypeMarker(entry LogEntry) string {
+	if entry.Type == steve.ContainerTypeApp {
+		return ""
+	}
+	return " [" + string(entry.Type) + "]"
+}
+
+// containerStartOrder ranks container types in the order a pod starts them.
+func containerStartOrder(t steve.ContainerType) int {
+	switch t {
+	case steve.ContainerTypeInit:
+		return 0
+	case steve.ContainerTypeEphemeral:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// truncateLogOutput keeps at most the last maxBytes bytes of logs, dropping any
+// leading partial line, and appends a marker with the number of bytes removed.
+// A maxBytes of 0 disables truncation.
+func truncateLogOutput(logs string, maxBytes int64) string {
+	if maxBytes <= 0 || int64(len(logs)) <= maxBytes {
+		return logs
+	}
+
+	start := len(logs) - int(maxBytes)
+	// Never start inside a multi-byte UTF-8 sequence
+	for start < len(logs) && !utf8.RuneStart(logs[start]) {
+		start++
+	}
+	kept := logs[start:]
+	// Keep whole lines; if the tail is a single oversized line, keep it as is.
+	if logs[start-1] != '\n' {
+		if idx := strings.IndexByte(kept, '\n'); idx >= 0 && idx < len(kept)-1 {
+			kept = kept[idx+1:]
+		}
+	}
+
+	return fmt.Sprintf("%s\n...[truncated %d bytes]", kept, len(logs)-len(kept))
+}
+
 // formatLogEntries sorts log entries by timestamp and formats them.
 func formatLogEntries(entries []LogEntry, timestamps bool, formatEntry func(LogEntry) string) string {
-	sort.Slice(entries, func(i, j int) bool {
+	// Stable so lines of one container keep their order when timestamps are absent.
+	sort.SliceStable(entries, func(i, j int) bool {
 		if entries[i].Timestamp.IsZero() || entries[j].Timestamp.IsZero() {
 			if entries[i].Pod != entries[j].Pod {
 				return entries[i].Pod < entries[j].Pod
 			}
+			// Init containers run before app containers, ephemeral ones are attached later.
+			if entries[i].Type != entries[j].Type {
+				return containerStartOrder(entries[i].Type) < containerStartOrder(entries[j].Type)
+			}
 			return entries[i].Container < entries[j].Container
 		}
 		return entries[i].Timestamp.Before(entries[j].Timestamp)
@@ -224,22 +300,93 @@ func formatLogEntries(entries []LogEntry, timestamps bool, formatEntry func(LogE
 	return strings.Join(resultLines, "\n")
 }
 
-// filterLogsByKeyword filters log lines by keyword (case-insensitive).
-// Returns the original logs if keyword is empty.
-func filterLogsByKeyword(logs, keyword string) string {
-	if keyword == "" {
-		return logs
+// logLineFilter matches log lines against an include keyword, either as a
+// case-insensitive substring or as a regular expression, and drops lines
+// containing an exclude keyword (case-insensitive substring). Regular
+// expressions match case-sensitively unless they start with (?i).
+type logLineFilter struct {
+	keyword      string
+	keywordLower string
+	pattern      *regexp.Regexp
+	excludeLower string
+}
+
+// newLogLineFilter builds a filter for keyword and excludeKeyword. Returns nil
+// when both are empty. When useRegex is true, keyword must be a valid regular
+// expression.
+func newLogLineFilter(keyword string, useRegex bool, excludeKeyword string) (*logLineFilter, error) {
+	if keyword == "" && excludeKeyword == "" {
+		return nil, nil
+	}
+	filter := &logLineFilter{
+		keyword:      keyword,
+		keywordLower: strings.ToLower(keyword),
+		excludeLower: strings.ToLower(excludeKeyword),
+	}
+	if useRegex && keyword != "" {
+		pattern, err := regexp.Compile(keyword)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyword regex %q: %w", keyword, err)
+		}
+		filter.pattern = pattern
 	}
-	keywordLower := strings.ToLower(keyword)
+	return filter, nil
+}
+
+// match reports whether line passes the filter: include first, then exclude.
+func (f *logLineFilter) match(line string) bool {
+	switch {
+	case f.pattern != nil:
+		if !f.pattern.MatchString(line) {
+			return false
+		}
+	case f.keywordLower != "":
+		if !strings.Contains(strings.ToLower(line), f.keywordLower) {
+			return false
+		}
+	}
+	if f.excludeLower != "" && strings.Contains(strings.ToLower(line), f.excludeLower) {
+		return false
+	}
+	return true
+}
+
+// emptyMessage describes a result in which no log line passed the filter.
+func (f *logLineFilter) emptyMessage() string {
+	if f.excludeLower != "" {
+		return "No log lines after filters"
+	}
+	if f.pattern != nil {
+		return fmt.Sprintf("No log lines matching pattern %q", f.keyword)
+	}
+	return fmt.Sprintf("No log lines matching keyword %q", f.keyword)
+}
+
+// filterLogLines splits logs into lines and keeps those that pass the filter.
+// Every line is kept if filter is nil.
+func filterLogLines(logs string, filter *logLineFilter) []string {
 	lines := strings.Split(logs, "\n")
+	if filter == nil {
+		return lines
+	}
 	var filtered []string
 	for _, line := range lines {
-		if strings.Contains(strings.ToLower(line), keywordLower) {
+		if filter.match(line) {
 			filtered = append(filtered, line)
 		}
 	}
+	return filtered
+}
+
+// filterLogsByKeyword keeps log lines that pass the filter, or returns the
+// filter's empty message when none do. Returns the original logs if filter is nil.
+func filterLogsByKeyword(logs string, filter *logLineFilter) string {
+	if filter == nil {
+		return logs
+	}
+	filtered := filterLogLines(logs, filter)
 	if len(filtered) == 0 {
-		return fmt.Sprintf("No log lines matching keyword %q", keyword)
+		return filter.emptyMessage()
 	}
 	return strings.Join(filtered, "\n")
 }
@@ -331,7 +478,11 @@ func inspectPodHandler(ctx context.Context, client interface{}, params map[strin
 		return "", err
 	}
 
-	result, err := steveClient.InspectPod(ctx, cluster, namespace, name)
+	maxEvents := paramutil.ExtractInt64(params, paramutil.ParamMaxEvents, DefaultPodInspectEvents)
+
+	result, err := steveClient.InspectPod(ctx, cluster, namespace, name, &steve.InspectPodOptions{
+		MaxEvents: int(maxEvents),
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to inspect pod: %w", err)
 	}
@@ -2,9 +2,11 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 )
@@ -41,7 +43,7 @@ func TestGetMultiPodLogs_PropagatesOptions(t *testing.T) {
 		},
 	}
 
-	_, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, &since, true, "", false)
+	_, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, &since, true, false, 4, nil, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -54,6 +56,32 @@ func TestGetMultiPodLogs_PropagatesOptions(t *testing.T) {
 	if !client.opts.Previous {
 		t.Error("expected Previous to be true")
 	}
+	if client.opts.MaxConcurrency != 4 {
+		t.Errorf("MaxConcurrency = %d, want 4", client.opts.MaxConcurrency)
+	}
+}
+
+func TestGetMultiPodLogs_ClampsMaxConcurrency(t *testing.T) {
+	tests := []struct {
+		maxConcurrency int64
+		want           int
+	}{
+		{maxConcurrency: 0, want: 1},
+		{maxConcurrency: -3, want: 1},
+		{maxConcurrency: steve.DefaultMultiPodLogConcurrency, want: steve.DefaultMultiPodLogConcurrency},
+		{maxConcurrency: 1000, want: MaxLogConcurrency},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.maxConcurrency), func(t *testing.T) {
+			client := &mockMultiPodLogClient{}
+			if _, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, nil, false, false, tt.maxConcurrency, nil, false); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if client.opts.MaxConcurrency != tt.want {
+				t.Errorf("MaxConcurrency = %d, want %d", client.opts.MaxConcurrency, tt.want)
+			}
+		})
+	}
 }
 
 func TestGetAllContainerLogs_PropagatesOptions(t *testing.T) {
@@ -66,7 +94,7 @@ func TestGetAllContainerLogs_PropagatesOptions(t *testing.T) {
 		TailLines:    int64Ptr(50),
 		SinceSeconds: &since,
 		Previous:     true,
-	}, "")
+	}, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -86,7 +114,7 @@ func TestGetAllContainerLogs_HappyPath(t *testing.T) {
 		logs: map[string]string{"app": "line1\nline2"},
 	}
 
-	out, err := getAllContainerLogs(context.Background(), client, "c1", "ns", "pod-1", &steve.PodLogOptions{TailLines: int64Ptr(10)}, "")
+	out, err := getAllContainerLogs(context.Background(), client, "c1", "ns", "pod-1", &steve.PodLogOptions{TailLines: int64Ptr(10)}, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -130,12 +158,60 @@ func TestParseLogTimestamp(t *testing.T) {
 			wantTimestamp: "2024-01-15T10:30:00Z",
 			wantContent:   "",
 		},
+		{
+			name:          "variable nanosecond precision",
+			line:          "2024-01-15T10:30:00.1Z short fraction",
+			wantTimestamp: "2024-01-15T10:30:00.1Z",
+			wantContent:   "short fraction",
+		},
+		{
+			name:          "zero nanoseconds",
+			line:          "2024-01-15T10:30:00.000000000Z zero nanos",
+			wantTimestamp: "2024-01-15T10:30:00Z",
+			wantContent:   "zero nanos",
+		},
+		{
+			name:          "offset timezone",
+			line:          "2024-01-15T18:30:00.5+08:00 offset message",
+			wantTimestamp: "2024-01-15T10:30:00.5Z",
+			wantContent:   "offset message",
+		},
+		{
+			name:          "negative offset without fraction",
+			line:          "2024-01-15T05:30:00-05:00 offset message",
+			wantTimestamp: "2024-01-15T10:30:00Z",
+			wantContent:   "offset message",
+		},
+		{
+			name:          "content keeps extra spaces",
+			line:          "2024-01-15T10:30:00Z  indented  message",
+			wantTimestamp: "2024-01-15T10:30:00Z",
+			wantContent:   " indented  message",
+		},
+		{
+			name:        "zoneless timestamp is not parsed",
+			line:        "2024-01-15T10:30:00 message",
+			wantContent: "2024-01-15T10:30:00 message",
+			wantZero:    true,
+		},
+		{
+			name:        "date only is not parsed",
+			line:        "2024-01-15 message",
+			wantContent: "2024-01-15 message",
+			wantZero:    true,
+		},
 		{
 			name:        "not timestamped",
 			line:        "plain log message",
 			wantContent: "plain log message",
 			wantZero:    true,
 		},
+		{
+			name:        "empty line",
+			line:        "",
+			wantContent: "",
+			wantZero:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -189,3 +265,214 @@ func TestFormatTimestampedContent(t *testing.T) {
 		t.Fatalf("formatTimestampedContent() = %q, want timestamp without trailing space", got)
 	}
 }
+
+func TestGetMultiPodLogs_ReportsPartialFailures(t *testing.T) {
+	client := &mockMultiPodLogClient{
+		results: []steve.MultiPodLogResult{
+			{Pod: "pod-1", Logs: map[string]string{"app": "hello"}},
+			{Pod: "pod-2", Error: "pods \"pod-2\" not found"},
+		},
+	}
+
+	out, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, nil, false, false, 0, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "[pod-1/app] hello") {
+		t.Errorf("expected successful pod logs, got:\n%s", out)
+	}
+	if !strings.Contains(out, "failed to get logs from 1 of 2 pods") || !strings.Contains(out, "pod-2") {
+		t.Errorf("expected partial failure note, got:\n%s", out)
+	}
+}
+
+func TestTruncateLogOutput(t *testing.T) {
+	logs := "line-1\nline-2\nline-3\nline-4"
+
+	tests := []struct {
+		name     string
+		maxBytes int64
+		want     string
+	}{
+		{name: "unlimited", maxBytes: 0, want: logs},
+		{name: "fits", maxBytes: int64(len(logs)), want: logs},
+		{name: "drops partial line", maxBytes: 9, want: "line-4\n...[truncated 21 bytes]"},
+		{name: "cut on line boundary", maxBytes: 13, want: "line-3\nline-4\n...[truncated 14 bytes]"},
+		{name: "single oversized line", maxBytes: 3, want: "e-4\n...[truncated 24 bytes]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateLogOutput(logs, tt.maxBytes); got != tt.want {
+				t.Errorf("truncateLogOutput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateLogOutput_Multibyte(t *testing.T) {
+	// Each character is 3 bytes, so the last 4 bytes begin inside a character
+	logs := "日志输出"
+	got := truncateLogOutput(logs, 4)
+	if want := "出\n...[truncated 9 bytes]"; got != want {
+		t.Errorf("truncateLogOutput() = %q, want %q", got, want)
+	}
+	if !utf8.ValidString(got) {
+		t.Errorf("truncateLogOutput() = %q is not valid UTF-8", got)
+	}
+}
+
+func TestFilterLogsByKeyword(t *testing.T) {
+	logs := "INFO started\nERROR db timeout\nWARN slow\nFATAL request timeout after 30s"
+
+	tests := []struct {
+		name     string
+		keyword  string
+		useRegex bool
+		exclude  string
+		want     string
+	}{
+		{name: "no keyword", keyword: "", want: logs},
+		{name: "substring case-insensitive", keyword: "error", want: "ERROR db timeout"},
+		{name: "regex", keyword: "(ERROR|FATAL).*timeout", useRegex: true, want: "ERROR db timeout\nFATAL request timeout after 30s"},
+		{name: "regex metacharacters literal without flag", keyword: "(error|fatal)", want: `No log lines matching keyword "(error|fatal)"`},
+		{name: "regex no match", keyword: "^DEBUG", useRegex: true, want: `No log lines matching pattern "^DEBUG"`},
+		{name: "regex is case-sensitive", keyword: "^error", useRegex: true, want: `No log lines matching pattern "^error"`},
+		{name: "regex with (?i) ignores case", keyword: "(?i)^error", useRegex: true, want: "ERROR db timeout"},
+		{name: "exclude only", exclude: "timeout", want: "INFO started\nWARN slow"},
+		{name: "include then exclude", keyword: "timeout", exclude: "fatal", want: "ERROR db timeout"},
+		{name: "regex include then exclude", keyword: "timeout$", useRegex: true, exclude: "db", want: `No log lines after filters`},
+		{name: "filters remove everything", keyword: "warn", exclude: "slow", want: "No log lines after filters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newLogLineFilter(tt.keyword, tt.useRegex, tt.exclude)
+			if err != nil {
+				t.Fatalf("newLogLineFilter() unexpected error: %v", err)
+			}
+			if got := filterLogsByKeyword(logs, filter); got != tt.want {
+				t.Errorf("filterLogsByKeyword() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMultiPodLogs_ReportsFilteredOutOfBand(t *testing.T) {
+	client := &mockMultiPodLogClient{
+		results: []steve.MultiPodLogResult{
+			{Pod: "pod-a", Logs: map[string]string{"app": "INFO ready"}},
+			{Pod: "pod-b", Logs: map[string]string{"app": "ERROR failed"}},
+		},
+	}
+	filter, _ := newLogLineFilter("error", false, "")
+
+	out, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, nil, false, false, 0, filter, false)
+	if err != nil {
+		t.Fatalf("getMultiPodLogs() unexpected error: %v", err)
+	}
+	if out != "[pod-b/app] ERROR failed" {
+		t.Errorf("getMultiPodLogs() = %q, want only the matching line", out)
+	}
+
+	filter, _ = newLogLineFilter("panic", false, "")
+	out, err = getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, nil, false, false, 0, filter, false)
+	if err != nil {
+		t.Fatalf("getMultiPodLogs() unexpected error: %v", err)
+	}
+	if out != `No log lines matching keyword "panic"` {
+		t.Errorf("getMultiPodLogs() = %q, want the empty-filter message", out)
+	}
+}
+
+func TestGetAllContainerLogs_ReportsFilteredOutOfBand(t *testing.T) {
+	client := &mockAllContainerLogClient{logs: map[string]string{"app": "INFO ready", "sidecar": "WARN slow"}}
+	filter, _ := newLogLineFilter("warn", false, "")
+
+	out, err := getAllContainerLogs(context.Background(), client, "c1", "ns", "pod-1", &steve.PodLogOptions{}, filter)
+	if err != nil {
+		t.Fatalf("getAllContainerLogs() unexpected error: %v", err)
+	}
+	if out != "[sidecar] WARN slow" {
+		t.Errorf("getAllContainerLogs() = %q, want only the matching line", out)
+	}
+
+	filter, _ = newLogLineFilter("", false, "info")
+	client.logs = map[string]string{"app": "INFO ready"}
+	out, err = getAllContainerLogs(context.Background(), client, "c1", "ns", "pod-1", &steve.PodLogOptions{}, filter)
+	if err != nil {
+		t.Fatalf("getAllContainerLogs() unexpected error: %v", err)
+	}
+	if out != "No log lines after filters" {
+		t.Errorf("getAllContainerLogs() = %q, want the empty-filter message", out)
+	}
+}
+
+func TestNewLogLineFilter_InvalidRegex(t *testing.T) {
+	if _, err := newLogLineFilter("(unclosed", true, ""); err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+}
+
+func TestGetMultiPodLogs_OrdersMixedTimestampPrecision(t *testing.T) {
+	client := &mockMultiPodLogClient{
+		results: []steve.MultiPodLogResult{
+			{Pod: "pod-a", Logs: map[string]string{"app": "2024-01-15T10:30:00.2Z second"}},
+			{Pod: "pod-b", Logs: map[string]string{"app": "2024-01-15T10:30:00.123456789Z first\n2024-01-15T11:30:00.3+01:00 third"}},
+		},
+	}
+
+	out, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "", 50, nil, false, false, 0, nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first := strings.Index(out, "first")
+	second := strings.Index(out, "second")
+	third := strings.Index(out, "third")
+	if first < 0 || second < 0 || third < 0 || !(first < second && second < third) {
+		t.Errorf("expected first < second < third ordering, got:\n%s", out)
+	}
+}
+
+func TestGetAllContainerLogs_InitContainersFirst(t *testing.T) {
+	client := &mockAllContainerLogClient{
+		logs: map[string]string{
+			"app": "serving",
+			steve.ContainerLogKey("setup", steve.ContainerTypeInit): "migrating",
+		},
+	}
+
+	out, err := getAllContainerLogs(context.Background(), client, "c1", "ns", "pod-1", &steve.PodLogOptions{IncludeInitContainers: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "[setup] [init] migrating\n[app] serving"
+	if out != want {
+		t.Errorf("output = %q, want %q", out, want)
+	}
+	if !client.opts.IncludeInitContainers {
+		t.Error("expected IncludeInitContainers to be passed to client")
+	}
+}
+
+func TestGetMultiPodLogs_ContainerFilterMatchesInitContainer(t *testing.T) {
+	client := &mockMultiPodLogClient{
+		results: []steve.MultiPodLogResult{
+			{Pod: "pod-1", Logs: map[string]string{
+				"app": "serving",
+				steve.ContainerLogKey("setup", steve.ContainerTypeInit): "migrating",
+			}},
+		},
+	}
+
+	out, err := getMultiPodLogs(context.Background(), client, "c1", "ns", "app=web", "setup", 50, nil, false, false, 0, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "[pod-1/setup] [init] migrating" {
+		t.Errorf("unexpected output: %q", out)
+	}
+	if client.opts.Container != "setup" {
+		t.Errorf("Container = %q, want setup", client.opts.Container)
+	}
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// parseIncludePaths splits a comma-separated includePaths parameter into field
+// paths. Empty entries are dropped; an empty result means no projection.
+func parseIncludePaths(includePaths string) [][]string {
+	var paths [][]string
+	for _, path := range strings.Split(includePaths, ",") {
+		if fields := splitIncludePath(strings.TrimSpace(path)); len(fields) > 0 {
+			paths = append(paths, fields)
+		}
+	}
+	return paths
+}
+
+// splitIncludePath splits a dotted path into fields, keeping label and
+// annotation keys whole.
+func splitIncludePath(path string) []string {
+	return paramutil.SplitFieldPath(path)
+}
+
+// removeResourcePaths deletes the given field paths from resource in place.
+// Paths that do not exist are ignored.
+func removeResourcePaths(resource *unstructured.Unstructured, paths [][]string) {
+	for _, fields := range paths {
+		unstructured.RemoveNestedField(resource.Object, fields...)
+	}
+}
+
+// projectResource builds a copy of resource that contains only the given field
+// paths. Paths that do not exist in the resource are omitted.
+func projectResource(resource *unstructured.Unstructured, paths [][]string) *unstructured.Unstructured {
+	projected := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for _, fields := range paths {
+		value, found, err := unstructured.NestedFieldNoCopy(resource.Object, fields...)
+		if err != nil || !found {
+			continue
+		}
+		// Every prefix of a found path is a map in resource, so setting it cannot
+		// fail; SetNestedField deep-copies value, so the projection never aliases resource.
+		_ = unstructured.SetNestedField(projected.Object, value, fields...)
+	}
+	return projected
+}
@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseIncludePaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  [][]string
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "blank entries dropped", input: " , ,", want: nil},
+		{
+			name:  "dotted paths",
+			input: "metadata.name, spec.replicas",
+			want:  [][]string{{"metadata", "name"}, {"spec", "replicas"}},
+		},
+		{
+			name:  "label key with dots kept whole",
+			input: "metadata.labels.app.kubernetes.io/name",
+			want:  [][]string{{"metadata", "labels", "app.kubernetes.io/name"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseIncludePaths(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIncludePaths(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectResource(t *testing.T) {
+	resource := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "web",
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "web"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{"spec": map[string]interface{}{}},
+		},
+		"status": map[string]interface{}{"readyReplicas": int64(2)},
+	}}
+
+	got := projectResource(resource, parseIncludePaths("metadata.name,spec.replicas,status.readyReplicas,status.missing,metadata.labels.app.kubernetes.io/name"))
+
+	want := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "web",
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "web"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{"readyReplicas": int64(2)},
+	}
+	if !reflect.DeepEqual(got.Object, want) {
+		t.Errorf("projectResource() = %v, want %v", got.Object, want)
+	}
+
+	// The projection must not alias the source object
+	got.Object["metadata"].(map[string]interface{})["labels"].(map[string]interface{})["app.kubernetes.io/name"] = "changed"
+	if resource.GetLabels()["app.kubernetes.io/name"] != "web" {
+		t.Error("projectResource() result aliases the source resource")
+	}
+}
@@ -0,0 +1,344 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ways a pod spec can reference a Secret or ConfigMap.
+const (
+	refTypeEnvFrom         = "envFrom"
+	refTypeSecretKeyRef    = "secretKeyRef"
+	refTypeConfigMapKeyRef = "configMapKeyRef"
+	refTypeVolume          = "volume"
+	refTypeImagePullSecret = "imagePullSecret"
+)
+
+// podSpecSource is a kind whose objects carry a pod spec, and the path to it.
+type podSpecSource struct {
+	kind    string
+	podSpec []string
+	// skipOwned skips objects managed by another controller; the owner is reported instead.
+	skipOwned bool
+}
+
+// podSpecSources are scanned in this order. Objects with a controller owner
+// are skipped for the kinds that are usually managed, so a Deployment's
+// ReplicaSets and pods or a CronJob's Jobs are reported once, as their owner.
+var podSpecSources = []podSpecSource{
+	{kind: "deployment", podSpec: []string{"spec", "template", "spec"}},
+	{kind: "statefulset", podSpec: []string{"spec", "template", "spec"}},
+	{kind: "daemonset", podSpec: []string{"spec", "template", "spec"}},
+	{kind: "cronjob", podSpec: []string{"spec", "jobTemplate", "spec", "template", "spec"}},
+	{kind: "replicaset", podSpec: []string{"spec", "template", "spec"}, skipOwned: true},
+	{kind: "job", podSpec: []string{"spec", "template", "spec"}, skipOwned: true},
+	{kind: "pod", podSpec: []string{"spec"}, skipOwned: true},
+}
+
+// WorkloadReference is one place a workload references a Secret or ConfigMap.
+type WorkloadReference struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Detail names the container, env var, key or volume holding the reference.
+	Detail string `json:"detail,omitempty"`
+}
+
+// ReferencesResult lists the workloads that reference an object.
+type ReferencesResult struct {
+	Kind       string              `json:"kind"`
+	Name       string              `json:"name"`
+	Namespace  string              `json:"namespace"`
+	References []WorkloadReference `json:"references"`
+	// Errors lists the kinds that could not be scanned, e.g. for lack of
+	// permission; references held by those kinds are missing from the result.
+	Errors []ReferenceScanError `json:"errors"`
+}
+
+// ReferenceScanError holds why a kind could not be scanned for references.
+type ReferenceScanError struct {
+	Kind  string `json:"kind"`
+	Error string `json:"error"`
+}
+
+// podSpecReferenceFunc returns the references to the object name in spec,
+// with Kind and Name left for the caller to fill in.
+type podSpecReferenceFunc func(spec *corev1.PodSpec, name string) []WorkloadReference
+
+// secretRefsHandler handles the kubernetes_secret_refs tool
+func secretRefsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	return referencesHandler(ctx, client, params, "Secret", podSpecSecretReferences)
+}
+
+// configMapRefsHandler handles the kubernetes_configmap_refs tool
+func configMapRefsHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	return referencesHandler(ctx, client, params, "ConfigMap", podSpecConfigMapReferences)
+}
+
+// referencesHandler lists the workloads whose pod specs reference the object
+// of kind named by the name parameter, as found by match.
+func referencesHandler(ctx context.Context, client interface{}, params map[string]interface{}, kind string, match podSpecReferenceFunc) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := steveClient.GetResource(ctx, cluster, strings.ToLower(kind), namespace, name); err != nil {
+		return "", fmt.Errorf("failed to get %s: %w", kind, err)
+	}
+
+	result, err := findWorkloadReferences(ctx, steveClient, cluster, namespace, name, match)
+	if err != nil {
+		return "", err
+	}
+	result.Kind = kind
+
+	return formatReferences(result, format)
+}
+
+// findWorkloadReferences scans the pod specs of the workloads and pods in
+// namespace for references to the object name, as found by match. Kinds that
+// cannot be listed are reported in Errors instead of failing the scan.
+func findWorkloadReferences(ctx context.Context, client steve.ResourceReader, cluster, namespace, name string, match podSpecReferenceFunc) (*ReferencesResult, error) {
+	result := &ReferencesResult{Name: name, Namespace: namespace, References: []WorkloadReference{}, Errors: []ReferenceScanError{}}
+
+	for _, source := range podSpecSources {
+		list, err := client.ListResources(ctx, cluster, source.kind, namespace, nil)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			result.Errors = append(result.Errors, ReferenceScanError{Kind: source.kind, Error: err.Error()})
+			continue
+		}
+		for _, obj := range list.Items {
+			raw, found, _ := unstructured.NestedMap(obj.Object, source.podSpec...)
+			if !found {
+				continue
+			}
+			var spec corev1.PodSpec
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &spec); err != nil {
+				result.Errors = append(result.Errors, ReferenceScanError{
+					Kind:  source.kind,
+					Error: fmt.Sprintf("failed to convert pod spec of %s: %v", obj.GetName(), err),
+				})
+				continue
+			}
+			if source.skipOwned && hasControllerOwner(obj) {
+				// Ephemeral containers are added to running pods and are not
+				// in the owner's template, so they are scanned on every pod
+				if len(spec.EphemeralContainers) == 0 {
+					continue
+				}
+				spec = corev1.PodSpec{EphemeralContainers: spec.EphemeralContainers}
+			}
+			for _, ref := range match(&spec, name) {
+				ref.Kind = obj.GetKind()
+				ref.Name = obj.GetName()
+				result.References = append(result.References, ref)
+			}
+		}
+	}
+
+	sort.SliceStable(result.References, func(i, j int) bool {
+		a, b := result.References[i], result.References[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return result, nil
+}
+
+// hasControllerOwner reports whether obj is managed by another controller.
+func hasControllerOwner(obj unstructured.Unstructured) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// specContainer is a container of a pod spec with the label used in reference
+// details, e.g. "container app" or "ephemeral container debugger".
+type specContainer struct {
+	corev1.Container
+	label string
+}
+
+// podSpecContainers returns the init containers, the containers and the
+// ephemeral containers of spec.
+func podSpecContainers(spec *corev1.PodSpec) []specContainer {
+	containers := make([]specContainer, 0, len(spec.InitContainers)+len(spec.Containers)+len(spec.EphemeralContainers))
+	for _, c := range spec.InitContainers {
+		containers = append(containers, specContainer{Container: c, label: "container " + c.Name})
+	}
+	for _, c := range spec.Containers {
+		containers = append(containers, specContainer{Container: c, label: "container " + c.Name})
+	}
+	for _, c := range spec.EphemeralContainers {
+		containers = append(containers, specContainer{
+			Container: corev1.Container(c.EphemeralContainerCommon),
+			label:     "ephemeral container " + c.Name,
+		})
+	}
+	return containers
+}
+
+// podSpecSecretReferences finds references to a Secret in envFrom, env
+// secretKeyRef, secret and projected volumes, and imagePullSecrets.
+func podSpecSecretReferences(spec *corev1.PodSpec, name string) []WorkloadReference {
+	var refs []WorkloadReference
+
+	for _, ips := range spec.ImagePullSecrets {
+		if ips.Name == name {
+			refs = append(refs, WorkloadReference{Type: refTypeImagePullSecret})
+		}
+	}
+
+	for _, v := range spec.Volumes {
+		switch {
+		case v.Secret != nil && v.Secret.SecretName == name:
+			refs = append(refs, WorkloadReference{Type: refTypeVolume, Detail: v.Name})
+		case v.Projected != nil:
+			for _, src := range v.Projected.Sources {
+				if src.Secret != nil && src.Secret.Name == name {
+					refs = append(refs, WorkloadReference{Type: refTypeVolume, Detail: v.Name + " (projected)"})
+				}
+			}
+		}
+	}
+
+	for _, c := range podSpecContainers(spec) {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == name {
+				refs = append(refs, WorkloadReference{Type: refTypeEnvFrom, Detail: c.label})
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == name {
+				refs = append(refs, WorkloadReference{
+					Type:   refTypeSecretKeyRef,
+					Detail: fmt.Sprintf("%s env %s (key %s)", c.label, env.Name, env.ValueFrom.SecretKeyRef.Key),
+				})
+			}
+		}
+	}
+	return refs
+}
+
+// podSpecConfigMapReferences finds references to a ConfigMap in envFrom, env
+// configMapKeyRef, and configMap and projected volumes.
+func podSpecConfigMapReferences(spec *corev1.PodSpec, name string) []WorkloadReference {
+	var refs []WorkloadReference
+
+	for _, v := range spec.Volumes {
+		switch {
+		case v.ConfigMap != nil && v.ConfigMap.Name == name:
+			refs = append(refs, WorkloadReference{Type: refTypeVolume, Detail: v.Name})
+		case v.Projected != nil:
+			for _, src := range v.Projected.Sources {
+				if src.ConfigMap != nil && src.ConfigMap.Name == name {
+					refs = append(refs, WorkloadReference{Type: refTypeVolume, Detail: v.Name + " (projected)"})
+				}
+			}
+		}
+	}
+
+	for _, c := range podSpecContainers(spec) {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+				refs = append(refs, WorkloadReference{Type: refTypeEnvFrom, Detail: c.label})
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+				refs = append(refs, WorkloadReference{
+					Type:   refTypeConfigMapKeyRef,
+					Detail: fmt.Sprintf("%s env %s (key %s)", c.label, env.Name, env.ValueFrom.ConfigMapKeyRef.Key),
+				})
+			}
+		}
+	}
+	return refs
+}
+
+// formatReferences renders the workload references as a table, markdown, CSV, JSON or YAML.
+func formatReferences(result *ReferencesResult, format string) (string, error) {
+	headers := []string{"kind", "name", "type", "detail"}
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(result)
+	case paramutil.FormatTable, paramutil.FormatMarkdown:
+		if len(result.References) == 0 {
+			if len(result.Errors) > 0 {
+				return fmt.Sprintf("No references to %s %s/%s found in the kinds that could be scanned\n", result.Kind, result.Namespace, result.Name) +
+					formatReferenceScanErrors(result.Errors), nil
+			}
+			return fmt.Sprintf("%s %s/%s is not referenced by any workload\n", result.Kind, result.Namespace, result.Name), nil
+		}
+		table, err := paramutil.FormatRows(referenceRows(result.References), headers, format)
+		if err != nil {
+			return "", err
+		}
+		return table + formatReferenceScanErrors(result.Errors), nil
+	case paramutil.FormatCSV:
+		return paramutil.FormatAsCSV(referenceRows(result.References), headers)
+	default: // json
+		return paramutil.FormatAsJSON(result)
+	}
+}
+
+// formatReferenceScanErrors lists the kinds that could not be scanned after a table.
+func formatReferenceScanErrors(errs []ReferenceScanError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\nIncomplete: %d kinds could not be scanned, references they hold are not listed:\n", len(errs))
+	for _, e := range errs {
+		fmt.Fprintf(&b, "  %s: %s\n", e.Kind, e.Error)
+	}
+	return b.String()
+}
+
+func referenceRows(refs []WorkloadReference) []map[string]string {
+	rows := make([]map[string]string, 0, len(refs))
+	for _, r := range refs {
+		rows = append(rows, map[string]string{
+			"kind":   r.Kind,
+			"name":   r.Name,
+			"type":   r.Type,
+			"detail": r.Detail,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,218 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func makeRefWorkload(t *testing.T, kind, name string, owned bool, spec map[string]interface{}) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+	}}
+	if owned {
+		controller := true
+		obj.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Deployment", Name: "owner", Controller: &controller}})
+	}
+	path := []string{"spec", "template", "spec"}
+	if kind == "Pod" {
+		path = []string{"spec"}
+	}
+	if err := unstructured.SetNestedMap(obj.Object, spec, path...); err != nil {
+		t.Fatalf("failed to set pod spec: %v", err)
+	}
+	return obj
+}
+
+func TestPodSpecWorkloadReferences(t *testing.T) {
+	spec := &corev1.PodSpec{
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "creds"}, {Name: "other"}},
+		Volumes: []corev1.Volume{
+			{Name: "certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "creds"}}},
+			{Name: "bundle", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+				{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}}},
+			}}}},
+		},
+		InitContainers: []corev1.Container{{
+			Name:    "init",
+			EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}}}},
+		}},
+		Containers: []corev1.Container{{
+			Name: "app",
+			Env: []corev1.EnvVar{
+				{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "password",
+				}}},
+				{Name: "PLAIN", Value: "creds"},
+			},
+		}},
+	}
+
+	refs := podSpecSecretReferences(spec, "creds")
+
+	want := []WorkloadReference{
+		{Type: refTypeImagePullSecret},
+		{Type: refTypeVolume, Detail: "certs"},
+		{Type: refTypeVolume, Detail: "bundle (projected)"},
+		{Type: refTypeEnvFrom, Detail: "container init"},
+		{Type: refTypeSecretKeyRef, Detail: "container app env PASSWORD (key password)"},
+	}
+	if len(refs) != len(want) {
+		t.Fatalf("refs = %+v, want %+v", refs, want)
+	}
+	for i := range want {
+		if refs[i] != want[i] {
+			t.Errorf("refs[%d] = %+v, want %+v", i, refs[i], want[i])
+		}
+	}
+}
+
+func TestFindWorkloadReferences_ReportsOwnersOnce(t *testing.T) {
+	envFrom := map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{
+			"name":    "app",
+			"envFrom": []interface{}{map[string]interface{}{"secretRef": map[string]interface{}{"name": "creds"}}},
+		}},
+	}
+	client := fake.NewClient()
+	client.AddResource(makeRefWorkload(t, "Deployment", "web", false, envFrom))
+	client.AddResource(makeRefWorkload(t, "ReplicaSet", "web-abc", true, envFrom))
+	client.AddResource(makeRefWorkload(t, "Pod", "web-abc-1", true, envFrom))
+	client.AddResource(makeRefWorkload(t, "Pod", "debug", false, map[string]interface{}{
+		"imagePullSecrets": []interface{}{map[string]interface{}{"name": "creds"}},
+	}))
+	client.AddResource(makeRefWorkload(t, "StatefulSet", "unrelated", false, map[string]interface{}{
+		"imagePullSecrets": []interface{}{map[string]interface{}{"name": "other"}},
+	}))
+
+	result, err := findWorkloadReferences(context.Background(), client, "c1", "default", "creds", podSpecSecretReferences)
+	if err != nil {
+		t.Fatalf("findWorkloadReferences() unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, r := range result.References {
+		got = append(got, r.Kind+"/"+r.Name+":"+r.Type)
+	}
+	if strings.Join(got, ",") != "Deployment/web:envFrom,Pod/debug:imagePullSecret" {
+		t.Errorf("references = %v", got)
+	}
+
+	out, err := formatReferences(result, paramutil.FormatTable)
+	if err != nil {
+		t.Fatalf("formatReferences() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "container app") {
+		t.Errorf("expected table to contain the container detail, got:\n%s", out)
+	}
+
+	empty, err := formatReferences(&ReferencesResult{Kind: "Secret", Name: "unused", Namespace: "default"}, paramutil.FormatTable)
+	if err != nil || !strings.Contains(empty, "not referenced") {
+		t.Errorf("empty table = %q, %v", empty, err)
+	}
+}
+
+// refListErrorReader fails listing one kind, as when RBAC denies it
+type refListErrorReader struct {
+	*fake.Client
+	kind string
+}
+
+func (r refListErrorReader) ListResources(ctx context.Context, cluster, kind, namespace string, opts *steve.ListOptions) (*unstructured.UnstructuredList, error) {
+	if kind == r.kind {
+		return nil, fmt.Errorf("%s is forbidden", kind)
+	}
+	return r.Client.ListResources(ctx, cluster, kind, namespace, opts)
+}
+
+func TestFindWorkloadReferences_ListErrorsAndEphemeralContainers(t *testing.T) {
+	client := fake.NewClient()
+	client.AddResource(makeRefWorkload(t, "Deployment", "web", false, map[string]interface{}{
+		"imagePullSecrets": []interface{}{map[string]interface{}{"name": "creds"}},
+	}))
+	client.AddResource(makeRefWorkload(t, "Pod", "web-abc-1", true, map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{"name": "app"}},
+		"ephemeralContainers": []interface{}{map[string]interface{}{
+			"name":    "debugger",
+			"envFrom": []interface{}{map[string]interface{}{"secretRef": map[string]interface{}{"name": "creds"}}},
+		}},
+	}))
+	reader := refListErrorReader{Client: client, kind: "cronjob"}
+
+	result, err := findWorkloadReferences(context.Background(), reader, "c1", "default", "creds", podSpecSecretReferences)
+	if err != nil {
+		t.Fatalf("findWorkloadReferences() unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, r := range result.References {
+		got = append(got, r.Kind+"/"+r.Name+":"+r.Type+":"+r.Detail)
+	}
+	if strings.Join(got, ",") != "Deployment/web:imagePullSecret:,Pod/web-abc-1:envFrom:ephemeral container debugger" {
+		t.Errorf("references = %v", got)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Kind != "cronjob" {
+		t.Errorf("errors = %+v, want one cronjob entry", result.Errors)
+	}
+
+	out, err := formatReferences(result, paramutil.FormatTable)
+	if err != nil {
+		t.Fatalf("formatReferences() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Incomplete") || !strings.Contains(out, "cronjob is forbidden") {
+		t.Errorf("expected table to report the unscanned kind, got:\n%s", out)
+	}
+}
+
+func TestPodSpecConfigMapReferences(t *testing.T) {
+	configMapRef := corev1.LocalObjectReference{Name: "settings"}
+	spec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: configMapRef}}},
+			{Name: "bundle", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+				{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: configMapRef}},
+			}}}},
+			{Name: "secret", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "settings"}}},
+		},
+		Containers: []corev1.Container{{
+			Name:    "app",
+			EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: configMapRef}}},
+			Env: []corev1.EnvVar{
+				{Name: "LEVEL", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: configMapRef, Key: "log-level",
+				}}},
+			},
+		}},
+	}
+
+	refs := podSpecConfigMapReferences(spec, "settings")
+
+	want := []WorkloadReference{
+		{Type: refTypeVolume, Detail: "config"},
+		{Type: refTypeVolume, Detail: "bundle (projected)"},
+		{Type: refTypeEnvFrom, Detail: "container app"},
+		{Type: refTypeConfigMapKeyRef, Detail: "container app env LEVEL (key log-level)"},
+	}
+	if len(refs) != len(want) {
+		t.Fatalf("refs = %+v, want %+v", refs, want)
+	}
+	for i := range want {
+		if refs[i] != want[i] {
+			t.Errorf("refs[%d] = %+v, want %+v", i, refs[i], want[i])
+		}
+	}
+}
@@ -1,10 +1,15 @@
 package kubernetes
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestExtractResourceKindWithAPIVersion(t *testing.T) {
@@ -83,7 +88,7 @@ func TestFormatResourceList(t *testing.T) {
 	}
 
 	t.Run("json", func(t *testing.T) {
-		out, err := formatResourceList(list, "json", nil)
+		out, err := formatResourceList(list, "json", nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -93,7 +98,7 @@ func TestFormatResourceList(t *testing.T) {
 	})
 
 	t.Run("table", func(t *testing.T) {
-		out, err := formatResourceList(list, "table", nil)
+		out, err := formatResourceList(list, "table", nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -104,6 +109,46 @@ func TestFormatResourceList(t *testing.T) {
 			t.Error("expected pod-1 in table")
 		}
 	})
+
+	t.Run("csv uses wide columns", func(t *testing.T) {
+		out, err := formatResourceList(list, "csv", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(out, "NAME,NAMESPACE,READY,STATUS,RESTARTS,NODE,AGE\n") {
+			t.Errorf("expected wide csv header, got:\n%s", out)
+		}
+		if !strings.Contains(out, "pod-2,kube-system,") {
+			t.Errorf("expected pod-2 row, got:\n%s", out)
+		}
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		out, err := formatResourceList(list, "markdown", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(out, "| NAME | NAMESPACE | READY | STATUS | RESTARTS | NODE | AGE |\n| --- |") {
+			t.Errorf("expected markdown header, got:\n%s", out)
+		}
+		if !strings.Contains(out, "| pod-1 | default |") {
+			t.Errorf("expected pod-1 row, got:\n%s", out)
+		}
+	})
+
+	t.Run("csv without wide columns", func(t *testing.T) {
+		mixed := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			newTestUnstructured("a-very-long-configmap-name-that-the-table-would-truncate", "default", "ConfigMap"),
+		}}
+		out, err := formatResourceList(mixed, "csv", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "NAME,NAMESPACE,KIND,AGE\na-very-long-configmap-name-that-the-table-would-truncate,default,ConfigMap,"
+		if !strings.HasPrefix(out, want) {
+			t.Errorf("expected output to start with %q, got:\n%s", want, out)
+		}
+	})
 }
 
 func TestFormatAsTable_Empty(t *testing.T) {
@@ -122,3 +167,105 @@ func newTestUnstructured(name, namespace, kind string) unstructured.Unstructured
 	u.SetKind(kind)
 	return u
 }
+
+func TestParseApplyManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{
+		{name: "valid", json: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cfg","namespace":"default"}}`},
+		{name: "invalid json", json: `{"apiVersion":`, wantErr: "failed to parse resource JSON"},
+		{name: "missing apiVersion", json: `{"kind":"ConfigMap","metadata":{"name":"cfg"}}`, wantErr: "apiVersion"},
+		{name: "missing kind", json: `{"apiVersion":"v1","metadata":{"name":"cfg"}}`, wantErr: "kind"},
+		{name: "missing name", json: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"generateName":"cfg-"}}`, wantErr: "metadata.name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseApplyManifest(tt.json)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseApplyManifest() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseApplyManifest() unexpected error: %v", err)
+			}
+			if got.GetName() != "cfg" || got.GetNamespace() != "default" {
+				t.Errorf("parseApplyManifest() = %s/%s, want default/cfg", got.GetNamespace(), got.GetName())
+			}
+		})
+	}
+}
+
+func TestApplyHandler_ReadOnlyMode(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":  "c1",
+		"resource": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cfg"}}`,
+		"readOnly": true,
+	}
+
+	_, err := applyHandler(context.Background(), nil, params)
+	if !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Fatalf("applyHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+}
+
+func TestParsePatchType(t *testing.T) {
+	tests := []struct {
+		patchType string
+		want      types.PatchType
+		wantErr   bool
+	}{
+		{patchType: "", want: types.JSONPatchType},
+		{patchType: "json", want: types.JSONPatchType},
+		{patchType: "merge", want: types.MergePatchType},
+		{patchType: "Strategic", want: types.StrategicMergePatchType},
+		{patchType: "apply", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.patchType, func(t *testing.T) {
+			got, err := parsePatchType(tt.patchType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parsePatchType(%q) expected error, got %q", tt.patchType, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePatchType(%q) unexpected error: %v", tt.patchType, err)
+			}
+			if got != tt.want {
+				t.Errorf("parsePatchType(%q) = %q, want %q", tt.patchType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateAndApplyHandlers_DryRunAllowedInReadOnlyMode(t *testing.T) {
+	handlers := map[string]toolset.ToolHandler{
+		"create": createHandler,
+		"apply":  applyHandler,
+	}
+
+	for name, handler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			params := map[string]interface{}{
+				"cluster":  "c1",
+				"resource": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cfg"}}`,
+				"readOnly": true,
+			}
+			if _, err := handler(context.Background(), nil, params); !errors.Is(err, paramutil.ErrReadOnlyMode) {
+				t.Fatalf("%sHandler() without dryRun error = %v, want %v", name, err, paramutil.ErrReadOnlyMode)
+			}
+
+			params["dryRun"] = true
+			if _, err := handler(context.Background(), nil, params); errors.Is(err, paramutil.ErrReadOnlyMode) {
+				t.Fatalf("%sHandler() with dryRun should pass the read-only guard", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartedAtAnnotation is the pod template annotation used by `kubectl rollout restart`.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// restartableKinds lists the workload kinds supported by kubernetes_restart.
+var restartableKinds = []string{"deployment", "statefulset", "daemonset"}
+
+// restartHandler handles the kubernetes_restart tool
+func restartHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	// Check read-only mode
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+		return "", paramutil.ErrReadOnlyMode
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	kindParam, err := paramutil.ExtractRequiredString(params, paramutil.ParamKind)
+	if err != nil {
+		return "", err
+	}
+	kind, err := normalizeWorkloadKind(kindParam, "restart", restartableKinds)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+	format := paramutil.ExtractFormat(params)
+	filter := paramutil.NewResourceFilterFromParams(params)
+
+	// The JSON Patch shape depends on whether the template already has annotations
+	workload, err := steveClient.GetResource(ctx, cluster, kind, namespace, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	patch, err := buildRestartPatch(workload, time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return "", err
+	}
+
+	patched, err := steveClient.PatchResource(ctx, cluster, kind, namespace, name, types.JSONPatchType, patch)
+	if err != nil {
+		return "", fmt.Errorf("failed to restart %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return formatResource(patched, format, filter)
+}
+
+// buildRestartPatch builds a JSON Patch that sets the restartedAt annotation on
+// the pod template, creating the annotations map (and template metadata) when absent.
+func buildRestartPatch(workload *unstructured.Unstructured, restartedAt string) ([]byte, error) {
+	var patch []map[string]interface{}
+
+	_, hasMetadata, _ := unstructured.NestedFieldNoCopy(workload.Object, "spec", "template", "metadata")
+	annotations, hasAnnotations, _ := unstructured.NestedFieldNoCopy(workload.Object, "spec", "template", "metadata", "annotations")
+
+	switch {
+	case hasAnnotations && annotations != nil:
+		patch = append(patch, map[string]interface{}{
+			"op":    "add",
+			"path":  "/spec/template/metadata/annotations/" + escapeJSONPointer(restartedAtAnnotation),
+			"value": restartedAt,
+		})
+	case hasMetadata:
+		patch = append(patch, map[string]interface{}{
+			"op":    "add",
+			"path":  "/spec/template/metadata/annotations",
+			"value": map[string]string{restartedAtAnnotation: restartedAt},
+		})
+	default:
+		patch = append(patch, map[string]interface{}{
+			"op":   "add",
+			"path": "/spec/template/metadata",
+			"value": map[string]interface{}{
+				"annotations": map[string]string{restartedAtAnnotation: restartedAt},
+			},
+		})
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build restart patch: %w", err)
+	}
+	return data, nil
+}
+
+// escapeJSONPointer escapes a key for use as a JSON Pointer (RFC 6901) token.
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
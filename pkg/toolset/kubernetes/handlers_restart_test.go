@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBuildRestartPatch(t *testing.T) {
+	const ts = "2024-01-15T10:00:00Z"
+
+	tests := []struct {
+		name     string
+		template map[string]interface{}
+		want     []map[string]interface{}
+	}{
+		{
+			name: "existing annotations",
+			template: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{"foo": "bar"},
+				},
+			},
+			want: []map[string]interface{}{
+				{"op": "add", "path": "/spec/template/metadata/annotations/kubectl.kubernetes.io~1restartedAt", "value": ts},
+			},
+		},
+		{
+			name: "metadata without annotations",
+			template: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "web"},
+				},
+			},
+			want: []map[string]interface{}{
+				{"op": "add", "path": "/spec/template/metadata/annotations", "value": map[string]interface{}{restartedAtAnnotation: ts}},
+			},
+		},
+		{
+			name:     "no template metadata",
+			template: map[string]interface{}{},
+			want: []map[string]interface{}{
+				{"op": "add", "path": "/spec/template/metadata", "value": map[string]interface{}{
+					"annotations": map[string]interface{}{restartedAtAnnotation: ts},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workload := &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"template": tt.template},
+			}}
+
+			data, err := buildRestartPatch(workload, ts)
+			if err != nil {
+				t.Fatalf("buildRestartPatch() unexpected error: %v", err)
+			}
+
+			var got []map[string]interface{}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("failed to decode patch %s: %v", data, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildRestartPatch() = %s, want %v", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeWorkloadKind_Restart(t *testing.T) {
+	for _, kind := range []string{"deployment", "StatefulSet", "ds"} {
+		if _, err := normalizeWorkloadKind(kind, "restart", restartableKinds); err != nil {
+			t.Errorf("normalizeWorkloadKind(%q) unexpected error: %v", kind, err)
+		}
+	}
+	if _, err := normalizeWorkloadKind("replicaset", "restart", restartableKinds); err == nil {
+		t.Error("normalizeWorkloadKind(replicaset) expected error for restart")
+	}
+}
+
+func TestRestartHandler_ReadOnlyMode(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":   "c1",
+		"kind":      "deployment",
+		"namespace": "ns",
+		"name":      "web",
+		"readOnly":  true,
+	}
+
+	_, err := restartHandler(context.Background(), nil, params)
+	if !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Fatalf("restartHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+}
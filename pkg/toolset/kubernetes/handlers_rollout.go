@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
@@ -52,7 +53,145 @@ func rolloutHistoryHandler(ctx context.Context, client interface{}, params map[s
 	return formatRolloutHistory(history, format)
 }
 
-// extractRolloutParams extracts the common parameters used by rolloutHistoryHandler.
+// rolloutStatusHandler handles the kubernetes_rollout_status tool
+func rolloutStatusHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := buildRolloutStatusRequest(params)
+	if err != nil {
+		return "", err
+	}
+	return rolloutStatusWithReader(ctx, steveClient, request)
+}
+
+type rolloutStatusRequest struct {
+	cluster   string
+	namespace string
+	name      string
+	wait      bool
+	timeout   time.Duration
+	interval  time.Duration
+}
+
+func buildRolloutStatusRequest(params map[string]interface{}) (*rolloutStatusRequest, error) {
+	cluster, namespace, name, err := extractRolloutParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutSeconds := paramutil.ExtractInt64(params, paramutil.ParamTimeoutSeconds, DefaultRolloutTimeoutSeconds)
+	if timeoutSeconds < 1 || timeoutSeconds > MaxRolloutTimeoutSeconds {
+		return nil, fmt.Errorf("timeoutSeconds must be between 1 and %d, got %d", MaxRolloutTimeoutSeconds, timeoutSeconds)
+	}
+
+	return &rolloutStatusRequest{
+		cluster:   cluster,
+		namespace: namespace,
+		name:      name,
+		wait:      paramutil.ExtractBool(params, paramutil.ParamWait, false),
+		timeout:   time.Duration(timeoutSeconds) * time.Second,
+		interval:  RolloutPollIntervalSeconds * time.Second,
+	}, nil
+}
+
+// rolloutStatusWithReader reports the rollout state of a Deployment. With wait
+// set it polls at request.interval until the rollout finishes, fails, or the
+// timeout elapses.
+func rolloutStatusWithReader(ctx context.Context, reader steve.ResourceReader, request *rolloutStatusRequest) (string, error) {
+	deadline := time.Now().Add(request.timeout)
+	for {
+		deployment, err := reader.GetResource(ctx, request.cluster, "deployment", request.namespace, request.name)
+		if err != nil {
+			return "", fmt.Errorf("failed to get deployment: %w", err)
+		}
+
+		status := evaluateDeploymentRollout(deployment)
+		if !request.wait || status.done {
+			return status.String(), nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Sprintf("Timed out after %s waiting for rollout to finish\n%s", request.timeout, status), nil
+		}
+		if err := waitForNextIteration(ctx, min(request.interval, remaining)); err != nil {
+			return "", err
+		}
+	}
+}
+
+// rolloutStatus is the verdict for a Deployment rollout, modelled on
+// `kubectl rollout status`.
+type rolloutStatus struct {
+	message string
+	done    bool
+	// summary lists the replica counters the verdict was derived from
+	summary string
+}
+
+func (s rolloutStatus) String() string {
+	return s.message + "\n" + s.summary
+}
+
+// evaluateDeploymentRollout compares a Deployment's status counters against its
+// spec. A rollout is complete once the latest generation has been observed and
+// every desired replica is updated and available with no old replicas left.
+// A ProgressDeadlineExceeded condition is terminal.
+func evaluateDeploymentRollout(deployment *unstructured.Unstructured) rolloutStatus {
+	generation := deployment.GetGeneration()
+	observedGeneration, _, _ := unstructured.NestedInt64(deployment.Object, "status", "observedGeneration")
+	desired, found, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	if !found {
+		// The API server defaults spec.replicas to 1
+		desired = 1
+	}
+	replicas, _, _ := unstructured.NestedInt64(deployment.Object, "status", "replicas")
+	updated, _, _ := unstructured.NestedInt64(deployment.Object, "status", "updatedReplicas")
+	ready, _, _ := unstructured.NestedInt64(deployment.Object, "status", "readyReplicas")
+	available, _, _ := unstructured.NestedInt64(deployment.Object, "status", "availableReplicas")
+
+	status := rolloutStatus{
+		summary: fmt.Sprintf("desired=%d updated=%d ready=%d available=%d total=%d generation=%d observedGeneration=%d",
+			desired, updated, ready, available, replicas, generation, observedGeneration),
+	}
+
+	switch {
+	case observedGeneration < generation:
+		status.message = fmt.Sprintf("Waiting for rollout: spec update (generation %d) not yet observed by the controller", generation)
+	case deploymentProgressDeadlineExceeded(deployment):
+		status.message = fmt.Sprintf("Rollout failed: deployment %q exceeded its progress deadline", deployment.GetName())
+		status.done = true
+	case updated < desired:
+		status.message = fmt.Sprintf("Waiting for rollout: %d of %d updated", updated, desired)
+	case replicas > updated:
+		status.message = fmt.Sprintf("Waiting for rollout: %d old replicas pending termination", replicas-updated)
+	case available < updated:
+		status.message = fmt.Sprintf("Waiting for rollout: %d of %d updated replicas available", available, updated)
+	default:
+		status.message = fmt.Sprintf("Rollout complete: deployment %q successfully rolled out", deployment.GetName())
+		status.done = true
+	}
+	return status
+}
+
+func deploymentProgressDeadlineExceeded(deployment *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(deployment.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Progressing" && condition["reason"] == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// extractRolloutParams extracts the common parameters used by the rollout handlers.
 func extractRolloutParams(params map[string]interface{}) (cluster, namespace, name string, err error) {
 	cluster, err = paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
 	if err != nil {
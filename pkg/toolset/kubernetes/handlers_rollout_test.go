@@ -269,7 +269,7 @@ func TestRolloutHistoryHandler_Integration(t *testing.T) {
 	server := newRolloutHistoryTestServer(t)
 	defer server.Close()
 
-	client := steve.NewClient(server.URL, "token", "", "", true)
+	client := steve.NewClient(server.URL, "token", "", "", true, 0)
 
 	t.Run("table output", func(t *testing.T) {
 		testRolloutHistoryTableOutput(t, client)
@@ -419,3 +419,157 @@ func testRolloutHistoryCombinedClient(t *testing.T, client *steve.Client) {
 		t.Errorf("expected output from combined client, got %q", out)
 	}
 }
+
+func newRolloutTestDeployment(generation, observed, desired, replicas, updated, available int64, conditions ...interface{}) *unstructured.Unstructured {
+	d := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default", "generation": generation},
+		"spec":       map[string]interface{}{"replicas": desired},
+		"status": map[string]interface{}{
+			"observedGeneration": observed,
+			"replicas":           replicas,
+			"updatedReplicas":    updated,
+			"readyReplicas":      available,
+			"availableReplicas":  available,
+			"conditions":         conditions,
+		},
+	}}
+	return d
+}
+
+func TestEvaluateDeploymentRollout(t *testing.T) {
+	tests := []struct {
+		name        string
+		deployment  *unstructured.Unstructured
+		wantMessage string
+		wantDone    bool
+	}{
+		{
+			name:        "generation not observed",
+			deployment:  newRolloutTestDeployment(3, 2, 5, 5, 5, 5),
+			wantMessage: "not yet observed",
+		},
+		{
+			name:        "partially updated",
+			deployment:  newRolloutTestDeployment(2, 2, 5, 6, 2, 4),
+			wantMessage: "Waiting for rollout: 2 of 5 updated",
+		},
+		{
+			name:        "old replicas pending termination",
+			deployment:  newRolloutTestDeployment(2, 2, 5, 7, 5, 5),
+			wantMessage: "2 old replicas pending termination",
+		},
+		{
+			name:        "updated replicas not yet available",
+			deployment:  newRolloutTestDeployment(2, 2, 5, 5, 5, 3),
+			wantMessage: "3 of 5 updated replicas available",
+		},
+		{
+			name:        "complete",
+			deployment:  newRolloutTestDeployment(2, 2, 5, 5, 5, 5),
+			wantMessage: "Rollout complete",
+			wantDone:    true,
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: newRolloutTestDeployment(2, 2, 5, 6, 2, 4, map[string]interface{}{
+				"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded",
+			}),
+			wantMessage: "Rollout failed",
+			wantDone:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluateDeploymentRollout(tt.deployment)
+			if !strings.Contains(got.message, tt.wantMessage) {
+				t.Errorf("message = %q, want containing %q", got.message, tt.wantMessage)
+			}
+			if got.done != tt.wantDone {
+				t.Errorf("done = %v, want %v", got.done, tt.wantDone)
+			}
+			if !strings.Contains(got.String(), "desired=5") {
+				t.Errorf("String() = %q, want replica summary", got.String())
+			}
+		})
+	}
+}
+
+func TestEvaluateDeploymentRollout_DefaultsReplicasToOne(t *testing.T) {
+	deployment := newRolloutTestDeployment(1, 1, 0, 0, 0, 0)
+	unstructured.RemoveNestedField(deployment.Object, "spec", "replicas")
+
+	got := evaluateDeploymentRollout(deployment)
+	if !strings.Contains(got.message, "0 of 1 updated") {
+		t.Errorf("message = %q, want 0 of 1 updated", got.message)
+	}
+}
+
+// deploymentSequenceReader returns the queued deployments in order, repeating the last one.
+type deploymentSequenceReader struct {
+	sequenceResourceReader
+	deployments []*unstructured.Unstructured
+	calls       int
+}
+
+func (r *deploymentSequenceReader) GetResource(context.Context, string, string, string, string) (*unstructured.Unstructured, error) {
+	idx := min(r.calls, len(r.deployments)-1)
+	r.calls++
+	return r.deployments[idx].DeepCopy(), nil
+}
+
+func TestRolloutStatusWithReader(t *testing.T) {
+	progressing := newRolloutTestDeployment(2, 2, 3, 4, 1, 3)
+	complete := newRolloutTestDeployment(2, 2, 3, 3, 3, 3)
+
+	t.Run("no wait returns current verdict", func(t *testing.T) {
+		reader := &deploymentSequenceReader{deployments: []*unstructured.Unstructured{progressing, complete}}
+		got, err := rolloutStatusWithReader(context.Background(), reader, &rolloutStatusRequest{timeout: time.Minute})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(got, "1 of 3 updated") || reader.calls != 1 {
+			t.Errorf("got %q after %d calls, want single progressing verdict", got, reader.calls)
+		}
+	})
+
+	t.Run("wait polls until complete", func(t *testing.T) {
+		reader := &deploymentSequenceReader{deployments: []*unstructured.Unstructured{progressing, progressing, complete}}
+		got, err := rolloutStatusWithReader(context.Background(), reader, &rolloutStatusRequest{wait: true, timeout: time.Minute})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(got, "Rollout complete") || reader.calls != 3 {
+			t.Errorf("got %q after %d calls, want completion on third poll", got, reader.calls)
+		}
+	})
+
+	t.Run("wait times out", func(t *testing.T) {
+		reader := &deploymentSequenceReader{deployments: []*unstructured.Unstructured{progressing}}
+		got, err := rolloutStatusWithReader(context.Background(), reader, &rolloutStatusRequest{
+			wait:     true,
+			timeout:  20 * time.Millisecond,
+			interval: 5 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(got, "Timed out") || !strings.Contains(got, "1 of 3 updated") {
+			t.Errorf("got %q, want timeout with last verdict", got)
+		}
+	})
+}
+
+func TestBuildRolloutStatusRequest_RejectsInvalidTimeout(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":        "c1",
+		"namespace":      "default",
+		"name":           "web",
+		"timeoutSeconds": float64(MaxRolloutTimeoutSeconds + 1),
+	}
+	if _, err := buildRolloutStatusRequest(params); err == nil {
+		t.Fatal("buildRolloutStatusRequest() expected error for timeout above maximum")
+	}
+}
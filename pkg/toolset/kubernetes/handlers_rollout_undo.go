@@ -0,0 +1,183 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// revisionAnnotation records a ReplicaSet's rollout revision number.
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	// podTemplateHashLabel is added to ReplicaSet templates by the deployment controller.
+	podTemplateHashLabel = "pod-template-hash"
+)
+
+// rollbackSkippedAnnotations are kept from the Deployment rather than copied
+// from the target ReplicaSet on rollback, as kubectl rollout undo does. The
+// deployment controller owns the revision bookkeeping among them.
+var rollbackSkippedAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	revisionAnnotation:                          true,
+	"deployment.kubernetes.io/revision-history": true,
+	"deployment.kubernetes.io/desired-replicas": true,
+	"deployment.kubernetes.io/max-replicas":     true,
+	"apps.kubernetes.io/deprecated-rollback-to": true,
+}
+
+// rolloutUndoHandler handles the kubernetes_rollout_undo tool
+func rolloutUndoHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	// Check read-only mode
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+		return "", paramutil.ErrReadOnlyMode
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, namespace, name, err := extractRolloutParams(params)
+	if err != nil {
+		return "", err
+	}
+	toRevision := paramutil.ExtractInt64(params, paramutil.ParamToRevision, 0)
+	if toRevision < 0 {
+		return "", fmt.Errorf("toRevision must be a positive revision number, got %d", toRevision)
+	}
+
+	deployment, err := steveClient.GetResource(ctx, cluster, "deployment", namespace, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	rsList, err := listReplicaSets(ctx, steveClient, cluster, namespace, buildDeploymentSelector(deployment))
+	if err != nil {
+		return "", fmt.Errorf("failed to list replicasets: %w", err)
+	}
+
+	target, revision, err := findRollbackReplicaSet(rsList, name, toRevision)
+	if err != nil {
+		return "", err
+	}
+
+	currentTemplate, _, _ := unstructured.NestedMap(deployment.Object, "spec", "template")
+	targetTemplate, err := rollbackTemplate(target)
+	if err != nil {
+		return "", err
+	}
+	if equality.Semantic.DeepEqual(currentTemplate, targetTemplate) {
+		return fmt.Sprintf("Skipped rollback: deployment %s/%s already matches revision %d", namespace, name, revision), nil
+	}
+
+	// "add" replaces the annotations map and also works when it is absent
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "replace", "path": "/spec/template", "value": targetTemplate},
+		{"op": "add", "path": "/metadata/annotations", "value": rollbackAnnotations(deployment, target)},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to build rollback patch: %w", err)
+	}
+	if _, err := steveClient.PatchResource(ctx, cluster, "deployment", namespace, name, types.JSONPatchType, patch); err != nil {
+		return "", fmt.Errorf("failed to roll back deployment %s/%s: %w", namespace, name, err)
+	}
+
+	diff, err := diffPodTemplates(deployment, currentTemplate, targetTemplate)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Rolled back deployment %s/%s to revision %d\n\n%s", namespace, name, revision, diff), nil
+}
+
+// findRollbackReplicaSet returns the ReplicaSet owned by the Deployment for the
+// requested revision. A zero toRevision selects the previous revision, i.e. the
+// second-highest revision number.
+func findRollbackReplicaSet(rsList *unstructured.UnstructuredList, deploymentName string, toRevision int64) (*unstructured.Unstructured, int64, error) {
+	type revisionedReplicaSet struct {
+		revision int64
+		rs       *unstructured.Unstructured
+	}
+
+	var owned []revisionedReplicaSet
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		ownerRefs, found, _ := unstructured.NestedSlice(rs.Object, "metadata", "ownerReferences")
+		if !found || !isOwnedByDeployment(ownerRefs, deploymentName) {
+			continue
+		}
+		revision, err := strconv.ParseInt(rs.GetAnnotations()[revisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		owned = append(owned, revisionedReplicaSet{revision: revision, rs: rs})
+	}
+	sort.Slice(owned, func(i, j int) bool { return owned[i].revision > owned[j].revision })
+
+	if toRevision == 0 {
+		if len(owned) < 2 {
+			return nil, 0, fmt.Errorf("no previous revision found for deployment %s", deploymentName)
+		}
+		return owned[1].rs, owned[1].revision, nil
+	}
+
+	for _, candidate := range owned {
+		if candidate.revision == toRevision {
+			return candidate.rs, candidate.revision, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("revision %d not found for deployment %s", toRevision, deploymentName)
+}
+
+// rollbackTemplate returns the ReplicaSet's pod template without the
+// controller-managed pod-template-hash label, ready to patch onto a Deployment.
+func rollbackTemplate(rs *unstructured.Unstructured) (map[string]interface{}, error) {
+	template, found, err := unstructured.NestedMap(rs.Object, "spec", "template")
+	if err != nil || !found {
+		return nil, fmt.Errorf("replicaset %s has no pod template", rs.GetName())
+	}
+	unstructured.RemoveNestedField(template, "metadata", "labels", podTemplateHashLabel)
+	return template, nil
+}
+
+// rollbackAnnotations returns the Deployment annotations after rolling back to
+// the ReplicaSet: the Deployment's own controller-managed annotations, plus
+// the ReplicaSet's other annotations such as the change-cause of that revision.
+// The controller then moves the ReplicaSet to the next revision number.
+func rollbackAnnotations(deployment, rs *unstructured.Unstructured) map[string]string {
+	annotations := make(map[string]string)
+	for k, v := range deployment.GetAnnotations() {
+		if rollbackSkippedAnnotations[k] {
+			annotations[k] = v
+		}
+	}
+	for k, v := range rs.GetAnnotations() {
+		if !rollbackSkippedAnnotations[k] {
+			annotations[k] = v
+		}
+	}
+	return annotations
+}
+
+// diffPodTemplates renders the change from the current to the rolled-back pod template.
+func diffPodTemplates(deployment *unstructured.Unstructured, current, target map[string]interface{}) (string, error) {
+	wrap := func(template map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": deployment.GetAPIVersion(),
+			"kind":       deployment.GetKind(),
+			"metadata": map[string]interface{}{
+				"name":      deployment.GetName(),
+				"namespace": deployment.GetNamespace(),
+			},
+			"spec": map[string]interface{}{"template": template},
+		}}
+	}
+	return diffResources(wrap(current), wrap(target), diffOptions{ignoreStatus: true, ignoreMeta: true})
+}
@@ -0,0 +1,171 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newUndoTestReplicaSet(name, owner, revision, image string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "ReplicaSet",
+		"metadata": map[string]interface{}{
+			"name":            name,
+			"namespace":       "default",
+			"annotations":     map[string]interface{}{revisionAnnotation: revision},
+			"ownerReferences": []interface{}{map[string]interface{}{"kind": "Deployment", "name": owner}},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "web", podTemplateHashLabel: name},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "web", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+func TestFindRollbackReplicaSet(t *testing.T) {
+	rsList := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		newUndoTestReplicaSet("web-a", "web", "1", "nginx:1.24"),
+		newUndoTestReplicaSet("web-c", "web", "10", "nginx:1.26"),
+		newUndoTestReplicaSet("web-b", "web", "2", "nginx:1.25"),
+		newUndoTestReplicaSet("other-a", "other", "11", "busybox"),
+	}}
+
+	tests := []struct {
+		name         string
+		toRevision   int64
+		wantName     string
+		wantRevision int64
+		wantErr      bool
+	}{
+		{name: "previous revision uses numeric order", toRevision: 0, wantName: "web-b", wantRevision: 2},
+		{name: "explicit revision", toRevision: 1, wantName: "web-a", wantRevision: 1},
+		{name: "revision owned by another deployment", toRevision: 11, wantErr: true},
+		{name: "missing revision", toRevision: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, revision, err := findRollbackReplicaSet(rsList, "web", tt.toRevision)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("findRollbackReplicaSet() expected error, got %s", rs.GetName())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("findRollbackReplicaSet() unexpected error: %v", err)
+			}
+			if rs.GetName() != tt.wantName || revision != tt.wantRevision {
+				t.Errorf("findRollbackReplicaSet() = %s@%d, want %s@%d", rs.GetName(), revision, tt.wantName, tt.wantRevision)
+			}
+		})
+	}
+}
+
+func TestFindRollbackReplicaSet_NoPreviousRevision(t *testing.T) {
+	rsList := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		newUndoTestReplicaSet("web-a", "web", "1", "nginx:1.24"),
+	}}
+	if _, _, err := findRollbackReplicaSet(rsList, "web", 0); err == nil || !strings.Contains(err.Error(), "no previous revision") {
+		t.Fatalf("findRollbackReplicaSet() error = %v, want no previous revision", err)
+	}
+}
+
+func TestRollbackTemplate_StripsPodTemplateHash(t *testing.T) {
+	rs := newUndoTestReplicaSet("web-a", "web", "1", "nginx:1.24")
+
+	template, err := rollbackTemplate(&rs)
+	if err != nil {
+		t.Fatalf("rollbackTemplate() unexpected error: %v", err)
+	}
+	labels, _, _ := unstructured.NestedStringMap(template, "metadata", "labels")
+	if _, ok := labels[podTemplateHashLabel]; ok {
+		t.Errorf("rollbackTemplate() kept %s label: %v", podTemplateHashLabel, labels)
+	}
+	if labels["app"] != "web" {
+		t.Errorf("rollbackTemplate() dropped app label: %v", labels)
+	}
+	// The source ReplicaSet must be left untouched
+	if _, found, _ := unstructured.NestedString(rs.Object, "spec", "template", "metadata", "labels", podTemplateHashLabel); !found {
+		t.Error("rollbackTemplate() mutated the ReplicaSet")
+	}
+}
+
+func TestRollbackAnnotations(t *testing.T) {
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAnnotations(map[string]string{
+		revisionAnnotation:                          "3",
+		"deployment.kubernetes.io/revision-history": "1",
+		"kubernetes.io/change-cause":                "bump to 1.25",
+		"team":                                      "web",
+	})
+	rs := newUndoTestReplicaSet("web-a", "web", "2", "nginx:1.24")
+	rs.SetAnnotations(map[string]string{
+		revisionAnnotation:                          "2",
+		"deployment.kubernetes.io/desired-replicas": "3",
+		"kubernetes.io/change-cause":                "bump to 1.24",
+	})
+
+	got := rollbackAnnotations(deployment, &rs)
+
+	want := map[string]string{
+		revisionAnnotation:                          "3",
+		"deployment.kubernetes.io/revision-history": "1",
+		"kubernetes.io/change-cause":                "bump to 1.24",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("rollbackAnnotations() = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("rollbackAnnotations()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestDiffPodTemplates(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+	}}
+	current := map[string]interface{}{"spec": map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{"name": "web", "image": "nginx:1.26"}},
+	}}
+	target := map[string]interface{}{"spec": map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{"name": "web", "image": "nginx:1.25"}},
+	}}
+
+	got, err := diffPodTemplates(deployment, current, target)
+	if err != nil {
+		t.Fatalf("diffPodTemplates() unexpected error: %v", err)
+	}
+	if !strings.Contains(got, "nginx:1.26") || !strings.Contains(got, "nginx:1.25") {
+		t.Errorf("diffPodTemplates() = %q, want both images", got)
+	}
+}
+
+func TestRolloutUndoHandler_ReadOnlyMode(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":   "c1",
+		"namespace": "default",
+		"name":      "web",
+		"readOnly":  true,
+	}
+
+	_, err := rolloutUndoHandler(context.Background(), nil, params)
+	if !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Fatalf("rolloutUndoHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+}
@@ -0,0 +1,117 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// workloadKindAliases maps accepted kind spellings to the canonical workload kind.
+var workloadKindAliases = map[string]string{
+	"deployment":   "deployment",
+	"deployments":  "deployment",
+	"deploy":       "deployment",
+	"statefulset":  "statefulset",
+	"statefulsets": "statefulset",
+	"sts":          "statefulset",
+	"replicaset":   "replicaset",
+	"replicasets":  "replicaset",
+	"rs":           "replicaset",
+	"daemonset":    "daemonset",
+	"daemonsets":   "daemonset",
+	"ds":           "daemonset",
+}
+
+// scalableKinds lists the workload kinds supported by kubernetes_scale.
+var scalableKinds = []string{"deployment", "statefulset", "replicaset"}
+
+// scaleHandler handles the kubernetes_scale tool
+func scaleHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	// Check read-only mode
+	if readOnly, ok := params["readOnly"].(bool); ok && readOnly {
+		return "", paramutil.ErrReadOnlyMode
+	}
+
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	kindParam, err := paramutil.ExtractRequiredString(params, paramutil.ParamKind)
+	if err != nil {
+		return "", err
+	}
+	kind, err := normalizeWorkloadKind(kindParam, "scale", scalableKinds)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+	replicas, err := extractReplicas(params)
+	if err != nil {
+		return "", err
+	}
+	format := paramutil.ExtractFormat(params)
+	filter := paramutil.NewResourceFilterFromParams(params)
+
+	patch, err := buildScalePatch(replicas)
+	if err != nil {
+		return "", err
+	}
+
+	patched, err := steveClient.PatchResource(ctx, cluster, kind, namespace, name, types.JSONPatchType, patch)
+	if err != nil {
+		return "", fmt.Errorf("failed to scale %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return formatResource(patched, format, filter)
+}
+
+// normalizeWorkloadKind returns the canonical workload kind if it is one of
+// the supported kinds for the given operation.
+func normalizeWorkloadKind(kind, operation string, supported []string) (string, error) {
+	if canonical, ok := workloadKindAliases[strings.ToLower(kind)]; ok && slices.Contains(supported, canonical) {
+		return canonical, nil
+	}
+	return "", fmt.Errorf("unsupported kind for %s: %s (supported: %s)", operation, kind, strings.Join(supported, ", "))
+}
+
+// extractReplicas extracts the required, non-negative replicas parameter.
+func extractReplicas(params map[string]interface{}) (int64, error) {
+	replicas := paramutil.ExtractOptionalInt64(params, paramutil.ParamReplicas)
+	if replicas == nil {
+		return 0, fmt.Errorf("%w: %s", paramutil.ErrMissingParameter, paramutil.ParamReplicas)
+	}
+	if *replicas < 0 {
+		return 0, fmt.Errorf("replicas must be non-negative, got %d", *replicas)
+	}
+	return *replicas, nil
+}
+
+// buildScalePatch builds a JSON Patch that replaces /spec/replicas.
+func buildScalePatch(replicas int64) ([]byte, error) {
+	patch := []map[string]interface{}{
+		{"op": "replace", "path": "/spec/replicas", "value": replicas},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build scale patch: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+func TestNormalizeWorkloadKind_Scale(t *testing.T) {
+	tests := []struct {
+		kind    string
+		want    string
+		wantErr bool
+	}{
+		{kind: "deployment", want: "deployment"},
+		{kind: "Deployment", want: "deployment"},
+		{kind: "deploy", want: "deployment"},
+		{kind: "statefulsets", want: "statefulset"},
+		{kind: "sts", want: "statefulset"},
+		{kind: "ReplicaSet", want: "replicaset"},
+		{kind: "daemonset", wantErr: true},
+		{kind: "pod", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			got, err := normalizeWorkloadKind(tt.kind, "scale", scalableKinds)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeWorkloadKind(%q) expected error, got %q", tt.kind, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeWorkloadKind(%q) unexpected error: %v", tt.kind, err)
+			}
+			if got != tt.want {
+				t.Fatalf("normalizeWorkloadKind(%q) = %q, want %q", tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractReplicas(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		want    int64
+		wantErr bool
+	}{
+		{name: "float64 from JSON", params: map[string]interface{}{"replicas": float64(3)}, want: 3},
+		{name: "zero", params: map[string]interface{}{"replicas": 0}, want: 0},
+		{name: "missing", params: map[string]interface{}{}, wantErr: true},
+		{name: "negative", params: map[string]interface{}{"replicas": float64(-1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractReplicas(tt.params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("extractReplicas() expected error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("extractReplicas() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("extractReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildScalePatch(t *testing.T) {
+	got, err := buildScalePatch(5)
+	if err != nil {
+		t.Fatalf("buildScalePatch() unexpected error: %v", err)
+	}
+	want := `[{"op":"replace","path":"/spec/replicas","value":5}]`
+	if string(got) != want {
+		t.Fatalf("buildScalePatch() = %s, want %s", got, want)
+	}
+}
+
+func TestScaleHandler_ReadOnlyMode(t *testing.T) {
+	params := map[string]interface{}{
+		"cluster":   "c1",
+		"kind":      "deployment",
+		"namespace": "ns",
+		"name":      "web",
+		"replicas":  float64(2),
+		"readOnly":  true,
+	}
+
+	_, err := scaleHandler(context.Background(), nil, params)
+	if !errors.Is(err, paramutil.ErrReadOnlyMode) {
+		t.Fatalf("scaleHandler() error = %v, want %v", err, paramutil.ErrReadOnlyMode)
+	}
+}
@@ -0,0 +1,270 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// failedSchedulingReason is the event reason the scheduler reports for pods it cannot place.
+const failedSchedulingReason = "FailedScheduling"
+
+// SchedulingCheck is the outcome of one scheduling constraint evaluated
+// against the node a pod landed on.
+type SchedulingCheck struct {
+	Constraint string `json:"constraint"`
+	Satisfied  bool   `json:"satisfied"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// SchedulingTaint is a taint of the pod's node and whether the pod tolerates it.
+type SchedulingTaint struct {
+	Taint     string `json:"taint"`
+	Tolerated bool   `json:"tolerated"`
+}
+
+// SchedulingExplanation explains where a pod was scheduled and why.
+type SchedulingExplanation struct {
+	Pod          string              `json:"pod"`
+	Namespace    string              `json:"namespace"`
+	Phase        string              `json:"phase"`
+	NodeName     string              `json:"nodeName,omitempty"`
+	NodeSelector map[string]string   `json:"nodeSelector,omitempty"`
+	Affinity     *corev1.Affinity    `json:"affinity,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+	NodeTaints   []SchedulingTaint   `json:"nodeTaints,omitempty"`
+	// Checks evaluates nodeSelector, required node affinity and the node's
+	// NoSchedule/NoExecute taints; pod (anti-)affinity is reported, not evaluated.
+	Checks []SchedulingCheck `json:"checks,omitempty"`
+	// Satisfied is set once the pod is bound to a node: whether every check passed.
+	Satisfied *bool `json:"satisfied,omitempty"`
+	// FailedScheduling is the latest FailedScheduling event message of an unscheduled pod.
+	FailedScheduling string `json:"failedScheduling,omitempty"`
+}
+
+// schedulingHandler handles the kubernetes_scheduling tool
+func schedulingHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return "", err
+	}
+	format := paramutil.ExtractFormat(params)
+
+	result, err := explainPodScheduling(ctx, steveClient, cluster, namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	if format == paramutil.FormatYAML {
+		return paramutil.FormatAsYAML(result)
+	}
+	return paramutil.FormatAsJSON(result)
+}
+
+// explainPodScheduling gathers a pod's scheduling constraints and checks them
+// against its node, or reports the scheduler's complaint for an unscheduled pod.
+func explainPodScheduling(ctx context.Context, client steve.ResourceReader, cluster, namespace, name string) (*SchedulingExplanation, error) {
+	obj, err := client.GetResource(ctx, cluster, "pod", namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod: %w", err)
+	}
+	var pod corev1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod); err != nil {
+		return nil, fmt.Errorf("failed to convert pod: %w", err)
+	}
+
+	result := &SchedulingExplanation{
+		Pod:          pod.Name,
+		Namespace:    pod.Namespace,
+		Phase:        string(pod.Status.Phase),
+		NodeName:     pod.Spec.NodeName,
+		NodeSelector: pod.Spec.NodeSelector,
+		Affinity:     pod.Spec.Affinity,
+		Tolerations:  pod.Spec.Tolerations,
+	}
+
+	if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodPending {
+		events, err := client.GetEvents(ctx, cluster, namespace, name, "Pod", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod events: %w", err)
+		}
+		result.FailedScheduling = latestFailedScheduling(events)
+	}
+
+	if pod.Spec.NodeName == "" {
+		return result, nil
+	}
+
+	nodeObj, err := client.GetResource(ctx, cluster, "node", "", pod.Spec.NodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", pod.Spec.NodeName, err)
+	}
+	var node corev1.Node
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(nodeObj.Object, &node); err != nil {
+		return nil, fmt.Errorf("failed to convert node: %w", err)
+	}
+
+	result.NodeTaints, result.Checks = checkPodAgainstNode(&pod, &node)
+	satisfied := true
+	for _, check := range result.Checks {
+		satisfied = satisfied && check.Satisfied
+	}
+	result.Satisfied = &satisfied
+	return result, nil
+}
+
+// checkPodAgainstNode evaluates the pod's nodeSelector, required node affinity
+// and tolerations against node. PreferNoSchedule taints are listed but are not
+// checks, as the scheduler may ignore them.
+func checkPodAgainstNode(pod *corev1.Pod, node *corev1.Node) ([]SchedulingTaint, []SchedulingCheck) {
+	var checks []SchedulingCheck
+
+	keys := make([]string, 0, len(pod.Spec.NodeSelector))
+	for key := range pod.Spec.NodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		want := pod.Spec.NodeSelector[key]
+		check := SchedulingCheck{Constraint: fmt.Sprintf("nodeSelector %s=%s", key, want)}
+		got, ok := node.Labels[key]
+		switch {
+		case !ok:
+			check.Detail = "node has no label " + key
+		case got != want:
+			check.Detail = fmt.Sprintf("node has %s=%s", key, got)
+		default:
+			check.Satisfied = true
+		}
+		checks = append(checks, check)
+	}
+
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil && affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		check := SchedulingCheck{
+			Constraint: "required node affinity",
+			Detail:     fmt.Sprintf("none of %d nodeSelectorTerms match the node", len(terms)),
+		}
+		for i, term := range terms {
+			if matchNodeSelectorTerm(term, node) {
+				check.Satisfied = true
+				check.Detail = fmt.Sprintf("nodeSelectorTerms[%d] matches", i)
+				break
+			}
+		}
+		checks = append(checks, check)
+	}
+
+	taints := make([]SchedulingTaint, 0, len(node.Spec.Taints))
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		tolerated := slices.ContainsFunc(pod.Spec.Tolerations, func(t corev1.Toleration) bool {
+			return t.ToleratesTaint(taint)
+		})
+		taints = append(taints, SchedulingTaint{Taint: taint.ToString(), Tolerated: tolerated})
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		check := SchedulingCheck{Constraint: "taint " + taint.ToString(), Satisfied: tolerated}
+		if !tolerated {
+			check.Detail = "no matching toleration"
+		}
+		checks = append(checks, check)
+	}
+
+	return taints, checks
+}
+
+// matchNodeSelectorTerm reports whether node satisfies every requirement of
+// term. A term without requirements matches no node, as in the scheduler.
+func matchNodeSelectorTerm(term corev1.NodeSelectorTerm, node *corev1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, req := range term.MatchExpressions {
+		if !matchNodeSelectorRequirement(req, node.Labels) {
+			return false
+		}
+	}
+	for _, req := range term.MatchFields {
+		// metadata.name is the only field the scheduler supports
+		if req.Key != "metadata.name" || !matchNodeSelectorRequirement(req, map[string]string{req.Key: node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchNodeSelectorRequirement evaluates one requirement against labels.
+func matchNodeSelectorRequirement(req corev1.NodeSelectorRequirement, labels map[string]string) bool {
+	value, ok := labels[req.Key]
+	switch req.Operator {
+	case corev1.NodeSelectorOpIn:
+		return ok && slices.Contains(req.Values, value)
+	case corev1.NodeSelectorOpNotIn:
+		return !ok || !slices.Contains(req.Values, value)
+	case corev1.NodeSelectorOpExists:
+		return ok
+	case corev1.NodeSelectorOpDoesNotExist:
+		return !ok
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if !ok || len(req.Values) != 1 {
+			return false
+		}
+		got, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false
+		}
+		want, err := strconv.ParseInt(req.Values[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if req.Operator == corev1.NodeSelectorOpGt {
+			return got > want
+		}
+		return got < want
+	default:
+		return false
+	}
+}
+
+// latestFailedScheduling returns the message of the most recent
+// FailedScheduling event, or "" when there is none.
+func latestFailedScheduling(events []corev1.Event) string {
+	var latest *corev1.Event
+	for i := range events {
+		e := &events[i]
+		if e.Reason != failedSchedulingReason {
+			continue
+		}
+		if latest == nil || eventTime(*e).After(eventTime(*latest)) {
+			latest = e
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return strings.TrimSpace(latest.Message)
+}
@@ -0,0 +1,146 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func toUnstructured(t *testing.T, kind string, obj interface{}) *unstructured.Unstructured {
+	t.Helper()
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to convert %s: %v", kind, err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetKind(kind)
+	return u
+}
+
+func TestMatchNodeSelectorRequirement(t *testing.T) {
+	labels := map[string]string{"zone": "a", "cores": "8"}
+	tests := []struct {
+		name string
+		req  corev1.NodeSelectorRequirement
+		want bool
+	}{
+		{"in matches", corev1.NodeSelectorRequirement{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}}, true},
+		{"in missing label", corev1.NodeSelectorRequirement{Key: "rack", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}, false},
+		{"notin other value", corev1.NodeSelectorRequirement{Key: "zone", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"b"}}, true},
+		{"notin missing label", corev1.NodeSelectorRequirement{Key: "rack", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"b"}}, true},
+		{"exists", corev1.NodeSelectorRequirement{Key: "zone", Operator: corev1.NodeSelectorOpExists}, true},
+		{"does not exist", corev1.NodeSelectorRequirement{Key: "zone", Operator: corev1.NodeSelectorOpDoesNotExist}, false},
+		{"gt", corev1.NodeSelectorRequirement{Key: "cores", Operator: corev1.NodeSelectorOpGt, Values: []string{"4"}}, true},
+		{"lt", corev1.NodeSelectorRequirement{Key: "cores", Operator: corev1.NodeSelectorOpLt, Values: []string{"4"}}, false},
+		{"gt non-numeric", corev1.NodeSelectorRequirement{Key: "zone", Operator: corev1.NodeSelectorOpGt, Values: []string{"4"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchNodeSelectorRequirement(tt.req, labels); got != tt.want {
+				t.Errorf("matchNodeSelectorRequirement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPodAgainstNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"disktype": "hdd", "zone": "a"}},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "gpu", Effect: corev1.TaintEffectNoExecute},
+			{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule},
+		}},
+	}
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		NodeSelector: map[string]string{"disktype": "ssd", "zone": "a"},
+		Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+				{MatchFields: []corev1.NodeSelectorRequirement{{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"}}}},
+			}},
+		}},
+		Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "db", Effect: corev1.TaintEffectNoSchedule}},
+	}}
+
+	taints, checks := checkPodAgainstNode(pod, node)
+
+	wantChecks := []SchedulingCheck{
+		{Constraint: "nodeSelector disktype=ssd", Satisfied: false, Detail: "node has disktype=hdd"},
+		{Constraint: "nodeSelector zone=a", Satisfied: true},
+		{Constraint: "required node affinity", Satisfied: true, Detail: "nodeSelectorTerms[1] matches"},
+		{Constraint: "taint dedicated=db:NoSchedule", Satisfied: true},
+		{Constraint: "taint gpu:NoExecute", Satisfied: false, Detail: "no matching toleration"},
+	}
+	if len(checks) != len(wantChecks) {
+		t.Fatalf("got %d checks, want %d: %+v", len(checks), len(wantChecks), checks)
+	}
+	for i := range wantChecks {
+		if checks[i] != wantChecks[i] {
+			t.Errorf("checks[%d] = %+v, want %+v", i, checks[i], wantChecks[i])
+		}
+	}
+	if len(taints) != 3 || !taints[0].Tolerated || taints[1].Tolerated || taints[2].Tolerated {
+		t.Errorf("unexpected taints: %+v", taints)
+	}
+}
+
+func TestExplainPodScheduling(t *testing.T) {
+	t.Run("scheduled pod is checked against its node", func(t *testing.T) {
+		client := fake.NewClient()
+		client.AddResource(toUnstructured(t, "Pod", &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-1", NodeSelector: map[string]string{"zone": "a"}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}))
+		client.AddResource(toUnstructured(t, "Node", &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"zone": "a"}},
+		}))
+
+		result, err := explainPodScheduling(context.Background(), client, "c1", "default", "web")
+		if err != nil {
+			t.Fatalf("explainPodScheduling() error = %v", err)
+		}
+		if result.NodeName != "node-1" || result.Satisfied == nil || !*result.Satisfied {
+			t.Errorf("expected a satisfied assignment to node-1, got %+v", result)
+		}
+		if result.FailedScheduling != "" {
+			t.Errorf("running pod should not report FailedScheduling, got %q", result.FailedScheduling)
+		}
+	})
+
+	t.Run("pending pod reports latest FailedScheduling", func(t *testing.T) {
+		client := fake.NewClient()
+		client.AddResource(toUnstructured(t, "Pod", &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		}))
+		now := time.Now()
+		for i, msg := range []string{"0/3 nodes are available: old", "0/3 nodes are available: 3 Insufficient cpu."} {
+			client.AddEvent(corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "default"},
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web"},
+				Reason:         failedSchedulingReason,
+				Message:        msg,
+				LastTimestamp:  metav1.NewTime(now.Add(time.Duration(i) * time.Minute)),
+			})
+		}
+
+		result, err := explainPodScheduling(context.Background(), client, "c1", "default", "web")
+		if err != nil {
+			t.Fatalf("explainPodScheduling() error = %v", err)
+		}
+		if result.Satisfied != nil || len(result.Checks) != 0 {
+			t.Errorf("unscheduled pod should have no checks, got %+v", result)
+		}
+		if result.FailedScheduling != "0/3 nodes are available: 3 Insufficient cpu." {
+			t.Errorf("FailedScheduling = %q", result.FailedScheduling)
+		}
+	})
+}
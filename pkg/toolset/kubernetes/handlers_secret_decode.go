@@ -0,0 +1,108 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// UndecodedSecretKey is a Secret data key decodeSecretData left base64-encoded,
+// with the reason, e.g. "not UTF-8 text".
+type UndecodedSecretKey struct {
+	Name   string `json:"name" yaml:"name"`
+	Key    string `json:"key" yaml:"key"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// decodeSecretData returns a copy of a Secret whose base64 data values are
+// decoded into stringData, the field Kubernetes accepts plain text in.
+// Values that are not valid base64 or do not decode to UTF-8 text (binary
+// data, or values masked as "***") stay base64-encoded in data and are
+// returned, sorted by key, so the caller can report them outside the object.
+// Other kinds are returned unchanged.
+func decodeSecretData(resource *unstructured.Unstructured) (*unstructured.Unstructured, []UndecodedSecretKey) {
+	if resource == nil || !strings.EqualFold(resource.GetKind(), "Secret") {
+		return resource, nil
+	}
+	data, ok := resource.Object["data"].(map[string]interface{})
+	if !ok || len(data) == 0 {
+		return resource, nil
+	}
+
+	result := resource.DeepCopy()
+	encoded := map[string]interface{}{}
+	decoded, _ := result.Object["stringData"].(map[string]interface{})
+	if decoded == nil {
+		decoded = map[string]interface{}{}
+	}
+	var undecoded []UndecodedSecretKey
+	for key, value := range data {
+		s, _ := value.(string)
+		raw, err := base64.StdEncoding.DecodeString(s)
+		var reason string
+		switch {
+		case err != nil:
+			reason = "not valid base64"
+		case !utf8.Valid(raw):
+			reason = "not UTF-8 text"
+		default:
+			decoded[key] = string(raw)
+			continue
+		}
+		encoded[key] = value
+		undecoded = append(undecoded, UndecodedSecretKey{Name: result.GetName(), Key: key, Reason: reason})
+	}
+	slices.SortFunc(undecoded, func(a, b UndecodedSecretKey) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	if len(encoded) > 0 {
+		result.Object["data"] = encoded
+	} else {
+		delete(result.Object, "data")
+	}
+	if len(decoded) > 0 {
+		result.Object["stringData"] = decoded
+	}
+	return result, undecoded
+}
+
+// decodedSecretResult is the json and yaml output of a single-name
+// kubernetes_get with decodeSecretData. UndecodedData is empty when every
+// value was decoded.
+type decodedSecretResult struct {
+	Resource      map[string]interface{} `json:"resource" yaml:"resource"`
+	UndecodedData []UndecodedSecretKey   `json:"undecodedData" yaml:"undecodedData"`
+}
+
+// formatDecodedSecret renders a decoded Secret and its undecoded keys as a
+// JSON or YAML object.
+func formatDecodedSecret(resource *unstructured.Unstructured, undecoded []UndecodedSecretKey, format string, filter *paramutil.ResourceFilter) (string, error) {
+	if filter != nil {
+		resource = filter.Filter(resource)
+	}
+	result := decodedSecretResult{Resource: resource.Object, UndecodedData: undecoded}
+	if result.UndecodedData == nil {
+		result.UndecodedData = []UndecodedSecretKey{}
+	}
+	return formatStructured(result, format)
+}
+
+// formatUndecodedData returns a trailing note listing the Secret keys left
+// base64-encoded, or an empty string when there are none. It is only used for
+// text output; json and yaml carry the keys beside the resource.
+func formatUndecodedData(undecoded []UndecodedSecretKey) string {
+	if len(undecoded) == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(undecoded))
+	for _, u := range undecoded {
+		lines = append(lines, fmt.Sprintf("%s: %s (%s)", u.Name, u.Key, u.Reason))
+	}
+	return "\n\nNote: secret values left base64-encoded in data:\n  " + strings.Join(lines, "\n  ")
+}
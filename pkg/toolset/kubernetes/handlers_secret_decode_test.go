@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDecodeSecretData(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Secret",
+		"metadata": map[string]interface{}{"name": "creds"},
+		"data": map[string]interface{}{
+			"username": "YWRtaW4=",     // admin
+			"binary":   "//79",         // 0xff 0xfe 0xfd, not UTF-8
+			"masked":   "***",          // not base64
+			"empty":    "",             // decodes to ""
+			"password": "aHVudGVyMg==", // hunter2
+		},
+	}}
+
+	got, undecoded := decodeSecretData(secret)
+
+	wantData := map[string]interface{}{"binary": "//79", "masked": "***"}
+	if !reflect.DeepEqual(got.Object["data"], wantData) {
+		t.Errorf("data = %v, want %v", got.Object["data"], wantData)
+	}
+	wantStringData := map[string]interface{}{"username": "admin", "password": "hunter2", "empty": ""}
+	if !reflect.DeepEqual(got.Object["stringData"], wantStringData) {
+		t.Errorf("stringData = %v, want %v", got.Object["stringData"], wantStringData)
+	}
+	wantUndecoded := []UndecodedSecretKey{
+		{Name: "creds", Key: "binary", Reason: "not UTF-8 text"},
+		{Name: "creds", Key: "masked", Reason: "not valid base64"},
+	}
+	if !reflect.DeepEqual(undecoded, wantUndecoded) {
+		t.Errorf("undecoded = %v, want %v", undecoded, wantUndecoded)
+	}
+	// The undecoded keys are reported beside the object, not in its metadata
+	if len(got.GetAnnotations()) != 0 {
+		t.Errorf("the decoded secret's annotations must not be modified, got %v", got.GetAnnotations())
+	}
+	if _, ok := secret.Object["stringData"]; ok {
+		t.Error("the original secret must not be modified")
+	}
+	if len(secret.GetAnnotations()) != 0 {
+		t.Errorf("the original secret's annotations must not be modified, got %v", secret.GetAnnotations())
+	}
+}
+
+func TestDecodeSecretData_LeavesOtherKindsAlone(t *testing.T) {
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "ConfigMap",
+		"data": map[string]interface{}{"value": "YWRtaW4="},
+	}}
+	if got, undecoded := decodeSecretData(cm); got != cm || undecoded != nil {
+		t.Errorf("expected ConfigMap to be returned unchanged, got %v", got.Object)
+	}
+}
+
+func TestDecodeSecretData_AllDecodedDropsData(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Secret",
+		"data": map[string]interface{}{"token": "YWJj"},
+	}}
+	got, undecoded := decodeSecretData(secret)
+	if _, ok := got.Object["data"]; ok {
+		t.Errorf("expected data to be dropped once every value is decoded, got %v", got.Object["data"])
+	}
+	if got.Object["stringData"].(map[string]interface{})["token"] != "abc" {
+		t.Errorf("stringData = %v", got.Object["stringData"])
+	}
+	if len(undecoded) != 0 {
+		t.Errorf("expected no undecoded keys when every value is decoded, got %v", undecoded)
+	}
+}
+
+func TestFormatDecodedSecret(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Secret",
+		"metadata": map[string]interface{}{"name": "creds"},
+		"data":     map[string]interface{}{"binary": "//79"},
+	}}
+	decoded, undecoded := decodeSecretData(secret)
+
+	for _, format := range []string{paramutil.FormatJSON, paramutil.FormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			out, err := formatDecodedSecret(decoded, undecoded, format, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var result decodedSecretResult
+			if format == paramutil.FormatJSON {
+				err = json.Unmarshal([]byte(out), &result)
+			} else {
+				err = yaml.Unmarshal([]byte(out), &result)
+			}
+			if err != nil {
+				t.Fatalf("output is not parseable: %v\n%s", err, out)
+			}
+			if result.Resource["kind"] != "Secret" || len(result.UndecodedData) != 1 || result.UndecodedData[0].Key != "binary" {
+				t.Errorf("result = %+v", result)
+			}
+		})
+	}
+
+	out, err := formatDecodedSecret(decoded, nil, paramutil.FormatJSON, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, `"undecodedData": []`) {
+		t.Errorf("expected an empty undecodedData list, got:\n%s", out)
+	}
+}
+
+func TestFormatUndecodedData(t *testing.T) {
+	if formatUndecodedData(nil) != "" {
+		t.Error("expected no note without undecoded keys")
+	}
+	note := formatUndecodedData([]UndecodedSecretKey{{Name: "creds", Key: "keystore", Reason: "not UTF-8 text"}})
+	if !strings.Contains(note, "secret values left base64-encoded") || !strings.Contains(note, "creds: keystore (not UTF-8 text)") {
+		t.Errorf("unexpected note: %q", note)
+	}
+}
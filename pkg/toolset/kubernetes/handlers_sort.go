@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Sort keys and orders accepted by kubernetes_list.
+const (
+	sortByName      = "name"
+	sortByNamespace = "namespace"
+	sortByCreated   = "created"
+	sortOrderAsc    = "asc"
+	sortOrderDesc   = "desc"
+)
+
+// resourceSort describes how to order a resource list: by one of the
+// well-known keys, or by a field path when path is set.
+type resourceSort struct {
+	key  string
+	path *columnPath
+	desc bool
+}
+
+// parseResourceSort parses the sortBy and sortOrder parameters.
+// Returns nil when sortBy is empty.
+func parseResourceSort(sortBy, sortOrder string) (*resourceSort, error) {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return nil, nil
+	}
+
+	s := &resourceSort{key: sortBy}
+	switch strings.ToLower(strings.TrimSpace(sortOrder)) {
+	case "", sortOrderAsc:
+	case sortOrderDesc:
+		s.desc = true
+	default:
+		return nil, fmt.Errorf("invalid sortOrder %q (must be %s or %s)", sortOrder, sortOrderAsc, sortOrderDesc)
+	}
+
+	switch strings.ToLower(sortBy) {
+	case sortByName, sortByNamespace, sortByCreated:
+		s.key = strings.ToLower(sortBy)
+	default:
+		path, err := parseColumnPath(sortBy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sortBy: %w", err)
+		}
+		s.path = &path
+	}
+	return s, nil
+}
+
+// applyResourceSort orders the list in place. Items missing the sort field
+// come last in either order; items that compare equal keep a stable
+// namespace/name order, so pages over the sorted list are stable.
+func applyResourceSort(list *unstructured.UnstructuredList, s *resourceSort) {
+	if s == nil {
+		return
+	}
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if missingA, missingB := s.missing(a), s.missing(b); missingA != missingB {
+			return missingB
+		}
+		if c := s.compare(a, b); c != 0 {
+			if s.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+// missing reports whether the item has no value for a field path sort key.
+func (s *resourceSort) missing(item unstructured.Unstructured) bool {
+	return s.path != nil && s.path.lookup(item.Object) == noneValue
+}
+
+// compare returns -1, 0 or 1 as a sorts before, equal to, or after b.
+func (s *resourceSort) compare(a, b unstructured.Unstructured) int {
+	if s.path != nil {
+		return compareFieldValues(s.path.lookup(a.Object), s.path.lookup(b.Object))
+	}
+	switch s.key {
+	case sortByNamespace:
+		if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	case sortByCreated:
+		return a.GetCreationTimestamp().Time.Compare(b.GetCreationTimestamp().Time)
+	default: // name
+		return strings.Compare(a.GetName(), b.GetName())
+	}
+}
+
+// compareFieldValues compares two rendered field values, numerically when both
+// are numbers. Missing values compare after present ones; applyResourceSort
+// keeps them last in descending order too.
+func compareFieldValues(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == noneValue:
+		return 1
+	case b == noneValue:
+		return -1
+	}
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
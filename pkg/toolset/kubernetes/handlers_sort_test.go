@@ -0,0 +1,108 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseResourceSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		wantNil   bool
+		wantKey   string
+		wantPath  bool
+		wantDesc  bool
+		wantErr   bool
+	}{
+		{name: "empty", wantNil: true},
+		{name: "name", sortBy: "name", wantKey: sortByName},
+		{name: "created desc", sortBy: "Created", sortOrder: "desc", wantKey: sortByCreated, wantDesc: true},
+		{name: "field path", sortBy: ".status.phase", wantPath: true},
+		{name: "bare field path", sortBy: "spec.replicas", sortOrder: "ASC", wantPath: true},
+		{name: "invalid order", sortBy: "name", sortOrder: "up", wantErr: true},
+		{name: "invalid path", sortBy: ".status[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseResourceSort(tt.sortBy, tt.sortOrder)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseResourceSort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("expected nil sort, got %+v", got)
+				}
+				return
+			}
+			if (got.path != nil) != tt.wantPath || got.desc != tt.wantDesc {
+				t.Errorf("unexpected sort: %+v", got)
+			}
+			if !tt.wantPath && got.key != tt.wantKey {
+				t.Errorf("key = %q, want %q", got.key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestApplyResourceSort(t *testing.T) {
+	now := time.Now()
+	makeItem := func(name, namespace string, age time.Duration, restarts interface{}) unstructured.Unstructured {
+		u := newTestUnstructured(name, namespace, "Pod")
+		u.SetCreationTimestamp(metav1.NewTime(now.Add(-age)))
+		if restarts != nil {
+			_ = unstructured.SetNestedField(u.Object, restarts, "status", "restarts")
+		}
+		return u
+	}
+	newList := func() *unstructured.UnstructuredList {
+		return &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			makeItem("web", "prod", time.Hour, int64(10)),
+			makeItem("api", "prod", 3*time.Hour, int64(2)),
+			makeItem("db", "dev", 2*time.Hour, nil),
+			makeItem("cache", "dev", 30*time.Minute, int64(9)),
+		}}
+	}
+	names := func(list *unstructured.UnstructuredList) string {
+		var out []string
+		for _, item := range list.Items {
+			out = append(out, item.GetName())
+		}
+		return strings.Join(out, ",")
+	}
+
+	tests := []struct {
+		sortBy    string
+		sortOrder string
+		want      string
+	}{
+		{sortBy: "name", want: "api,cache,db,web"},
+		{sortBy: "name", sortOrder: "desc", want: "web,db,cache,api"},
+		{sortBy: "namespace", want: "cache,db,api,web"},
+		{sortBy: "created", sortOrder: "desc", want: "cache,web,db,api"},
+		{sortBy: ".status.restarts", want: "api,cache,web,db"},
+		// Missing values stay last when the order is reversed
+		{sortBy: ".status.restarts", sortOrder: "desc", want: "web,cache,api,db"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy+"/"+tt.sortOrder, func(t *testing.T) {
+			s, err := parseResourceSort(tt.sortBy, tt.sortOrder)
+			if err != nil {
+				t.Fatalf("parseResourceSort() unexpected error: %v", err)
+			}
+			list := newList()
+			applyResourceSort(list, s)
+			if got := names(list); got != tt.want {
+				t.Errorf("order = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
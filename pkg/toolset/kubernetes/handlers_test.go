@@ -1,8 +1,17 @@
 package kubernetes
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -20,6 +29,11 @@ func TestTruncate(t *testing.T) {
 		{"maxLen less than 3", "hello", 2, "he"},
 		{"maxLen 3 exactly", "hello world", 3, "hel"},
 		{"maxLen zero returns full", "hello", 0, "hello"},
+		{"cjk shorter", "部署配置", 4, "部署配置"},
+		{"cjk longer", "生产环境的部署配置", 6, "生产环..."},
+		{"cjk maxLen less than 3", "生产环境", 2, "生产"},
+		{"emoji longer", "🚀🚀🚀🚀🚀", 4, "🚀..."},
+		{"mixed ascii and cjk", "app-前端服务", 7, "app-..."},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -27,6 +41,9 @@ func TestTruncate(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.maxLen, got, tt.want)
 			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncate(%q, %d) = %q is not valid UTF-8", tt.s, tt.maxLen, got)
+			}
 		})
 	}
 }
@@ -137,6 +154,30 @@ func TestFormatAsTable(t *testing.T) {
 		if !containsStr(result, "nginx") || !containsStr(result, "NAME") {
 			t.Errorf("expected table with headers and data, got: %s", result)
 		}
+		if !containsStr(result, "AGE") {
+			t.Errorf("expected AGE column, got: %s", result)
+		}
+	})
+
+	t.Run("auto width", func(t *testing.T) {
+		paramutil.SetTableAutoWidth(true, 50)
+		defer paramutil.SetTableAutoWidth(false, 0)
+
+		longName := "checkout-service-" + strings.Repeat("a", 30)
+		list := &unstructured.UnstructuredList{
+			Items: []unstructured.Unstructured{
+				makeUnstructuredItem(longName, "default", "Deployment"),
+				makeUnstructuredItem(strings.Repeat("b", 80), "default", "Deployment"),
+			},
+		}
+		lines := strings.Split(formatAsTable(list), "\n")
+		// the NAME column is as wide as the truncated long name
+		if !strings.HasPrefix(lines[2], longName+strings.Repeat(" ", 50-len(longName))+"  default") {
+			t.Errorf("expected untruncated name in a sized column, got %q", lines[2])
+		}
+		if !strings.HasPrefix(lines[3], strings.Repeat("b", 47)+"...  default") {
+			t.Errorf("expected name truncated to 50 runes, got %q", lines[3])
+		}
 	})
 }
 
@@ -181,3 +222,173 @@ func containsStr(s, substr string) bool {
 	}
 	return false
 }
+
+func TestUseServerSidePagination(t *testing.T) {
+	tests := []struct {
+		name          string
+		continueToken string
+		nameFilter    string
+		limit         int64
+		page          int64
+		want          bool
+	}{
+		{name: "unfiltered first page", limit: 100, page: 1, want: true},
+		{name: "continue token", continueToken: "abc", limit: 100, page: 1, want: true},
+		{name: "continue token ignores page", continueToken: "abc", limit: 100, page: 3, want: true},
+		{name: "continue token with name filter", continueToken: "abc", nameFilter: "web", limit: 100, page: 1, want: true},
+		{name: "name filter searches full list", nameFilter: "web", limit: 100, page: 1, want: false},
+		{name: "explicit later page", limit: 100, page: 2, want: false},
+		{name: "no limit", continueToken: "abc", limit: 0, page: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := useServerSidePagination(tt.continueToken, tt.nameFilter, tt.limit, tt.page); got != tt.want {
+				t.Errorf("useServerSidePagination() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatContinueNote(t *testing.T) {
+	if got := formatContinueNote(""); got != "" {
+		t.Errorf("formatContinueNote(\"\") = %q, want empty", got)
+	}
+	if got := formatContinueNote("eyJ2IjoibWV0YSJ9"); !strings.Contains(got, `continue="eyJ2IjoibWV0YSJ9"`) {
+		t.Errorf("formatContinueNote() = %q, want continue token", got)
+	}
+}
+
+func TestFormatResourceListPage(t *testing.T) {
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+		{Object: map[string]interface{}{"kind": "Pod", "metadata": map[string]interface{}{"name": "web-1"}}},
+	}}
+
+	for _, token := range []string{"", "eyJ2IjoibWV0YSJ9"} {
+		list.SetContinue(token)
+
+		out, err := formatResourceListPage(list, paramutil.FormatJSON, nil)
+		if err != nil {
+			t.Fatalf("formatResourceListPage(json) error: %v", err)
+		}
+		var page resourceListPage
+		if err := json.Unmarshal([]byte(out), &page); err != nil {
+			t.Fatalf("json output is not parseable: %v\n%s", err, out)
+		}
+		if page.Continue != token || len(page.Items) != 1 || page.Items[0]["kind"] != "Pod" {
+			t.Errorf("json page = %+v, want continue %q", page, token)
+		}
+
+		out, err = formatResourceListPage(list, paramutil.FormatYAML, nil)
+		if err != nil {
+			t.Fatalf("formatResourceListPage(yaml) error: %v", err)
+		}
+		page = resourceListPage{}
+		if err := yaml.Unmarshal([]byte(out), &page); err != nil {
+			t.Fatalf("yaml output is not parseable: %v\n%s", err, out)
+		}
+		if page.Continue != token || len(page.Items) != 1 || page.Items[0]["kind"] != "Pod" {
+			t.Errorf("yaml page = %+v, want continue %q", page, token)
+		}
+	}
+}
+
+func TestUsePagedListShape(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		token  string
+		want   bool
+	}{
+		{name: "no paging", params: map[string]interface{}{"cluster": "c1"}, want: false},
+		{name: "limit", params: map[string]interface{}{"limit": 10}, want: true},
+		{name: "continue", params: map[string]interface{}{"continue": "abc"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := usePagedListShape(tt.params); got != tt.want {
+				t.Errorf("usePagedListShape() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStructuredResourceList_ShapeIndependentOfSize(t *testing.T) {
+	makeList := func(n int, token string) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		for i := 0; i < n; i++ {
+			list.Items = append(list.Items, makeUnstructuredItem(fmt.Sprintf("pod-%d", i), "default", "Pod"))
+		}
+		list.SetContinue(token)
+		return list
+	}
+	// A short list, and a first page cut at the default limit with more to come
+	small := makeList(3, "")
+	full := makeList(DefaultLimit, "eyJ2IjoibWV0YSJ9")
+
+	t.Run("without paging params", func(t *testing.T) {
+		for _, list := range []*unstructured.UnstructuredList{small, full} {
+			out, err := formatStructuredResourceList(map[string]interface{}{"cluster": "c1"}, list, paramutil.FormatJSON, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var items []map[string]interface{}
+			if err := json.Unmarshal([]byte(out), &items); err != nil {
+				t.Fatalf("expected a plain array for %d items: %v", len(list.Items), err)
+			}
+			if len(items) != len(list.Items) {
+				t.Errorf("got %d items, want %d", len(items), len(list.Items))
+			}
+		}
+	})
+
+	t.Run("with limit", func(t *testing.T) {
+		for _, list := range []*unstructured.UnstructuredList{small, full} {
+			out, err := formatStructuredResourceList(map[string]interface{}{"limit": DefaultLimit}, list, paramutil.FormatJSON, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var page resourceListPage
+			if err := json.Unmarshal([]byte(out), &page); err != nil {
+				t.Fatalf("expected a page object for %d items: %v", len(list.Items), err)
+			}
+			if page.Continue != list.GetContinue() {
+				t.Errorf("continue = %q, want %q", page.Continue, list.GetContinue())
+			}
+		}
+	})
+}
+
+func TestIsNamespaceKind(t *testing.T) {
+	for _, kind := range []string{"namespace", "Namespace", "namespaces", "ns", "v1/Namespace"} {
+		if !isNamespaceKind(kind) {
+			t.Errorf("isNamespaceKind(%q) = false, want true", kind)
+		}
+	}
+	for _, kind := range []string{"pod", "secret", "", "example.com/v1/Namespace"} {
+		if isNamespaceKind(kind) {
+			t.Errorf("isNamespaceKind(%q) = true, want false", kind)
+		}
+	}
+}
+
+func TestInvalidateNamespaceProject(t *testing.T) {
+	normanClient := &norman.Client{ProjectCacheTTL: time.Minute}
+	client := &toolset.CombinedClient{Norman: normanClient}
+	detected := norman.NamespaceProject{ProjectID: "c-a:p-1"}
+	normanClient.StoreNamespaceProject("c-a", "web", detected)
+
+	invalidateNamespaceProject(client, "c-a", "ConfigMap", "web")
+	if _, ok := normanClient.CachedNamespaceProject("c-a", "web"); !ok {
+		t.Fatal("a write to a ConfigMap must not invalidate the namespace")
+	}
+
+	invalidateNamespaceProject(client, "c-a", "Namespace", "web")
+	if _, ok := normanClient.CachedNamespaceProject("c-a", "web"); ok {
+		t.Fatal("expected a write to the namespace to invalidate its project")
+	}
+
+	// Clients without a Norman client are ignored
+	invalidateNamespaceProject(&toolset.CombinedClient{}, "c-a", "Namespace", "web")
+	invalidateNamespaceProject(nil, "c-a", "Namespace", "web")
+}
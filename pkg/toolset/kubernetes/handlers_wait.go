@@ -0,0 +1,267 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// States kubernetes_wait reports besides condition statuses and JSONPath values.
+const (
+	waitStateNotFound        = "not found"
+	waitStateExists          = "exists"
+	waitStateRecreated       = "recreated"
+	waitStateConditionAbsent = "condition not reported"
+)
+
+// WaitResult is the outcome of a kubernetes_wait call.
+type WaitResult struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	// For describes the awaited state, e.g. "condition Available=True".
+	For      string `json:"for"`
+	Met      bool   `json:"met"`
+	TimedOut bool   `json:"timedOut"`
+	Elapsed  string `json:"elapsed"`
+	Polls    int    `json:"polls"`
+	// State is the last observed condition status or JSONPath value, or
+	// "exists", "recreated" or "not found".
+	State string `json:"state"`
+	// Message is the reason and message of the awaited condition.
+	Message string `json:"message,omitempty"`
+}
+
+// waitHandler handles the kubernetes_wait tool
+func waitHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := buildWaitRequest(params)
+	if err != nil {
+		return "", err
+	}
+	result, err := waitWithReader(ctx, steveClient, request)
+	if err != nil {
+		return "", err
+	}
+
+	if paramutil.ExtractFormat(params) == paramutil.FormatYAML {
+		return paramutil.FormatAsYAML(result)
+	}
+	return paramutil.FormatAsJSON(result)
+}
+
+// waitRequest holds one target: a condition, deletion, or a JSONPath value.
+type waitRequest struct {
+	cluster   string
+	kind      string
+	namespace string
+	name      string
+
+	conditionType   string
+	conditionStatus string
+	deleted         bool
+	jsonPath        *jsonpath.JSONPath
+	jsonPathExpr    string
+	value           string
+	// filter masks sensitive fields before the jsonPath is evaluated, so
+	// State never reports a value that kubernetes_get would mask
+	filter *paramutil.SensitiveDataFilter
+
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// describe renders the awaited state for WaitResult.For.
+func (r *waitRequest) describe() string {
+	switch {
+	case r.deleted:
+		return "delete"
+	case r.jsonPath != nil:
+		return fmt.Sprintf("jsonPath %s=%s", r.jsonPathExpr, r.value)
+	default:
+		return fmt.Sprintf("condition %s=%s", r.conditionType, r.conditionStatus)
+	}
+}
+
+func buildWaitRequest(params map[string]interface{}) (*waitRequest, error) {
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return nil, err
+	}
+	kind, err := extractResourceKind(params)
+	if err != nil {
+		return nil, err
+	}
+	name, err := paramutil.ExtractRequiredString(params, paramutil.ParamName)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutSeconds := paramutil.ExtractInt64(params, paramutil.ParamTimeoutSeconds, DefaultWaitTimeoutSeconds)
+	if timeoutSeconds < 1 || timeoutSeconds > MaxWaitTimeoutSeconds {
+		return nil, fmt.Errorf("timeoutSeconds must be between 1 and %d, got %d", MaxWaitTimeoutSeconds, timeoutSeconds)
+	}
+	intervalSeconds := paramutil.ExtractInt64(params, paramutil.ParamIntervalSeconds, DefaultWaitIntervalSeconds)
+	intervalSeconds = max(MinIntervalSeconds, min(intervalSeconds, MaxIntervalSeconds))
+
+	request := &waitRequest{
+		cluster:   cluster,
+		kind:      kind,
+		namespace: paramutil.ExtractOptionalString(params, paramutil.ParamNamespace),
+		name:      name,
+		deleted:   paramutil.ExtractBool(params, paramutil.ParamDeleted, false),
+		timeout:   time.Duration(timeoutSeconds) * time.Second,
+		interval:  time.Duration(intervalSeconds) * time.Second,
+	}
+
+	condition := strings.TrimSpace(paramutil.ExtractOptionalString(params, paramutil.ParamCondition))
+	request.jsonPathExpr = strings.TrimSpace(paramutil.ExtractOptionalString(params, paramutil.ParamJSONPath))
+	targets := 0
+	for _, set := range []bool{condition != "", request.deleted, request.jsonPathExpr != ""} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, fmt.Errorf("exactly one of condition, deleted or jsonPath must be set")
+	}
+
+	switch {
+	case condition != "":
+		conditionType, status, found := strings.Cut(condition, "=")
+		request.conditionType = strings.TrimSpace(conditionType)
+		request.conditionStatus = conditionTrue
+		if found {
+			request.conditionStatus = strings.TrimSpace(status)
+		}
+		if request.conditionType == "" || request.conditionStatus == "" {
+			return nil, fmt.Errorf("invalid condition %q: expected Type or Type=Status, e.g. Available=True", condition)
+		}
+	case request.jsonPathExpr != "":
+		if request.jsonPath, err = parseJSONPath(request.jsonPathExpr); err != nil {
+			return nil, err
+		}
+		if request.value, err = paramutil.ExtractRequiredString(params, paramutil.ParamValue); err != nil {
+			return nil, err
+		}
+		if request.filter, err = paramutil.NewSensitiveDataFilterFromParams(params); err != nil {
+			return nil, err
+		}
+	}
+	return request, nil
+}
+
+// waitWithReader polls the resource at request.interval until the awaited
+// state is reached or the timeout elapses. A timeout is not an error; the
+// result reports it with the last observed state.
+func waitWithReader(ctx context.Context, reader steve.ResourceReader, request *waitRequest) (*WaitResult, error) {
+	result := &WaitResult{
+		Kind:      request.kind,
+		Name:      request.name,
+		Namespace: request.namespace,
+		For:       request.describe(),
+	}
+
+	start := time.Now()
+	deadline := start.Add(request.timeout)
+	// uid is the first UID seen, so a deleted and recreated object counts as deleted
+	var uid types.UID
+	for {
+		result.Polls++
+		met, err := evaluateWait(ctx, reader, request, result, &uid)
+		if err != nil {
+			return nil, err
+		}
+		result.Elapsed = time.Since(start).Round(time.Millisecond).String()
+		if met {
+			result.Met = true
+			return result, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			result.TimedOut = true
+			return result, nil
+		}
+		if err := waitForNextIteration(ctx, min(request.interval, remaining)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// evaluateWait fetches the resource once, records its state in result and
+// reports whether the awaited state is reached. A missing resource is a
+// state, not an error: it ends a deletion wait and keeps other waits polling
+// until the resource is created.
+func evaluateWait(ctx context.Context, reader steve.ResourceReader, request *waitRequest, result *WaitResult, uid *types.UID) (bool, error) {
+	obj, err := reader.GetResource(ctx, request.cluster, request.kind, request.namespace, request.name)
+	if err != nil {
+		// The steve client classifies not-found errors as steve.ErrNotFound
+		if !errors.Is(err, steve.ErrNotFound) && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get resource: %w", err)
+		}
+		result.State = waitStateNotFound
+		result.Message = ""
+		return request.deleted, nil
+	}
+	if *uid == "" {
+		*uid = obj.GetUID()
+	}
+
+	switch {
+	case request.deleted:
+		if obj.GetUID() != *uid {
+			result.State = waitStateRecreated
+			return true, nil
+		}
+		result.State = waitStateExists
+		return false, nil
+	case request.jsonPath != nil:
+		if request.filter != nil {
+			obj = request.filter.Filter(obj)
+		}
+		value, err := formatResourceJSONPath(obj, request.jsonPath)
+		if err != nil {
+			return false, err
+		}
+		result.State = value
+		return strings.TrimSpace(value) == request.value, nil
+	default:
+		for _, c := range extractConditions(obj) {
+			if !strings.EqualFold(c.Type, request.conditionType) {
+				continue
+			}
+			result.State = c.Status
+			result.Message = conditionSummary(c)
+			return strings.EqualFold(c.Status, request.conditionStatus), nil
+		}
+		result.State = waitStateConditionAbsent
+		result.Message = ""
+		return false, nil
+	}
+}
+
+// conditionSummary joins the reason and message of a condition.
+func conditionSummary(c ResourceCondition) string {
+	switch {
+	case c.Reason == "":
+		return c.Message
+	case c.Message == "":
+		return c.Reason
+	default:
+		return c.Reason + ": " + c.Message
+	}
+}
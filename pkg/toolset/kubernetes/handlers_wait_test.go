@@ -0,0 +1,269 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBuildWaitRequest(t *testing.T) {
+	base := func(extra map[string]interface{}) map[string]interface{} {
+		params := map[string]interface{}{"cluster": "c1", "kind": "deployment", "name": "web"}
+		for k, v := range extra {
+			params[k] = v
+		}
+		return params
+	}
+
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		wantFor    string
+		wantErr    string
+		wantPeriod time.Duration
+	}{
+		{name: "condition defaults to True", params: base(map[string]interface{}{"condition": "Available"}), wantFor: "condition Available=True", wantPeriod: DefaultWaitIntervalSeconds * time.Second},
+		{name: "condition with status", params: base(map[string]interface{}{"condition": "Ready=False"}), wantFor: "condition Ready=False", wantPeriod: DefaultWaitIntervalSeconds * time.Second},
+		{name: "deleted", params: base(map[string]interface{}{"deleted": true, "intervalSeconds": float64(5000)}), wantFor: "delete", wantPeriod: MaxIntervalSeconds * time.Second},
+		{name: "jsonPath", params: base(map[string]interface{}{"jsonPath": "{.status.phase}", "value": "Running"}), wantFor: "jsonPath {.status.phase}=Running", wantPeriod: DefaultWaitIntervalSeconds * time.Second},
+		{name: "no target", params: base(nil), wantErr: "exactly one of"},
+		{name: "two targets", params: base(map[string]interface{}{"condition": "Ready", "deleted": true}), wantErr: "exactly one of"},
+		{name: "empty condition status", params: base(map[string]interface{}{"condition": "Ready="}), wantErr: "invalid condition"},
+		{name: "jsonPath without value", params: base(map[string]interface{}{"jsonPath": "{.status.phase}"}), wantErr: "missing required parameter"},
+		{name: "timeout too long", params: base(map[string]interface{}{"condition": "Ready", "timeoutSeconds": float64(MaxWaitTimeoutSeconds + 1)}), wantErr: "timeoutSeconds must be between"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := buildWaitRequest(tt.params)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.describe(); got != tt.wantFor {
+				t.Errorf("describe() = %q, want %q", got, tt.wantFor)
+			}
+			if request.interval != tt.wantPeriod {
+				t.Errorf("interval = %s, want %s", request.interval, tt.wantPeriod)
+			}
+		})
+	}
+}
+
+// waitSequenceReader returns the queued get results in order, repeating the last one.
+type waitSequenceReader struct {
+	sequenceResourceReader
+	results []waitGetResult
+	calls   int
+}
+
+type waitGetResult struct {
+	obj *unstructured.Unstructured
+	err error
+}
+
+func (r *waitSequenceReader) GetResource(context.Context, string, string, string, string) (*unstructured.Unstructured, error) {
+	result := r.results[min(r.calls, len(r.results)-1)]
+	r.calls++
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.obj.DeepCopy(), nil
+}
+
+func newWaitTestObject(uid, phase string, conditions ...map[string]interface{}) *unstructured.Unstructured {
+	obj := makeConditionsObject("Deployment", "default", "web", conditions...)
+	obj.SetUID(types.UID(uid))
+	if phase != "" {
+		if err := unstructured.SetNestedField(obj.Object, phase, "status", "phase"); err != nil {
+			panic(err)
+		}
+	}
+	return obj
+}
+
+func TestWaitWithReader(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "web")
+	unavailable := newWaitTestObject("uid-1", "", map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable"})
+	available := newWaitTestObject("uid-1", "", map[string]interface{}{"type": "Available", "status": "True", "reason": "MinimumReplicasAvailable", "message": "Deployment has minimum availability."})
+	pending := newWaitTestObject("uid-1", "Pending")
+	running := newWaitTestObject("uid-1", "Running")
+	recreated := newWaitTestObject("uid-2", "")
+
+	fast := func(r *waitRequest) *waitRequest {
+		r.kind, r.namespace, r.name = "deployment", "default", "web"
+		r.interval = time.Millisecond
+		if r.timeout == 0 {
+			r.timeout = time.Minute
+		}
+		return r
+	}
+
+	tests := []struct {
+		name        string
+		request     *waitRequest
+		results     []waitGetResult
+		wantMet     bool
+		wantTimeout bool
+		wantState   string
+		wantMessage string
+		wantPolls   int
+	}{
+		{
+			name:        "condition becomes true",
+			request:     fast(&waitRequest{conditionType: "available", conditionStatus: "true"}),
+			results:     []waitGetResult{{err: notFound}, {obj: unavailable}, {obj: available}},
+			wantMet:     true,
+			wantState:   "True",
+			wantMessage: "MinimumReplicasAvailable: Deployment has minimum availability.",
+			wantPolls:   3,
+		},
+		{
+			name:        "condition times out",
+			request:     fast(&waitRequest{conditionType: "Available", conditionStatus: "True", timeout: 10 * time.Millisecond}),
+			results:     []waitGetResult{{obj: unavailable}},
+			wantTimeout: true,
+			wantState:   "False",
+			wantMessage: "MinimumReplicasUnavailable",
+		},
+		{
+			name:        "condition not reported",
+			request:     fast(&waitRequest{conditionType: "Ready", conditionStatus: "True", timeout: 5 * time.Millisecond}),
+			results:     []waitGetResult{{obj: running}},
+			wantTimeout: true,
+			wantState:   waitStateConditionAbsent,
+		},
+		{
+			name:      "deleted",
+			request:   fast(&waitRequest{deleted: true}),
+			results:   []waitGetResult{{obj: running}, {obj: running}, {err: notFound}},
+			wantMet:   true,
+			wantState: waitStateNotFound,
+			wantPolls: 3,
+		},
+		{
+			name:      "recreated counts as deleted",
+			request:   fast(&waitRequest{deleted: true}),
+			results:   []waitGetResult{{obj: running}, {obj: recreated}},
+			wantMet:   true,
+			wantState: waitStateRecreated,
+			wantPolls: 2,
+		},
+		{
+			name:      "jsonPath value",
+			request:   fast(&waitRequest{jsonPathExpr: "{.status.phase}", value: "Running"}),
+			results:   []waitGetResult{{obj: pending}, {obj: running}},
+			wantMet:   true,
+			wantState: "Running",
+			wantPolls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.request.jsonPathExpr != "" {
+				jp, err := parseJSONPath(tt.request.jsonPathExpr)
+				if err != nil {
+					t.Fatalf("failed to parse jsonPath: %v", err)
+				}
+				tt.request.jsonPath = jp
+			}
+			reader := &waitSequenceReader{results: tt.results}
+			result, err := waitWithReader(context.Background(), reader, tt.request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Met != tt.wantMet || result.TimedOut != tt.wantTimeout {
+				t.Errorf("met=%v timedOut=%v, want met=%v timedOut=%v", result.Met, result.TimedOut, tt.wantMet, tt.wantTimeout)
+			}
+			if result.State != tt.wantState || result.Message != tt.wantMessage {
+				t.Errorf("state=%q message=%q, want %q and %q", result.State, result.Message, tt.wantState, tt.wantMessage)
+			}
+			if tt.wantPolls > 0 && result.Polls != tt.wantPolls {
+				t.Errorf("polls = %d, want %d", result.Polls, tt.wantPolls)
+			}
+			if result.Elapsed == "" {
+				t.Error("expected elapsed time to be set")
+			}
+		})
+	}
+}
+
+func TestWaitWithReader_ClassifiedNotFound(t *testing.T) {
+	// The fake returns not-found errors wrapped in steve.ErrNotFound, as the
+	// steve client does, rather than as an API status
+	client := fake.NewClient()
+	request := &waitRequest{cluster: "c1", kind: "deployment", namespace: "default", name: "web", deleted: true, timeout: time.Minute, interval: time.Millisecond}
+	result, err := waitWithReader(context.Background(), client, request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Met || result.State != waitStateNotFound || result.Polls != 1 {
+		t.Errorf("result = %+v, want met on the first poll with state %q", result, waitStateNotFound)
+	}
+}
+
+func TestWaitWithReader_GetError(t *testing.T) {
+	reader := &waitSequenceReader{results: []waitGetResult{{err: errors.New("connection refused")}}}
+	_, err := waitWithReader(context.Background(), reader, &waitRequest{deleted: true, timeout: time.Minute, interval: time.Millisecond})
+	if err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Fatalf("expected get error to fail the wait, got %v", err)
+	}
+}
+
+func TestWaitWithReader_JSONPathMasksSecret(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "db", "namespace": "default", "uid": "uid-1"},
+		"data":       map[string]interface{}{"password": "aHVudGVyMg=="},
+	}}
+	params := map[string]interface{}{
+		"cluster":  "c1",
+		"kind":     "secret",
+		"name":     "db",
+		"jsonPath": "{.data.password}",
+		"value":    "aHVudGVyMg==",
+	}
+
+	tests := []struct {
+		name      string
+		show      bool
+		wantMet   bool
+		wantState string
+	}{
+		{name: "masked by default", wantState: "***"},
+		{name: "shown with showSensitiveData", show: true, wantMet: true, wantState: "aHVudGVyMg=="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := maps.Clone(params)
+			p["showSensitiveData"] = tt.show
+			request, err := buildWaitRequest(p)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			request.timeout, request.interval = 5*time.Millisecond, time.Millisecond
+			reader := &waitSequenceReader{results: []waitGetResult{{obj: secret}}}
+			result, err := waitWithReader(context.Background(), reader, request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Met != tt.wantMet || result.State != tt.wantState {
+				t.Errorf("met=%v state=%q, want met=%v state=%q", result.Met, result.State, tt.wantMet, tt.wantState)
+			}
+		})
+	}
+}
@@ -43,6 +43,11 @@ type watchRequest struct {
 	iterations     int64
 	maxItems       int
 	maxOutputBytes int
+	// stableIterations, when positive, ends the watch after that many
+	// consecutive iterations without changes.
+	stableIterations int64
+	// maxDuration, when positive, bounds the total runtime.
+	maxDuration time.Duration
 }
 
 func buildWatchRequest(params map[string]interface{}) (*watchRequest, error) {
@@ -71,6 +76,19 @@ func buildWatchRequest(params map[string]interface{}) (*watchRequest, error) {
 		iterations = MaxIterations
 	}
 
+	var stableIterations int64
+	if paramutil.ExtractBool(params, paramutil.ParamStopWhenStable, false) {
+		stableIterations = paramutil.ExtractInt64(params, paramutil.ParamStableIterations, DefaultStableIterations)
+		if stableIterations < 1 {
+			stableIterations = 1
+		}
+	}
+
+	maxDurationSeconds := paramutil.ExtractInt64(params, paramutil.ParamMaxDurationSeconds, 0)
+	if maxDurationSeconds < 0 {
+		return nil, fmt.Errorf("maxDurationSeconds must be non-negative, got %d", maxDurationSeconds)
+	}
+
 	return &watchRequest{
 		cluster:        cluster,
 		kind:           kind,
@@ -83,6 +101,9 @@ func buildWatchRequest(params map[string]interface{}) (*watchRequest, error) {
 		iterations:     iterations,
 		maxItems:       MaxWatchItems,
 		maxOutputBytes: MaxWatchOutputBytes,
+
+		stableIterations: stableIterations,
+		maxDuration:      time.Duration(maxDurationSeconds) * time.Second,
 	}, nil
 }
 
@@ -101,6 +122,9 @@ func watchDiffWithReader(ctx context.Context, reader steve.ResourceReader, reque
 	var resultLines []string
 	totalOutputBytes := 0
 	previousObjects := make(map[string]*unstructured.Unstructured)
+	start := time.Now()
+	var unchanged int64
+	stopNote := fmt.Sprintf("# completed all %d iterations", request.iterations)
 
 	for i := int64(0); i < request.iterations; i++ {
 		if err := ctx.Err(); err != nil {
@@ -141,18 +165,35 @@ func watchDiffWithReader(ctx context.Context, reader steve.ResourceReader, reque
 
 		previousObjects = diff.currentObjects
 
-		if i+1 < request.iterations {
-			if err := waitForNextIteration(ctx, request.interval); err != nil {
-				return "", err
-			}
+		if i+1 >= request.iterations {
+			break
+		}
+
+		// The first iteration only establishes the baseline, so it never counts as stable.
+		if i > 0 && diff.changeCount+diff.deleteCount == 0 {
+			unchanged++
+		} else {
+			unchanged = 0
+		}
+		if request.stableIterations > 0 && unchanged >= request.stableIterations {
+			stopNote = fmt.Sprintf("# stopped early after iteration %d of %d: no changes for %d consecutive iterations", i+1, request.iterations, unchanged)
+			break
+		}
+		if request.maxDuration > 0 && time.Since(start)+request.interval > request.maxDuration {
+			stopNote = fmt.Sprintf("# stopped after iteration %d of %d: the next iteration would exceed maxDurationSeconds (%s)", i+1, request.iterations, request.maxDuration)
+			break
+		}
+
+		if err := waitForNextIteration(ctx, request.interval); err != nil {
+			return "", err
 		}
 	}
 
 	if len(resultLines) == 0 {
-		return fmt.Sprintf("No changes detected across %d iterations", request.iterations), nil
+		return fmt.Sprintf("No changes detected\n\n%s", stopNote), nil
 	}
 
-	return strings.Join(resultLines, "\n"), nil
+	return strings.Join(resultLines, "\n") + "\n\n" + stopNote, nil
 }
 
 func validateWatchIteration(list *unstructured.UnstructuredList, maxItems int) error {
@@ -142,6 +142,97 @@ func TestBuildIterationOutput_ReturnsEmptyWhenNoDiffs(t *testing.T) {
 	}
 }
 
+func TestWatchDiffWithReader_StopsWhenStable(t *testing.T) {
+	stable := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{newWatchTestObject("apps/v1", "Deployment", "default", "demo", 2)},
+	}
+	reader := &sequenceResourceReader{
+		lists: []*unstructured.UnstructuredList{
+			{Items: []unstructured.Unstructured{newWatchTestObject("apps/v1", "Deployment", "default", "demo", 1)}},
+			stable, stable, stable, stable, stable,
+		},
+	}
+
+	output, err := watchDiffWithReader(context.Background(), reader, &watchRequest{
+		cluster:          "c1",
+		kind:             "deployment",
+		iterations:       10,
+		maxItems:         MaxWatchItems,
+		maxOutputBytes:   MaxWatchOutputBytes,
+		stableIterations: 2,
+	})
+	if err != nil {
+		t.Fatalf("watchDiffWithReader() returned unexpected error: %v", err)
+	}
+	if reader.index != 4 {
+		t.Errorf("listed %d times, want 4 (baseline, change, two stable)", reader.index)
+	}
+	if !strings.HasSuffix(output, "# stopped early after iteration 4 of 10: no changes for 2 consecutive iterations") {
+		t.Errorf("expected stability note, got %q", output)
+	}
+}
+
+func TestWatchDiffWithReader_StopsAtMaxDuration(t *testing.T) {
+	output, err := watchDiffWithReader(context.Background(), &sequenceResourceReader{}, &watchRequest{
+		cluster:        "c1",
+		kind:           "deployment",
+		interval:       time.Hour,
+		iterations:     5,
+		maxItems:       MaxWatchItems,
+		maxOutputBytes: MaxWatchOutputBytes,
+		maxDuration:    time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("watchDiffWithReader() returned unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "No changes detected") || !strings.Contains(output, "stopped after iteration 1 of 5: the next iteration would exceed maxDurationSeconds (1m0s)") {
+		t.Errorf("expected duration note, got %q", output)
+	}
+}
+
+func TestWatchDiffWithReader_ReportsCompletion(t *testing.T) {
+	output, err := watchDiffWithReader(context.Background(), &sequenceResourceReader{}, &watchRequest{
+		cluster:        "c1",
+		kind:           "deployment",
+		iterations:     2,
+		maxItems:       MaxWatchItems,
+		maxOutputBytes: MaxWatchOutputBytes,
+	})
+	if err != nil {
+		t.Fatalf("watchDiffWithReader() returned unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(output, "# completed all 2 iterations") {
+		t.Errorf("expected completion note, got %q", output)
+	}
+}
+
+func TestBuildWatchRequest_StopOptions(t *testing.T) {
+	request, err := buildWatchRequest(map[string]interface{}{
+		"cluster":            "c1",
+		"kind":               "pod",
+		"stopWhenStable":     true,
+		"maxDurationSeconds": float64(90),
+	})
+	if err != nil {
+		t.Fatalf("buildWatchRequest() unexpected error: %v", err)
+	}
+	if request.stableIterations != DefaultStableIterations || request.maxDuration != 90*time.Second {
+		t.Errorf("stableIterations = %d, maxDuration = %s", request.stableIterations, request.maxDuration)
+	}
+
+	request, err = buildWatchRequest(map[string]interface{}{"cluster": "c1", "kind": "pod", "stableIterations": float64(5)})
+	if err != nil {
+		t.Fatalf("buildWatchRequest() unexpected error: %v", err)
+	}
+	if request.stableIterations != 0 {
+		t.Errorf("stableIterations without stopWhenStable = %d, want 0", request.stableIterations)
+	}
+
+	if _, err := buildWatchRequest(map[string]interface{}{"cluster": "c1", "kind": "pod", "maxDurationSeconds": float64(-1)}); err == nil {
+		t.Error("expected error for negative maxDurationSeconds")
+	}
+}
+
 func TestBuildIterationOutput_FormatsHeaderAndDiffs(t *testing.T) {
 	output := buildIterationOutput(2, 3, 1, 1, []string{"diff-a", "diff-b"})
 	want := "# iteration 2 resources=3 changes=1 deletions=1\n\ndiff-a\ndiff-b"
@@ -178,7 +269,7 @@ func (r *sequenceResourceReader) ListResources(context.Context, string, string,
 	return current.DeepCopy(), nil
 }
 
-func (r *sequenceResourceReader) GetEvents(context.Context, string, string, string, string) ([]corev1.Event, error) {
+func (r *sequenceResourceReader) GetEvents(context.Context, string, string, string, string, *steve.ListOptions) ([]corev1.Event, error) {
 	return nil, nil
 }
 
@@ -0,0 +1,235 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// wideColumns describes the extra columns shown for a kind in wide format.
+type wideColumns struct {
+	headers []string
+	values  func(obj map[string]interface{}) []string
+}
+
+// wideColumnsByKind holds the kinds with kind-specific wide columns, keyed by lowercase kind.
+var wideColumnsByKind = map[string]wideColumns{
+	"pod": {
+		headers: []string{"READY", "STATUS", "RESTARTS", "NODE"},
+		values:  podWideValues,
+	},
+	"deployment": {
+		headers: []string{"READY", "UP-TO-DATE", "AVAILABLE"},
+		values:  deploymentWideValues,
+	},
+	"service": {
+		headers: []string{"TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORT(S)"},
+		values:  serviceWideValues,
+	},
+}
+
+// formatAsWideTable renders NAME and NAMESPACE, kind-specific columns and AGE.
+// Lists of unknown or mixed kinds fall back to the generic table.
+func formatAsWideTable(list *unstructured.UnstructuredList) string {
+	if len(list.Items) == 0 {
+		return "No resources found"
+	}
+
+	headers, rows, ok := wideTableRows(list)
+	if !ok {
+		return formatAsTable(list)
+	}
+	return paramutil.FormatAsTable(rows, headers)
+}
+
+// wideTableRows returns the wide headers and rows of list, or false when the
+// list holds an unknown kind or mixed kinds.
+func wideTableRows(list *unstructured.UnstructuredList) ([]string, []map[string]string, bool) {
+	if len(list.Items) == 0 {
+		return nil, nil, false
+	}
+	kind := strings.ToLower(list.Items[0].GetKind())
+	columns, ok := wideColumnsByKind[kind]
+	if !ok {
+		return nil, nil, false
+	}
+	for _, item := range list.Items[1:] {
+		if strings.ToLower(item.GetKind()) != kind {
+			return nil, nil, false
+		}
+	}
+
+	headers := append([]string{"NAME", "NAMESPACE"}, columns.headers...)
+	headers = append(headers, "AGE")
+
+	rows := make([]map[string]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespace := item.GetNamespace()
+		if namespace == "" {
+			namespace = "-"
+		}
+		row := map[string]string{
+			"NAME":      item.GetName(),
+			"NAMESPACE": namespace,
+			"AGE":       resourceAge(item),
+		}
+		for i, value := range columns.values(item.Object) {
+			row[columns.headers[i]] = value
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, true
+}
+
+// podWideValues returns READY, STATUS, RESTARTS and NODE for a pod.
+func podWideValues(obj map[string]interface{}) []string {
+	containers, _, _ := unstructured.NestedSlice(obj, "spec", "containers")
+	statuses, _, _ := unstructured.NestedSlice(obj, "status", "containerStatuses")
+
+	ready, restarts := 0, int64(0)
+	for _, s := range statuses {
+		status, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if r, _, _ := unstructured.NestedBool(status, "ready"); r {
+			ready++
+		}
+		count, _, _ := unstructured.NestedInt64(status, "restartCount")
+		restarts += count
+	}
+
+	node, _, _ := unstructured.NestedString(obj, "spec", "nodeName")
+	if node == "" {
+		node = noneValue
+	}
+
+	return []string{
+		fmt.Sprintf("%d/%d", ready, len(containers)),
+		podDisplayStatus(obj, statuses),
+		strconv.FormatInt(restarts, 10),
+		node,
+	}
+}
+
+// podDisplayStatus approximates the STATUS column of kubectl get pods: a
+// container's waiting or terminated reason takes precedence over the phase.
+func podDisplayStatus(obj map[string]interface{}, statuses []interface{}) string {
+	if _, found, _ := unstructured.NestedString(obj, "metadata", "deletionTimestamp"); found {
+		return "Terminating"
+	}
+
+	status, _, _ := unstructured.NestedString(obj, "status", "phase")
+	if reason, _, _ := unstructured.NestedString(obj, "status", "reason"); reason != "" {
+		status = reason
+	}
+	for _, s := range statuses {
+		containerStatus, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if reason, _, _ := unstructured.NestedString(containerStatus, "state", "waiting", "reason"); reason != "" {
+			return reason
+		}
+		if reason, _, _ := unstructured.NestedString(containerStatus, "state", "terminated", "reason"); reason != "" {
+			status = reason
+		}
+	}
+	if status == "" {
+		return "Unknown"
+	}
+	return status
+}
+
+// deploymentWideValues returns READY, UP-TO-DATE and AVAILABLE for a deployment.
+func deploymentWideValues(obj map[string]interface{}) []string {
+	replicas, found, _ := unstructured.NestedInt64(obj, "spec", "replicas")
+	if !found {
+		replicas = 1 // API default
+	}
+	readyReplicas, _, _ := unstructured.NestedInt64(obj, "status", "readyReplicas")
+	updatedReplicas, _, _ := unstructured.NestedInt64(obj, "status", "updatedReplicas")
+	availableReplicas, _, _ := unstructured.NestedInt64(obj, "status", "availableReplicas")
+
+	return []string{
+		fmt.Sprintf("%d/%d", readyReplicas, replicas),
+		strconv.FormatInt(updatedReplicas, 10),
+		strconv.FormatInt(availableReplicas, 10),
+	}
+}
+
+// serviceWideValues returns TYPE, CLUSTER-IP, EXTERNAL-IP and PORT(S) for a service.
+func serviceWideValues(obj map[string]interface{}) []string {
+	serviceType, _, _ := unstructured.NestedString(obj, "spec", "type")
+	if serviceType == "" {
+		serviceType = "ClusterIP"
+	}
+	clusterIP, _, _ := unstructured.NestedString(obj, "spec", "clusterIP")
+	if clusterIP == "" {
+		clusterIP = noneValue
+	}
+
+	var ports []string
+	rawPorts, _, _ := unstructured.NestedSlice(obj, "spec", "ports")
+	for _, p := range rawPorts {
+		port, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		number, _, _ := unstructured.NestedInt64(port, "port")
+		protocol, _, _ := unstructured.NestedString(port, "protocol")
+		if protocol == "" {
+			protocol = "TCP"
+		}
+		entry := strconv.FormatInt(number, 10)
+		if nodePort, found, _ := unstructured.NestedInt64(port, "nodePort"); found && nodePort > 0 {
+			entry += ":" + strconv.FormatInt(nodePort, 10)
+		}
+		ports = append(ports, entry+"/"+protocol)
+	}
+	portList := strings.Join(ports, ",")
+	if portList == "" {
+		portList = noneValue
+	}
+
+	return []string{serviceType, clusterIP, serviceExternalIP(obj, serviceType), portList}
+}
+
+// serviceExternalIP returns the external addresses of a service, as kubectl shows them.
+func serviceExternalIP(obj map[string]interface{}, serviceType string) string {
+	if serviceType == "ExternalName" {
+		name, _, _ := unstructured.NestedString(obj, "spec", "externalName")
+		return name
+	}
+
+	addresses, _, _ := unstructured.NestedStringSlice(obj, "spec", "externalIPs")
+	ingress, _, _ := unstructured.NestedSlice(obj, "status", "loadBalancer", "ingress")
+	for _, i := range ingress {
+		entry, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ip, _, _ := unstructured.NestedString(entry, "ip"); ip != "" {
+			addresses = append(addresses, ip)
+		} else if hostname, _, _ := unstructured.NestedString(entry, "hostname"); hostname != "" {
+			addresses = append(addresses, hostname)
+		}
+	}
+
+	if len(addresses) > 0 {
+		return strings.Join(addresses, ",")
+	}
+	if serviceType == "LoadBalancer" {
+		return "<pending>"
+	}
+	return noneValue
+}
+
+// resourceAge returns the age of a resource from metadata.creationTimestamp,
+// or "-" when it is unset.
+func resourceAge(item unstructured.Unstructured) string {
+	return paramutil.HumanizeAge(item.GetCreationTimestamp().Time)
+}
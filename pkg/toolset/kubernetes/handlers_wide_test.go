@@ -0,0 +1,164 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPodWideValues(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  map[string]interface{}
+		want []string
+	}{
+		{
+			name: "running",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"nodeName":   "node-a",
+					"containers": []interface{}{map[string]interface{}{"name": "app"}, map[string]interface{}{"name": "sidecar"}},
+				},
+				"status": map[string]interface{}{
+					"phase": "Running",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{"ready": true, "restartCount": int64(2), "state": map[string]interface{}{"running": map[string]interface{}{}}},
+						map[string]interface{}{"ready": true, "restartCount": int64(1), "state": map[string]interface{}{"running": map[string]interface{}{}}},
+					},
+				},
+			},
+			want: []string{"2/2", "Running", "3", "node-a"},
+		},
+		{
+			name: "crash looping",
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"nodeName": "node-b", "containers": []interface{}{map[string]interface{}{"name": "app"}}},
+				"status": map[string]interface{}{
+					"phase": "Running",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{"ready": false, "restartCount": int64(7), "state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"}}},
+					},
+				},
+			},
+			want: []string{"0/1", "CrashLoopBackOff", "7", "node-b"},
+		},
+		{
+			name: "pending unscheduled",
+			obj: map[string]interface{}{
+				"spec":   map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}},
+				"status": map[string]interface{}{"phase": "Pending"},
+			},
+			want: []string{"0/1", "Pending", "0", "<none>"},
+		},
+		{
+			name: "terminating",
+			obj: map[string]interface{}{
+				"metadata": map[string]interface{}{"deletionTimestamp": "2026-01-01T00:00:00Z"},
+				"spec":     map[string]interface{}{"nodeName": "node-a"},
+				"status":   map[string]interface{}{"phase": "Running"},
+			},
+			want: []string{"0/0", "Terminating", "0", "node-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := podWideValues(tt.obj)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("podWideValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentWideValues(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec":   map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{"readyReplicas": int64(2), "updatedReplicas": int64(3), "availableReplicas": int64(2)},
+	}
+	if got := strings.Join(deploymentWideValues(obj), "|"); got != "2/3|3|2" {
+		t.Errorf("deploymentWideValues() = %s, want 2/3|3|2", got)
+	}
+}
+
+func TestServiceWideValues(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  map[string]interface{}
+		want string
+	}{
+		{
+			name: "cluster ip",
+			obj: map[string]interface{}{"spec": map[string]interface{}{
+				"clusterIP": "10.43.0.10",
+				"ports":     []interface{}{map[string]interface{}{"port": int64(53), "protocol": "UDP"}, map[string]interface{}{"port": int64(53)}},
+			}},
+			want: "ClusterIP|10.43.0.10|<none>|53/UDP,53/TCP",
+		},
+		{
+			name: "pending load balancer",
+			obj: map[string]interface{}{"spec": map[string]interface{}{
+				"type":      "LoadBalancer",
+				"clusterIP": "10.43.0.20",
+				"ports":     []interface{}{map[string]interface{}{"port": int64(80), "nodePort": int64(30080), "protocol": "TCP"}},
+			}},
+			want: "LoadBalancer|10.43.0.20|<pending>|80:30080/TCP",
+		},
+		{
+			name: "load balancer with ingress",
+			obj: map[string]interface{}{
+				"spec":   map[string]interface{}{"type": "LoadBalancer", "clusterIP": "10.43.0.20"},
+				"status": map[string]interface{}{"loadBalancer": map[string]interface{}{"ingress": []interface{}{map[string]interface{}{"ip": "203.0.113.5"}}}},
+			},
+			want: "LoadBalancer|10.43.0.20|203.0.113.5|<none>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.Join(serviceWideValues(tt.obj), "|"); got != tt.want {
+				t.Errorf("serviceWideValues() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatResourceList_Wide(t *testing.T) {
+	pod := newTestUnstructured("pod-1", "default", "Pod")
+	_ = unstructured.SetNestedField(pod.Object, "node-a", "spec", "nodeName")
+	_ = unstructured.SetNestedField(pod.Object, "Running", "status", "phase")
+	pod.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-3 * time.Hour)))
+
+	out, err := formatResourceList(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{pod}}, "wide", nil, nil)
+	if err != nil {
+		t.Fatalf("formatResourceList() unexpected error: %v", err)
+	}
+	for _, want := range []string{"STATUS", "RESTARTS", "NODE", "AGE", "node-a", "Running", "3h"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected wide table to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	t.Run("unknown kind falls back", func(t *testing.T) {
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{newTestUnstructured("cfg", "default", "ConfigMap")}}
+		out, err := formatResourceList(list, "wide", nil, nil)
+		if err != nil {
+			t.Fatalf("formatResourceList() unexpected error: %v", err)
+		}
+		if out != formatAsTable(list) {
+			t.Errorf("expected generic table for unknown kind, got:\n%s", out)
+		}
+	})
+
+	t.Run("mixed kinds fall back", func(t *testing.T) {
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{pod, newTestUnstructured("web", "default", "Service")}}
+		out, err := formatResourceList(list, "wide", nil, nil)
+		if err != nil {
+			t.Fatalf("formatResourceList() unexpected error: %v", err)
+		}
+		if !strings.Contains(out, "KIND") {
+			t.Errorf("expected generic table for mixed kinds, got:\n%s", out)
+		}
+	})
+}
@@ -23,6 +23,14 @@ var showSensitiveDataProperty = map[string]any{
 	"default":     false,
 }
 
+// maskPathsProperty is the shared schema for the maskPaths parameter used by
+// the tools that mask sensitive data.
+var maskPathsProperty = map[string]any{
+	"type":        "string",
+	"description": "Comma-separated extra fields to mask with '***' in resources of any kind, applied even when showSensitiveData is true. Each entry is a dotted field path (e.g. 'data.token,metadata.annotations.example.com/password') or a regular expression in slashes matched against dotted field paths, with list items as [i] (e.g. '/env\\[\\d+\\]\\.value$/')",
+	"default":     "",
+}
+
 var apiVersionProperty = map[string]any{
 	"type":        "string",
 	"description": "Kubernetes API version for CRDs or ambiguous kinds, e.g. catalog.cattle.io/v1. Optional for built-in resources.",
@@ -34,6 +42,13 @@ var clusterIDProperty = map[string]any{
 	"description": "Cluster ID (use cluster_list tool to get available cluster IDs)",
 }
 
+var diffFormatProperty = map[string]any{
+	"type":        "string",
+	"description": "Output format: text for a git-style diff, json for a list of changes with path, operation (add/remove/replace), oldValue and newValue",
+	"enum":        []string{"text", "json"},
+	"default":     "text",
+}
+
 // GetName returns the name of the toolset
 func (t *Toolset) GetName() string {
 	return "kubernetes"
@@ -14,6 +14,17 @@ func aggregateTools() []toolset.ServerTool {
 		workloadHealthTool(),
 		resourceSummaryTool(),
 		eventSummaryTool(),
+		pvcStatusTool(),
+		hpaStatusTool(),
+		cronJobStatusTool(),
+		networkPolicyListTool(),
+		namespaceQuotaTool(),
+		imagesTool(),
+		countsTool(),
+		unhealthyPodsTool(),
+		deploymentHealthTool(),
+		pdbStatusTool(),
+		serviceHealthTool(),
 	}
 }
 
@@ -45,8 +56,8 @@ func topTool() toolset.ServerTool {
 					},
 					"sortBy": map[string]any{
 						"type":        "string",
-						"description": "Sort by field. For pods: cpu.util, mem.util, cpu.request, mem.request, cpu.limit, mem.limit, restart.count. For nodes: cpu.util, mem.util, cpu.util.percentage, mem.util.percentage, pod.count",
-						"enum":        []string{"", "cpu.util", "mem.util", "cpu.request", "mem.request", "cpu.limit", "mem.limit", "cpu.util.percentage", "mem.util.percentage", "restart.count", "pod.count", "name"},
+						"description": "Sort by field. 'cpu' and 'memory' are shorthands for cpu.util and mem.util (live usage from metrics-server). For pods: cpu.util, mem.util, cpu.request, mem.request, cpu.limit, mem.limit, restart.count. For nodes: cpu.util, mem.util, cpu.util.percentage, mem.util.percentage, pod.count",
+						"enum":        []string{"", "cpu", "memory", "cpu.util", "mem.util", "cpu.request", "mem.request", "cpu.limit", "mem.limit", "cpu.util.percentage", "mem.util.percentage", "restart.count", "pod.count", "name"},
 						"default":     "",
 					},
 					"limit": map[string]any{
@@ -74,7 +85,7 @@ func workloadHealthTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_workload_health",
-			Description: "Get a health summary for Deployments, StatefulSets, and DaemonSets. Shows ready vs desired replicas, unavailable count, update progress, and derived status.",
+			Description: "Get a health summary for Deployments, StatefulSets, and DaemonSets. Shows ready vs desired replicas, unavailable count, update progress, and derived status. Filter by name or image substring to find workloads across all namespaces.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster"},
@@ -96,6 +107,22 @@ func workloadHealthTool() toolset.ServerTool {
 						"description": "Label selector for filtering (e.g., 'app=nginx,env=prod')",
 						"default":     "",
 					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Only workloads whose name contains this substring (case-insensitive)",
+						"default":     "",
+					},
+					"image": map[string]any{
+						"type":        "string",
+						"description": "Only workloads with a container image containing this substring (case-insensitive), e.g. 'nginx:1.25'",
+						"default":     "",
+					},
+					"includeContainers": map[string]any{
+						"type":        "boolean",
+						"description": "Include each workload's containers: image, resource requests/limits, ports, and env var names (literal values masked unless sensitive data is shown)",
+						"default":     false,
+					},
+					"showSensitiveData": showSensitiveDataProperty,
 					"sortBy": map[string]any{
 						"type":        "string",
 						"description": "Sort by field: unready.count, ready.ratio, name",
@@ -238,3 +265,478 @@ func eventSummaryTool() toolset.ServerTool {
 		Handler: eventSummaryHandler,
 	}
 }
+
+func pvcStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_pvc_status",
+			Description: "Report PersistentVolumeClaim status: phase, requested vs provisioned capacity, storage class, bound volume, and access modes, sorted by requested size. Flags PVCs stuck in Pending, lost volumes, and pending resizes.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering (e.g., 'app=postgres')",
+						"default":     "",
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: pvcStatusHandler,
+	}
+}
+
+func hpaStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_hpa_status",
+			Description: "Report HorizontalPodAutoscaler status: scale target, min/max and current replicas, current vs target metric values, and scaling conditions (AbleToScale, ScalingActive, ScalingLimited). Explains why a workload is or isn't scaling. Supports autoscaling/v2 and v1 objects.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering (e.g., 'app=web')",
+						"default":     "",
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: hpaStatusHandler,
+	}
+}
+
+func cronJobStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_cronjob_status",
+			Description: "Report CronJob status: schedule, suspend flag, last schedule and last successful time, active job count, and the success/failure counts of the Jobs each CronJob owns. Highlights CronJobs whose last run failed or that are suspended.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering (e.g., 'app=backup')",
+						"default":     "",
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: cronJobStatusHandler,
+	}
+}
+
+func networkPolicyListTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_networkpolicy_list",
+			Description: "List NetworkPolicies with their pod selector, policy types, ingress/egress rule counts, and whether they are a namespace-wide default deny. With checkCoverage, also report namespaces whose pods are not selected by any NetworkPolicy (no isolation).",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"checkCoverage": map[string]any{
+						"type":        "boolean",
+						"description": "Also list pods, and report namespaces with pods that no NetworkPolicy selects",
+						"default":     false,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: networkPolicyListHandler,
+	}
+}
+
+func namespaceQuotaTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_namespace_quota",
+			Description: "Report ResourceQuota usage in a namespace: hard limit vs used and percentage for each tracked resource, flagging entries near (>=90%), at, or over their limit. Also lists LimitRange min, max, default request, and default limit per resource.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: namespaceQuotaHandler,
+	}
+}
+
+func imagesTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_images",
+			Description: "List the distinct container images run by pods (including init and ephemeral containers), with the number of pods using each and the namespaces and workloads that reference them, sorted by pod count. Useful as an image bill of materials for vulnerability and patching audits.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering pods (e.g., 'app=nginx')",
+						"default":     "",
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of images to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: imagesHandler,
+	}
+}
+
+func countsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_counts",
+			Description: "Count resources per kind, optionally per namespace, for a quick overview of a cluster's shape without returning the objects. Kinds are listed in parallel and page by page; the counts are sorted highest first. Kinds that cannot be listed (e.g. forbidden) are reported separately.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"kinds": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated kinds to count (e.g., 'pod,deployment,crd'). Empty counts pods, deployments, statefulsets, daemonsets, replicasets, jobs, cronjobs, services, ingresses, configmaps, secrets, persistentvolumeclaims and serviceaccounts",
+						"default":     "",
+					},
+					"byNamespace": map[string]any{
+						"type":        "boolean",
+						"description": "Split the counts of each kind per namespace",
+						"default":     false,
+					},
+					"concurrency": map[string]any{
+						"type":        "integer",
+						"description": "Number of kinds listed in parallel",
+						"default":     5,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: countsHandler,
+	}
+}
+
+func unhealthyPodsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_unhealthy_pods",
+			Description: "Triage view of pods that are not healthy: CrashLoopBackOff, OOMKilled, image pull failures, container config errors, Failed, Evicted, Pending longer than a threshold, or restarting more than a threshold. Each pod has the failing container and reason, a one-line problem description and its latest event, sorted by severity. Use kubernetes_inspect_pod to dig into one pod.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering pods (e.g., 'app=nginx')",
+						"default":     "",
+					},
+					"pendingThreshold": map[string]any{
+						"type":        "string",
+						"description": "Report Pending pods older than this duration (e.g., '5m', '1h')",
+						"default":     "5m",
+					},
+					"restartThreshold": map[string]any{
+						"type":        "integer",
+						"description": "Report pods with a container that restarted more than this many times; 0 disables the check",
+						"default":     5,
+					},
+					"includeEvents": map[string]any{
+						"type":        "boolean",
+						"description": "Attach the latest event (warnings first) of each reported pod",
+						"default":     true,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of pods to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: unhealthyPodsHandler,
+	}
+}
+
+func deploymentHealthTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_deployment_health",
+			Description: "Bulk health scan of Deployments: desired/ready/updated/available replicas and a verdict per Deployment. Flags Deployments with fewer ready replicas than desired (Degraded, Unavailable), a spec generation the controller has not observed (Stalled), or an exceeded progress deadline (Failed), with how long the discrepancy has lasted. Unhealthy Deployments are listed first. Use kubernetes_rollout_status to follow a single rollout.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering (e.g., 'app=web')",
+						"default":     "",
+					},
+					"unhealthyOnly": map[string]any{
+						"type":        "boolean",
+						"description": "Only list Deployments that need attention",
+						"default":     false,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: deploymentHealthHandler,
+	}
+}
+
+func pdbStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_pdb_status",
+			Description: "Report PodDisruptionBudget status: minAvailable/maxUnavailable, current vs desired healthy pods, expected pods and allowed disruptions. Flags budgets that allow no disruptions, which block evictions and therefore kubernetes_drain. Use it to find out why a node won't drain.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering (e.g., 'app=web')",
+						"default":     "",
+					},
+					"blockingOnly": map[string]any{
+						"type":        "boolean",
+						"description": "Only list budgets that currently block node drains",
+						"default":     false,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: pdbStatusHandler,
+	}
+}
+
+func serviceHealthTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_service_health",
+			Description: "List Services with their backend readiness: ready/total pods matched by the selector, whether the Service has any ready backend, and whether it is degraded (only some backends ready). Headless Services and Services without a selector are judged by their EndpointSlice endpoints and ExternalName Services are ready when externalName is set; the readiness field says which applies. Not ready and degraded Services are listed first. Use kubernetes_endpoints for the addresses of a single Service.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional, empty for all namespaces)",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector for filtering services (e.g., 'app=web')",
+						"default":     "",
+					},
+					"unhealthyOnly": map[string]any{
+						"type":        "boolean",
+						"description": "Only list services that are not ready or degraded",
+						"default":     false,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of results to return",
+						"default":     50,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, or yaml",
+						"enum":        []string{"json", "table", "yaml"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: serviceHealthHandler,
+	}
+}
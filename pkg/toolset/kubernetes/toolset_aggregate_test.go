@@ -14,6 +14,14 @@ func TestAggregateToolsRegisteredAsReadOnly(t *testing.T) {
 		"kubernetes_workload_health",
 		"kubernetes_resource_summary",
 		"kubernetes_event_summary",
+		"kubernetes_pvc_status",
+		"kubernetes_hpa_status",
+		"kubernetes_cronjob_status",
+		"kubernetes_networkpolicy_list",
+		"kubernetes_images",
+		"kubernetes_deployment_health",
+		"kubernetes_pdb_status",
+		"kubernetes_service_health",
 	} {
 		st, ok := tools[name]
 		if !ok {
@@ -31,11 +39,25 @@ func TestAggregateToolsRegisteredAsReadOnly(t *testing.T) {
 	}
 }
 
+func TestNamespaceQuotaToolRequiresNamespace(t *testing.T) {
+	st, ok := mapToolsByName((&Toolset{}).GetTools(nil))["kubernetes_namespace_quota"]
+	if !ok {
+		t.Fatal("kubernetes_namespace_quota is not registered")
+	}
+	if st.Annotations.ReadOnlyHint == nil || !*st.Annotations.ReadOnlyHint {
+		t.Fatalf("ReadOnlyHint = %v, want true", st.Annotations.ReadOnlyHint)
+	}
+	if !reflect.DeepEqual(st.Tool.InputSchema.Required, []string{"cluster", "namespace"}) {
+		t.Fatalf("required = %#v, want [cluster namespace]", st.Tool.InputSchema.Required)
+	}
+	assertDefault(t, st, "format", "table")
+}
+
 func TestAggregateToolSchemasExposeExpectedEnums(t *testing.T) {
 	tools := mapToolsByName((&Toolset{}).GetTools(nil))
 
 	assertEnum(t, tools["kubernetes_top"], "kind", []string{"pod", "node"})
-	assertEnum(t, tools["kubernetes_top"], "sortBy", []string{"", "cpu.util", "mem.util", "cpu.request", "mem.request", "cpu.limit", "mem.limit", "cpu.util.percentage", "mem.util.percentage", "restart.count", "pod.count", "name"})
+	assertEnum(t, tools["kubernetes_top"], "sortBy", []string{"", "cpu", "memory", "cpu.util", "mem.util", "cpu.request", "mem.request", "cpu.limit", "mem.limit", "cpu.util.percentage", "mem.util.percentage", "restart.count", "pod.count", "name"})
 	assertDefault(t, tools["kubernetes_top"], "limit", 50)
 	assertDefault(t, tools["kubernetes_top"], "format", "table")
 
@@ -13,10 +13,18 @@ import (
 func analysisTools() []toolset.ServerTool {
 	return []toolset.ServerTool{
 		depTool(),
+		treeTool(),
+		conditionsTool(),
+		waitTool(),
 		nodeAnalysisTool(),
+		endpointsTool(),
+		schedulingTool(),
+		secretRefsTool(),
+		configMapRefsTool(),
 		resourceDiffTool(),
 		watchTool(),
 		diffTool(),
+		liveDiffTool(),
 		capacityTool(),
 	}
 }
@@ -66,6 +74,14 @@ func depTool() toolset.ServerTool {
 						"description": "Optional fail-fast budget for total scanned objects. When set to a value greater than 0, kubernetes_dep aborts instead of building a partial graph after the budget is exceeded.",
 						"default":     0,
 					},
+					"includeKinds": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated kinds to show (e.g., 'Service,Endpoints'); other kinds are hidden and their descendants attach to the nearest shown ancestor. The root is always shown.",
+					},
+					"excludeKinds": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated kinds to hide (e.g., 'Event,ReplicaSet'); their descendants attach to the nearest shown ancestor",
+					},
 					"format": map[string]any{
 						"type":        "string",
 						"description": "Output format: tree (human-readable) or json (structured)",
@@ -82,11 +98,169 @@ func depTool() toolset.ServerTool {
 	}
 }
 
+func treeTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_tree",
+			Description: "Show the ownership tree below a Kubernetes resource, like kubectl tree: the objects whose ownerReferences chain back to it (e.g. Deployment -> ReplicaSet -> Pod), with ready and status columns. Unlike kubernetes_dep it follows ownerReferences only, without Service, volume, RBAC or Event edges.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (e.g., deployment, cronjob, statefulset). For CRDs, pass the manifest kind and optionally apiVersion.",
+					},
+					"apiVersion": apiVersionProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional for cluster-scoped resources)",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Resource name",
+					},
+					"depth": map[string]any{
+						"type":        "integer",
+						"description": "Maximum tree depth (1-20)",
+						"default":     DefaultMaxDepth,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: tree (human-readable) or json (structured)",
+						"enum":        []string{"tree", "json"},
+						"default":     "tree",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: treeHandler,
+	}
+}
+
+func conditionsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_conditions",
+			Description: "Summarize the status.conditions of any Kubernetes resource, including CRDs such as cert-manager Certificates or operator resources: type, status, reason, message and last transition time, with each condition flagged healthy or not. A condition is healthy at status True, or False for problem types such as DiskPressure, ReplicaFailure, Failed, Stalled or Degraded.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (e.g., deployment, node, Certificate). For CRDs, pass the manifest kind and optionally apiVersion.",
+					},
+					"apiVersion": apiVersionProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional for cluster-scoped resources)",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Resource name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: table, json, yaml, csv, or markdown",
+						"enum":        []string{"table", "json", "yaml", "csv", "markdown"},
+						"default":     "table",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: conditionsHandler,
+	}
+}
+
+func waitTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_wait",
+			Description: "Wait for a Kubernetes resource to reach a state, like kubectl wait: a status condition reaching a status (e.g. Available=True), deletion, or a JSONPath expression equalling a value. Polls every intervalSeconds until the state is reached or timeoutSeconds elapses, and returns whether it was met, the elapsed time and the last observed state. Set exactly one of condition, deleted or jsonPath.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (e.g., deployment, pod, job, Certificate). For CRDs, pass the manifest kind and optionally apiVersion.",
+					},
+					"apiVersion": apiVersionProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (optional for cluster-scoped resources)",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Resource name",
+					},
+					"condition": map[string]any{
+						"type":        "string",
+						"description": "Status condition to wait for as Type or Type=Status, e.g. 'Available', 'Ready=True' or 'Complete'. The status defaults to True. A resource that does not exist yet is polled until it is created.",
+					},
+					"deleted": map[string]any{
+						"type":        "boolean",
+						"description": "Wait until the resource no longer exists, or has been recreated under a new UID",
+						"default":     false,
+					},
+					"jsonPath": map[string]any{
+						"type":        "string",
+						"description": "JSONPath expression (kubectl syntax) to wait on, e.g. '{.status.phase}'; requires value",
+					},
+					"value": map[string]any{
+						"type":        "string",
+						"description": "Value the jsonPath expression must equal, e.g. 'Running'",
+					},
+					"showSensitiveData": showSensitiveDataProperty,
+					"maskPaths":         maskPathsProperty,
+					"timeoutSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Maximum time to wait; on timeout the result has timedOut=true and the last observed state",
+						"default":     DefaultWaitTimeoutSeconds,
+						"minimum":     1,
+						"maximum":     MaxWaitTimeoutSeconds,
+					},
+					"intervalSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Interval in seconds between polls",
+						"default":     DefaultWaitIntervalSeconds,
+						"minimum":     MinIntervalSeconds,
+						"maximum":     MaxIntervalSeconds,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json or yaml",
+						"enum":        []string{"json", "yaml"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: waitHandler,
+	}
+}
+
 func nodeAnalysisTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_node_analysis",
-			Description: "Get comprehensive node analysis including capacity, allocated resources, taints, labels, and list of pods running on the node.",
+			Description: "Get comprehensive node analysis including capacity, allocated resources, taints, labels, roles, conditions, pod counts by phase, total CPU/memory requests relative to allocatable, and list of pods running on the node.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster", "name"},
@@ -112,11 +286,147 @@ func nodeAnalysisTool() toolset.ServerTool {
 	}
 }
 
+func endpointsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_endpoints",
+			Description: "List the backend addresses of a Service with their readiness, target pod, node, and ports. Reads EndpointSlices and falls back to the Endpoints object. Use it to check whether a Service has ready backends, e.g. when it returns 503.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace of the service",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Service name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: endpointsHandler,
+	}
+}
+
+func secretRefsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_secret_refs",
+			Description: "List the workloads that reference a Secret and how: envFrom, env valueFrom.secretKeyRef, secret or projected volumes, and imagePullSecrets. Scans Deployments, StatefulSets, DaemonSets, CronJobs and unowned ReplicaSets, Jobs and Pods in the secret's namespace, plus ephemeral containers on any pod; kinds that cannot be listed are reported as errors. Use it before deleting or rotating a secret.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace of the secret",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Secret name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: secretRefsHandler,
+	}
+}
+
+func configMapRefsTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_configmap_refs",
+			Description: "List the workloads that reference a ConfigMap and how: envFrom, env valueFrom.configMapKeyRef, and configMap or projected volumes. Scans Deployments, StatefulSets, DaemonSets, CronJobs and unowned ReplicaSets, Jobs and Pods in the ConfigMap's namespace, plus ephemeral containers on any pod; kinds that cannot be listed are reported as errors. Use it to see the blast radius before editing or deleting a ConfigMap.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace of the ConfigMap",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "ConfigMap name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: configMapRefsHandler,
+	}
+}
+
+func schedulingTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_scheduling",
+			Description: "Explain why a pod is on its node: reports the pod's nodeSelector, affinity/anti-affinity rules and tolerations, the node's taints, and checks nodeSelector, required node affinity and NoSchedule/NoExecute taints against the node. Pod affinity and anti-affinity are reported but not evaluated. For Pending pods, returns the latest FailedScheduling event message.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace of the pod",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Pod name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json or yaml",
+						"enum":        []string{"json", "yaml"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: schedulingHandler,
+	}
+}
+
 func resourceDiffTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_resource_diff",
-			Description: "Compare two Kubernetes resources (e.g., two deployments). Returns a git-style diff showing differences between the specified resources. Can compare resources across different clusters and namespaces.",
+			Description: "Compare two Kubernetes resources (e.g., two deployments). Returns a git-style diff (or structured JSON changes) showing differences between the specified resources. Can compare resources across different clusters and namespaces.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"kind", "left", "right"},
@@ -172,6 +482,7 @@ func resourceDiffTool() toolset.ServerTool {
 						"description": "Ignore non-essential metadata differences (managedFields, resourceVersion, uid, etc.)",
 						"default":     true,
 					},
+					"format": diffFormatProperty,
 				},
 			},
 		},
@@ -232,6 +543,21 @@ func watchTool() toolset.ServerTool {
 						"description": "Number of times to re-evaluate and diff before returning. Use a small number to avoid very large outputs.",
 						"default":     6,
 					},
+					"stopWhenStable": map[string]any{
+						"type":        "boolean",
+						"description": "Return early once resources stop changing for stableIterations consecutive iterations",
+						"default":     false,
+					},
+					"stableIterations": map[string]any{
+						"type":        "integer",
+						"description": "Consecutive iterations without changes that count as stable (used with stopWhenStable)",
+						"default":     3,
+					},
+					"maxDurationSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Upper bound on the total runtime in seconds regardless of intervalSeconds x iterations (0 for no limit)",
+						"default":     0,
+					},
 				},
 			},
 		},
@@ -246,7 +572,7 @@ func diffTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_diff",
-			Description: "Compare two Kubernetes resource versions and show the differences as a git-style diff. Useful for comparing current vs desired state, or before/after changes.",
+			Description: "Compare two Kubernetes resource versions and show the differences as a git-style diff or structured JSON changes. Useful for comparing current vs desired state, or before/after changes.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"resource1", "resource2"},
@@ -269,6 +595,20 @@ func diffTool() toolset.ServerTool {
 						"description": "Ignore non-essential metadata differences (managedFields, resourceVersion, etc.)",
 						"default":     false,
 					},
+					"ignorePaths": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated dotted field paths removed from both resources before diffing (e.g., 'spec.template.metadata.annotations,metadata.labels.pod-template-hash')",
+					},
+					"onlyPaths": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated dotted field paths to compare; everything else except apiVersion, kind, name and namespace is dropped (e.g., 'spec.template.spec.containers')",
+					},
+					"normalize": map[string]any{
+						"type":        "boolean",
+						"description": "Sort order-insensitive lists before diffing so reorderings are not reported: containers, initContainers, ephemeralContainers, env and volumes by name, ports by containerPort (or port), volumeMounts by mountPath",
+						"default":     false,
+					},
+					"format": diffFormatProperty,
 				},
 			},
 		},
@@ -280,6 +620,57 @@ func diffTool() toolset.ServerTool {
 	}
 }
 
+func liveDiffTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_diff_live",
+			Description: "Preview what applying a manifest would change: diff the live resource in the cluster against a supplied manifest as a git-style diff or structured JSON changes. If the live resource does not exist, the whole manifest is shown as added. Nothing is modified.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "manifest"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"manifest": map[string]any{
+						"type":        "string",
+						"description": "Desired resource manifest as JSON string",
+					},
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (defaults to the manifest kind)",
+						"default":     "",
+					},
+					"apiVersion": apiVersionProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (defaults to the manifest namespace)",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Resource name (defaults to the manifest name)",
+						"default":     "",
+					},
+					"ignoreStatus": map[string]any{
+						"type":        "boolean",
+						"description": "Ignore the status field, which manifests usually omit",
+						"default":     true,
+					},
+					"ignoreMeta": map[string]any{
+						"type":        "boolean",
+						"description": "Ignore non-essential metadata differences (managedFields, resourceVersion, uid, etc.)",
+						"default":     true,
+					},
+					"format": diffFormatProperty,
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: liveDiffHandler,
+	}
+}
+
 func capacityTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
@@ -360,12 +751,12 @@ func capacityToolFilterProperties() map[string]any {
 		},
 		"nodeLabelSelector": map[string]any{
 			"type":        "string",
-			"description": "Filter nodes by label selector (e.g., 'node-role.kubernetes.io/worker=true')",
+			"description": "Filter nodes by label selector; supports =, !=, in, notin, key and !key (e.g., 'node-role.kubernetes.io/worker=true,zone in (a,b)')",
 			"default":     "",
 		},
 		"namespaceLabelSelector": map[string]any{
 			"type":        "string",
-			"description": "Filter namespaces by label selector (e.g., 'env=production')",
+			"description": "Filter namespaces by label selector; supports =, !=, in, notin, key and !key (e.g., 'env!=dev')",
 			"default":     "",
 		},
 		"nodeTaints": map[string]any{
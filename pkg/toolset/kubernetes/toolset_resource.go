@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"github.com/mark3labs/mcp-go/mcp"
 
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
@@ -16,8 +17,12 @@ func resourceTools() []toolset.ServerTool {
 		logsTool(),
 		inspectPodTool(),
 		describeTool(),
+		explainTool(),
+		apiResourcesTool(),
 		eventsTool(),
 		rolloutHistoryTool(),
+		rolloutStatusTool(),
+		canITool(),
 	}
 }
 
@@ -25,10 +30,10 @@ func getTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_get",
-			Description: "Get any Kubernetes resource by kind, namespace, and name. Works with any resource type including CRDs.",
+			Description: "Get any Kubernetes resource by kind, namespace, and name, or several resources of a kind at once. Works with any resource type including CRDs.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
-				Required: []string{"cluster", "kind", "name"},
+				Required: []string{"cluster", "kind"},
 				Properties: map[string]any{
 					"cluster": clusterIDProperty,
 					"kind": map[string]any{
@@ -43,15 +48,43 @@ func getTool() toolset.ServerTool {
 					},
 					"name": map[string]any{
 						"type":        "string",
-						"description": "Resource name",
+						"description": "Resource name, or a comma-separated list of names to fetch several resources at once. Name or names is required.",
+					},
+					"names": map[string]any{
+						"type":        "array",
+						"description": "Resource names to fetch at once, combined with name. Using names, even with a single entry, returns a list like kubernetes_list, while a single name returns the bare resource; names that cannot be fetched do not fail the call: json and yaml return {items, failures}, table, wide and markdown list them in a trailing note, and csv does not report them.",
+						"items": map[string]any{
+							"type": "string",
+						},
 					},
 					"format": map[string]any{
 						"type":        "string",
-						"description": "Output format: json or yaml",
-						"enum":        []string{"json", "yaml"},
+						"description": "Output format: json or yaml; table, wide, csv and markdown return the resources as a list like kubernetes_list",
+						"enum":        []string{"json", "yaml", "table", "wide", "csv", "markdown"},
 						"default":     "json",
 					},
+					"jsonPath": map[string]any{
+						"type":        "string",
+						"description": "JSONPath expression to extract fields instead of returning the full resource, e.g. '{.status.podIP}' (kubectl syntax; overrides format)",
+						"default":     "",
+					},
+					"includePaths": map[string]any{
+						"type":        "string",
+						"description": "Comma-separated dotted field paths to keep, pruning everything else, e.g. 'metadata.name,spec.replicas,status.readyReplicas'. Missing paths are omitted. Ignored when jsonPath is set.",
+						"default":     "",
+					},
 					"showSensitiveData": showSensitiveDataProperty,
+					"maskPaths":         maskPathsProperty,
+					"decodeSecretData": map[string]any{
+						"type":        "boolean",
+						"description": "For Secrets, decode base64 data values into plain-text stringData. Only takes effect when showSensitiveData is true; values that are binary (not UTF-8) stay base64-encoded in data. Those keys are listed with the reason outside the resource, whose metadata is left as on the cluster: json and yaml wrap a single Secret as {resource, undecodedData} and add undecodedData to the multi-name {items, failures}; other formats end with a note",
+						"default":     false,
+					},
+					"includeOwners": map[string]any{
+						"type":        "boolean",
+						"description": "Fetch the objects in metadata.ownerReferences and report them beside the resource with their kind, name, controller flag, ready and status, e.g. the ReplicaSet controlling a pod. json and yaml return {resource, owners} for a single name and an owners map keyed by name beside items for several; table, wide, markdown and jsonPath output list them in a trailing note. Lighter than kubernetes_dep for finding what controls a resource",
+						"default":     false,
+					},
 				},
 			},
 		},
@@ -84,7 +117,7 @@ func listTool() toolset.ServerTool {
 					},
 					"name": map[string]any{
 						"type":        "string",
-						"description": "Filter by resource name (partial match, client-side)",
+						"description": "Filter by resource name (partial match, client-side; with continue it only applies within the fetched page)",
 						"default":     "",
 					},
 					"labelSelector": map[string]any{
@@ -102,13 +135,45 @@ func listTool() toolset.ServerTool {
 						"description": "Page number (starting from 1)",
 						"default":     1,
 					},
+					"continue": map[string]any{
+						"type":        "string",
+						"description": "Continue token from a previous response to fetch the next server-side page of limit items (page is ignored). With limit or continue, json and yaml return {items, continue} instead of a plain array",
+						"default":     "",
+					},
 					"format": map[string]any{
 						"type":        "string",
-						"description": "Output format: json, table, or yaml",
-						"enum":        []string{"json", "table", "yaml"},
+						"description": "Output format: json, table, wide, yaml, csv, or markdown. wide is a table with kind-specific columns and AGE for pods, deployments, and services; csv and markdown have the same columns as wide (or the requested columns) without truncation",
+						"enum":        []string{"json", "table", "wide", "yaml", "csv", "markdown"},
 						"default":     "json",
 					},
+					"jsonPath": map[string]any{
+						"type":        "string",
+						"description": "JSONPath expression evaluated against each item instead of returning full resources, e.g. '{.status.podIP}' (kubectl syntax; one line per item; overrides format)",
+						"default":     "",
+					},
+					"sortBy": map[string]any{
+						"type":        "string",
+						"description": "Sort by name, namespace, created (metadata.creationTimestamp), or a field path (e.g., '.status.containerStatuses[0].restartCount'). Applied after the name filter and before pagination; cannot be combined with continue",
+						"default":     "",
+					},
+					"sortOrder": map[string]any{
+						"type":        "string",
+						"description": "Sort order: asc or desc",
+						"enum":        []string{"asc", "desc"},
+						"default":     "asc",
+					},
+					"columns": map[string]any{
+						"type":        "string",
+						"description": "Custom table columns as comma-separated field paths (e.g., '.status.phase,.status.containerStatuses[0].restartCount'). Only used with table and wide formats; NAME and NAMESPACE are always shown.",
+						"default":     "",
+					},
+					"showKeys": map[string]any{
+						"type":        "boolean",
+						"description": "With table or wide format, show the key names of each item's data, stringData and binaryData (e.g. which keys a Secret holds) instead of kind-specific columns. Values are never shown, independent of showSensitiveData. Ignored when columns is set.",
+						"default":     false,
+					},
 					"showSensitiveData": showSensitiveDataProperty,
+					"maskPaths":         maskPathsProperty,
 				},
 			},
 		},
@@ -155,6 +220,11 @@ func getAllTool() toolset.ServerTool {
 						"enum":        []string{"", "namespaced", "cluster"},
 						"default":     "",
 					},
+					"customResourcesOnly": map[string]any{
+						"type":        "boolean",
+						"description": "Only list custom resources (API groups outside the core and *.k8s.io groups), useful for auditing operator-managed state",
+						"default":     false,
+					},
 					"since": map[string]any{
 						"type":        "string",
 						"description": "Only show resources created since this duration (e.g., '1h30m', '2d', '1w')",
@@ -165,9 +235,14 @@ func getAllTool() toolset.ServerTool {
 						"description": "Limit number of resources per API call (0 for no limit)",
 						"default":     0,
 					},
+					"concurrency": map[string]any{
+						"type":        "integer",
+						"description": "Number of resource types listed in parallel",
+						"default":     10,
+					},
 					"format": map[string]any{
 						"type":        "string",
-						"description": "Output format: json, table, or yaml",
+						"description": "Output format: json, table, or yaml. json and yaml return an object {items, skippedDueToPermissions}",
 						"enum":        []string{"json", "table", "yaml"},
 						"default":     "table",
 					},
@@ -229,11 +304,38 @@ func logsTool() toolset.ServerTool {
 						"description": "Get logs from previous container instance",
 						"default":     false,
 					},
+					"includeInitContainers": map[string]any{
+						"type":        "boolean",
+						"description": "Also include init container logs, marked with [init] (only when no container is specified)",
+						"default":     false,
+					},
 					"keyword": map[string]any{
 						"type":        "string",
 						"description": "Filter log lines containing this keyword (case-insensitive)",
 						"default":     "",
 					},
+					"keywordRegex": map[string]any{
+						"type":        "boolean",
+						"description": "Treat keyword as a regular expression (e.g., '(error|fatal).*timeout') instead of a case-insensitive substring. Regular expressions are case-sensitive; prefix with (?i) to ignore case",
+						"default":     false,
+					},
+					"excludeKeyword": map[string]any{
+						"type":        "string",
+						"description": "Drop log lines containing this keyword (case-insensitive), applied after keyword",
+						"default":     "",
+					},
+					"maxBytes": map[string]any{
+						"type":        "integer",
+						"description": "Maximum output size in bytes; keeps the newest whole lines and appends a truncation marker. 0 means unlimited.",
+						"default":     DefaultLogMaxBytes,
+					},
+					"maxConcurrency": map[string]any{
+						"type":        "integer",
+						"description": "Number of pods whose logs are fetched in parallel with labelSelector",
+						"default":     steve.DefaultMultiPodLogConcurrency,
+						"minimum":     1,
+						"maximum":     MaxLogConcurrency,
+					},
 				},
 			},
 		},
@@ -248,7 +350,7 @@ func inspectPodTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_inspect_pod",
-			Description: "Get comprehensive pod diagnostics: pod details, parent workload (Deployment/StatefulSet/DaemonSet), metrics, and container logs.",
+			Description: "Get comprehensive pod diagnostics: pod details, parent workload (Deployment/StatefulSet/DaemonSet), metrics, container logs, the most recent events involving the pod (newest first), per-container state and restart reasons, and a likely-problem summary.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster", "namespace", "name"},
@@ -262,6 +364,11 @@ func inspectPodTool() toolset.ServerTool {
 						"type":        "string",
 						"description": "Pod name",
 					},
+					"maxEvents": map[string]any{
+						"type":        "integer",
+						"description": "Maximum number of recent events to include (0 for all)",
+						"default":     20,
+					},
 				},
 			},
 		},
@@ -303,6 +410,7 @@ func describeTool() toolset.ServerTool {
 						"default":     "json",
 					},
 					"showSensitiveData": showSensitiveDataProperty,
+					"maskPaths":         maskPathsProperty,
 				},
 			},
 		},
@@ -313,11 +421,76 @@ func describeTool() toolset.ServerTool {
 	}
 }
 
+func explainTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_explain",
+			Description: "Document the fields of a resource kind from the cluster's OpenAPI schema, like 'kubectl explain'. Returns the description and type of a kind or field path plus its child fields, marking required ones. Use it to build valid manifests for kubernetes_create without guessing field names; works for CRDs that publish a schema.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (e.g., deployment, pod, App). For CRDs, pass the manifest kind and optionally apiVersion.",
+					},
+					"apiVersion": apiVersionProperty,
+					"field": map[string]any{
+						"type":        "string",
+						"description": "Dotted field path to explain (e.g., 'spec.strategy' or 'spec.template.spec.containers.ports'); lists and maps are stepped through to their element type. Empty explains the kind itself.",
+						"default":     "",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: explainHandler,
+	}
+}
+
+func apiResourcesTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_api_resources",
+			Description: "List the resource kinds a cluster serves, like 'kubectl api-resources': plural name, short names, kind, group, preferred version, whether it is namespaced, singular name, and fullName (resource.group). Use it to find the right kind for kubernetes_get/kubernetes_list, including CRDs; fullName is accepted as kind when a name is ambiguous.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"group": map[string]any{
+						"type":        "string",
+						"description": "Only list resources of this API group (e.g., apps, cert-manager.io); use 'core' for the core group. Empty lists every group.",
+						"default":     "",
+					},
+					"namespaced": map[string]any{
+						"type":        "boolean",
+						"description": "Only list namespaced (true) or cluster-scoped (false) resources. Omit to list both.",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: apiResourcesHandler,
+	}
+}
+
 func eventsTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_events",
-			Description: "List Kubernetes events. Supports filtering by namespace, involved object name, and involved object kind.",
+			Description: "List Kubernetes events. Supports filtering by namespace, involved object name, involved object kind, and server-side field/label selectors.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster"},
@@ -338,6 +511,26 @@ func eventsTool() toolset.ServerTool {
 						"description": "Filter by involved object kind, e.g., Pod, Deployment, Node (optional)",
 						"default":     "",
 					},
+					"fieldSelector": map[string]any{
+						"type":        "string",
+						"description": "Field selector applied server-side (e.g., 'reason=FailedScheduling,type=Warning')",
+						"default":     "",
+					},
+					"labelSelector": map[string]any{
+						"type":        "string",
+						"description": "Label selector applied server-side (e.g., 'app=nginx')",
+						"default":     "",
+					},
+					"since": map[string]any{
+						"type":        "string",
+						"description": "Only show events newer than this duration ago (e.g., '30m', '2h', '1d')",
+						"default":     "",
+					},
+					"until": map[string]any{
+						"type":        "string",
+						"description": "Only show events older than this duration ago (e.g., '10m'). Combine with since for a time window.",
+						"default":     "",
+					},
 					"limit": map[string]any{
 						"type":        "integer",
 						"description": "Number of events per page",
@@ -397,3 +590,94 @@ func rolloutHistoryTool() toolset.ServerTool {
 		Handler: rolloutHistoryHandler,
 	}
 }
+
+func rolloutStatusTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_rollout_status",
+			Description: "Show whether a Deployment has finished rolling out, based on its updated, ready, and available replica counts and observed generation. Optionally wait until the rollout completes. Similar to 'kubectl rollout status deployment'.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Deployment name",
+					},
+					"wait": map[string]any{
+						"type":        "boolean",
+						"description": "Poll until the rollout completes, fails, or timeoutSeconds elapses",
+						"default":     false,
+					},
+					"timeoutSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Maximum time to wait when wait=true",
+						"default":     DefaultRolloutTimeoutSeconds,
+						"minimum":     1,
+						"maximum":     MaxRolloutTimeoutSeconds,
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: rolloutStatusHandler,
+	}
+}
+
+func canITool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_can_i",
+			Description: "Check whether the configured credentials may perform an action, like 'kubectl auth can-i'. Creates a SelfSubjectAccessReview and returns allowed/denied with the reason, so an operation can be checked before attempting it.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "verb", "kind"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"verb": map[string]any{
+						"type":        "string",
+						"description": "Action to check",
+						"enum":        accessVerbs,
+					},
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Resource kind (e.g., pod, deployment, secret, App). For CRDs, pass the manifest kind and optionally apiVersion.",
+					},
+					"apiVersion": apiVersionProperty,
+					"subresource": map[string]any{
+						"type":        "string",
+						"description": "Subresource to check (e.g., log, exec, scale)",
+						"default":     "",
+					},
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name (empty checks across all namespaces or a cluster-scoped resource)",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Resource name (optional, empty checks all resources of the kind)",
+						"default":     "",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: canIHandler,
+	}
+}
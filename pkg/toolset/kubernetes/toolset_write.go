@@ -7,25 +7,49 @@ import (
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
 
-// appendWriteTools appends write-operation tools (create, patch, exec, upload, delete)
-// to the tools slice, respecting ReadOnly and DisableDestructive flags.
+// appendWriteTools appends write-operation tools (create, apply, patch, scale, restart, rollout undo, cordon, uncordon, exec, upload, delete, drain)
+// to the tools slice, respecting ReadOnly and DisableDestructive flags. In read-only
+// mode create and apply are still offered, restricted to dry runs.
 func (t *Toolset) appendWriteTools(tools []toolset.ServerTool) []toolset.ServerTool {
-	if !t.ReadOnly {
-		tools = append(tools,
-			createTool(),
-			patchTool(),
-			execTool(),
-			uploadFileTool(),
-		)
-
-		if !t.DisableDestructive {
-			tools = append(tools, deleteTool())
-		}
+	if t.ReadOnly {
+		return append(tools, dryRunOnlyTool(createTool()), dryRunOnlyTool(applyTool()))
+	}
+
+	tools = append(tools,
+		createTool(),
+		applyTool(),
+		patchTool(),
+		scaleTool(),
+		restartTool(),
+		rolloutUndoTool(),
+		cordonTool(),
+		uncordonTool(),
+		execTool(),
+		uploadFileTool(),
+	)
+
+	if !t.DisableDestructive {
+		tools = append(tools, deleteTool(), drainTool())
 	}
 
 	return tools
 }
 
+// dryRunProperty is the shared schema for the dryRun parameter of create and apply.
+var dryRunProperty = map[string]any{
+	"type":        "boolean",
+	"description": "Validate the manifest on the server and return the resulting object without persisting it. Never mutates cluster state, so it is allowed in read-only mode.",
+	"default":     false,
+}
+
+// dryRunOnlyTool adapts a create/apply tool for read-only mode, where the
+// handler rejects any call that does not set dryRun.
+func dryRunOnlyTool(tool toolset.ServerTool) toolset.ServerTool {
+	tool.Tool.Description += " The server is read-only: only dryRun=true is accepted."
+	tool.Annotations.ReadOnlyHint = paramutil.BoolPtr(true)
+	return tool
+}
+
 func createTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
@@ -40,6 +64,7 @@ func createTool() toolset.ServerTool {
 						"type":        "string",
 						"description": "Resource manifest as JSON string (must include apiVersion, kind, metadata, and spec)",
 					},
+					"dryRun": dryRunProperty,
 				},
 			},
 		},
@@ -50,11 +75,46 @@ func createTool() toolset.ServerTool {
 	}
 }
 
+func applyTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_apply",
+			Description: "Create or update a Kubernetes resource from a JSON manifest using server-side apply. Unlike kubernetes_create, applying a resource that already exists updates it.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "resource"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"resource": map[string]any{
+						"type":        "string",
+						"description": "Resource manifest as JSON string (must include apiVersion, kind, and metadata.name)",
+					},
+					"fieldManager": map[string]any{
+						"type":        "string",
+						"description": "Field manager name recorded as the owner of the applied fields",
+						"default":     DefaultFieldManager,
+					},
+					"force": map[string]any{
+						"type":        "boolean",
+						"description": "Take ownership of fields that conflict with other field managers",
+						"default":     false,
+					},
+					"dryRun": dryRunProperty,
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: applyHandler,
+	}
+}
+
 func patchTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_patch",
-			Description: "Patch a Kubernetes resource using JSON Patch (RFC 6902).",
+			Description: "Patch a Kubernetes resource using JSON Patch (RFC 6902), JSON merge patch (RFC 7386), or strategic merge patch.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster", "kind", "name", "patch"},
@@ -76,7 +136,13 @@ func patchTool() toolset.ServerTool {
 					},
 					"patch": map[string]any{
 						"type":        "string",
-						"description": "JSON Patch array as string, e.g., '[{\"op\":\"replace\",\"path\":\"/spec/replicas\",\"value\":3}]'",
+						"description": "Patch document as string. For patchType json, a JSON Patch array, e.g., '[{\"op\":\"replace\",\"path\":\"/spec/replicas\",\"value\":3}]'; for merge or strategic, a partial object, e.g., '{\"spec\":{\"replicas\":3}}'",
+					},
+					"patchType": map[string]any{
+						"type":        "string",
+						"description": "Patch type: json (RFC 6902), merge (RFC 7386), or strategic (built-in kinds only; not supported for custom resources)",
+						"enum":        []string{"json", "merge", "strategic"},
+						"default":     "json",
 					},
 				},
 			},
@@ -88,11 +154,142 @@ func patchTool() toolset.ServerTool {
 	}
 }
 
+func scaleTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_scale",
+			Description: "Scale a Deployment, StatefulSet, or ReplicaSet to the given number of replicas.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind", "namespace", "name", "replicas"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Workload kind: deployment, statefulset, or replicaset",
+						"enum":        []string{"deployment", "statefulset", "replicaset"},
+					},
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Workload name",
+					},
+					"replicas": map[string]any{
+						"type":        "integer",
+						"description": "Desired number of replicas",
+						"minimum":     0,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json or yaml",
+						"enum":        []string{"json", "yaml"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: scaleHandler,
+	}
+}
+
+func restartTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_restart",
+			Description: "Trigger a rolling restart of a Deployment, StatefulSet, or DaemonSet, like 'kubectl rollout restart'.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "kind", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"kind": map[string]any{
+						"type":        "string",
+						"description": "Workload kind: deployment, statefulset, or daemonset",
+						"enum":        []string{"deployment", "statefulset", "daemonset"},
+					},
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Workload name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json or yaml",
+						"enum":        []string{"json", "yaml"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: restartHandler,
+	}
+}
+
+func cordonTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_cordon",
+			Description: "Mark a node as unschedulable so no new pods are scheduled onto it.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Node name",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: cordonHandler,
+	}
+}
+
+func uncordonTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_uncordon",
+			Description: "Mark a node as schedulable again.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Node name",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: uncordonHandler,
+	}
+}
+
 func execTool() toolset.ServerTool {
 	return toolset.ServerTool{
 		Tool: mcp.Tool{
 			Name:        "kubernetes_exec",
-			Description: "Execute a non-interactive command in a pod container. Disabled by default and blocked in read-only mode. The command must be an argv-style string array; stdin and TTY are not supported.",
+			Description: "Execute a non-interactive command in a pod container. Disabled by default and blocked in read-only mode. The command must be an argv-style string array; stdin and TTY are not supported. Returns exitCode with stdout and stderr captured separately.",
 			InputSchema: mcp.ToolInputSchema{
 				Type:     "object",
 				Required: []string{"cluster", "namespace", "name", "command"},
@@ -119,6 +316,13 @@ func execTool() toolset.ServerTool {
 						},
 						"minItems": 1,
 					},
+					"timeoutSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Maximum time the command may run before it is cancelled; output captured so far is returned with timedOut=true and a message noting it is partial",
+						"default":     DefaultExecTimeoutSeconds,
+						"minimum":     1,
+						"maximum":     MaxExecTimeoutSeconds,
+					},
 				},
 			},
 		},
@@ -208,3 +412,81 @@ func deleteTool() toolset.ServerTool {
 		Handler: deleteHandler,
 	}
 }
+
+func drainTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_drain",
+			Description: "Cordon a node and evict its pods using the eviction API (honors PodDisruptionBudgets). Mirror pods are skipped; DaemonSet-managed pods, emptyDir pods and pods without a controller block the drain unless ignoreDaemonSets/deleteEmptyDir/force are set. Returns evicted, skipped, and failed pods.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Node name",
+					},
+					"gracePeriodSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Termination grace period for evicted pods in seconds (optional, defaults to each pod's own setting)",
+						"minimum":     0,
+					},
+					"ignoreDaemonSets": map[string]any{
+						"type":        "boolean",
+						"description": "Skip DaemonSet-managed pods instead of refusing to drain",
+						"default":     false,
+					},
+					"deleteEmptyDir": map[string]any{
+						"type":        "boolean",
+						"description": "Evict pods using emptyDir volumes (their local data is lost)",
+						"default":     false,
+					},
+					"force": map[string]any{
+						"type":        "boolean",
+						"description": "Evict pods that no controller (ReplicaSet, StatefulSet, Job, ...) owns; they are not recreated elsewhere",
+						"default":     false,
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint:    paramutil.BoolPtr(false),
+			DestructiveHint: paramutil.BoolPtr(true),
+		},
+		Handler: drainHandler,
+	}
+}
+
+func rolloutUndoTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "kubernetes_rollout_undo",
+			Description: "Roll back a Deployment to a previous revision by restoring that revision's pod template and annotations, keeping the controller-managed revision annotations. Defaults to the previous revision. Returns the diff between the current and restored template. Similar to 'kubectl rollout undo deployment'.",
+			InputSchema: mcp.ToolInputSchema{
+				Type:     "object",
+				Required: []string{"cluster", "namespace", "name"},
+				Properties: map[string]any{
+					"cluster": clusterIDProperty,
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Deployment name",
+					},
+					"toRevision": map[string]any{
+						"type":        "integer",
+						"description": "Revision to roll back to, as listed by kubernetes_rollout_history (optional, defaults to the previous revision)",
+						"minimum":     1,
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(false),
+		},
+		Handler: rolloutUndoHandler,
+	}
+}
@@ -0,0 +1,41 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppendWriteTools_ReadOnlyOffersDryRunOnly(t *testing.T) {
+	tools := mapToolsByName((&Toolset{ReadOnly: true}).GetTools(nil))
+
+	for _, name := range []string{"kubernetes_create", "kubernetes_apply"} {
+		st, ok := tools[name]
+		if !ok {
+			t.Fatalf("%s should stay registered in read-only mode for dry runs", name)
+		}
+		if st.Annotations.ReadOnlyHint == nil || !*st.Annotations.ReadOnlyHint {
+			t.Errorf("%s ReadOnlyHint = %v, want true", name, st.Annotations.ReadOnlyHint)
+		}
+		if !strings.Contains(st.Tool.Description, "only dryRun=true is accepted") {
+			t.Errorf("%s description should mention the dry-run restriction: %q", name, st.Tool.Description)
+		}
+	}
+
+	for _, name := range []string{"kubernetes_patch", "kubernetes_scale", "kubernetes_delete", "kubernetes_drain"} {
+		if _, ok := tools[name]; ok {
+			t.Errorf("%s should not be registered in read-only mode", name)
+		}
+	}
+}
+
+func TestAppendWriteTools_WritableKeepsCreateDescription(t *testing.T) {
+	tools := mapToolsByName((&Toolset{}).GetTools(nil))
+
+	st, ok := tools["kubernetes_create"]
+	if !ok {
+		t.Fatal("kubernetes_create is not registered")
+	}
+	if strings.Contains(st.Tool.Description, "dryRun=true") {
+		t.Errorf("writable kubernetes_create should not carry the read-only note: %q", st.Tool.Description)
+	}
+}
@@ -9,22 +9,47 @@ const (
 	FormatJSON  = "json"
 	FormatYAML  = "yaml"
 	FormatTable = "table"
+	// FormatCSV is the table rows as RFC 4180 comma-separated values
+	FormatCSV = "csv"
+	// FormatMarkdown is the table rows as a GitHub-flavored markdown table
+	FormatMarkdown = "markdown"
+	// FormatWide is a table with kind-specific columns (kubernetes_list only)
+	FormatWide = "wide"
+	// FormatText is the git-style output of the diff tools
+	FormatText = "text"
+)
+
+// DefaultTableMaxColumnWidth caps auto-sized table columns, in runes.
+const DefaultTableMaxColumnWidth = 60
+
+// Time display modes for FormatTime
+const (
+	TimeDisplayAbsolute = "absolute"
+	TimeDisplayRelative = "relative"
 )
 
 // Parameter name constants
 const (
-	ParamCluster      = "cluster"
-	ParamNamespace    = "namespace"
-	ParamProject      = "project"
-	ParamFormat       = "format"
-	ParamName         = "name"
-	ParamUser         = "user"
-	ParamContainer    = "container"
-	ParamTailLines    = "tailLines"
-	ParamSinceSeconds = "sinceSeconds"
-	ParamTimestamps   = "timestamps"
-	ParamPrevious     = "previous"
-	ParamKeyword      = "keyword"
+	ParamCluster               = "cluster"
+	ParamNamespace             = "namespace"
+	ParamProject               = "project"
+	ParamFormat                = "format"
+	ParamName                  = "name"
+	ParamNames                 = "names"
+	ParamUser                  = "user"
+	ParamPrincipal             = "principal"
+	ParamContainer             = "container"
+	ParamTailLines             = "tailLines"
+	ParamSinceSeconds          = "sinceSeconds"
+	ParamTimestamps            = "timestamps"
+	ParamIncludeInitContainers = "includeInitContainers"
+	ParamPrevious              = "previous"
+	ParamKeyword               = "keyword"
+	ParamKeywordRegex          = "keywordRegex"
+	ParamExcludeKeyword        = "excludeKeyword"
+	ParamMaxBytes              = "maxBytes"
+	ParamMaxConcurrency        = "maxConcurrency"
+	ParamMaxEvents             = "maxEvents"
 	// Kubernetes toolset parameters
 	ParamKind          = "kind"
 	ParamAPIVersion    = "apiVersion"
@@ -32,8 +57,41 @@ const (
 	ParamLimit         = "limit"
 	ParamResource      = "resource"
 	ParamPatch         = "patch"
+	ParamPatchType     = "patchType"
 	ParamPage          = "page"
 	ParamFieldSelector = "fieldSelector"
+	ParamReplicas      = "replicas"
+	ParamColumns       = "columns"
+	ParamSince         = "since"
+	ParamUntil         = "until"
+	ParamWait          = "wait"
+	ParamToRevision    = "toRevision"
+	ParamContinue      = "continue"
+	ParamJSONPath      = "jsonPath"
+	ParamIncludePaths  = "includePaths"
+	ParamIgnorePaths   = "ignorePaths"
+	ParamOnlyPaths     = "onlyPaths"
+	ParamNormalize     = "normalize"
+	ParamIncludeKinds  = "includeKinds"
+	ParamExcludeKinds  = "excludeKinds"
+	ParamField         = "field"
+	ParamIncludeErrors = "includeErrors"
+	ParamConcurrency   = "concurrency"
+	ParamCheckCoverage = "checkCoverage"
+	ParamSortBy        = "sortBy"
+	ParamSortOrder     = "sortOrder"
+	ParamIncludeOwners = "includeOwners"
+	// Access review parameters
+	ParamVerb        = "verb"
+	ParamSubresource = "subresource"
+	// Server-side apply parameters (force is also used by node drain)
+	ParamFieldManager = "fieldManager"
+	ParamForce        = "force"
+	ParamDryRun       = "dryRun"
+	// Node drain parameters
+	ParamGracePeriodSeconds = "gracePeriodSeconds"
+	ParamIgnoreDaemonSets   = "ignoreDaemonSets"
+	ParamDeleteEmptyDir     = "deleteEmptyDir"
 	// Dep tool parameters
 	ParamDirection         = "direction"
 	ParamDepth             = "depth"
@@ -41,15 +99,31 @@ const (
 	ParamMaxScannedObjects = "maxScannedObjects"
 	// Sensitive data parameters
 	ParamShowSensitiveData = "showSensitiveData"
+	ParamMaskPaths         = "maskPaths"
+	ParamShowKeys          = "showKeys"
+	ParamDecodeSecretData  = "decodeSecretData"
 	// Watch/diff tool parameters
-	ParamIntervalSeconds = "intervalSeconds"
-	ParamIterations      = "iterations"
+	ParamIntervalSeconds    = "intervalSeconds"
+	ParamIterations         = "iterations"
+	ParamStopWhenStable     = "stopWhenStable"
+	ParamStableIterations   = "stableIterations"
+	ParamMaxDurationSeconds = "maxDurationSeconds"
 	// Container file operation parameters
 	ParamFilePath    = "filePath"
 	ParamContent     = "content"
 	ParamMaxFileSize = "maxFileSize"
 	// Container exec operation parameters
-	ParamCommand = "command"
+	ParamCommand        = "command"
+	ParamTimeoutSeconds = "timeoutSeconds"
+	// Wait tool parameters
+	ParamCondition = "condition"
+	ParamDeleted   = "deleted"
+	ParamValue     = "value"
+	// Kubeconfig tool parameters
+	ParamTTLSeconds    = "ttlSeconds"
+	ParamClusterScoped = "clusterScoped"
+	// ParamMaxTTLSeconds is injected by the server from kubeconfig_max_ttl
+	ParamMaxTTLSeconds = "maxTTLSeconds"
 )
 
 // Error definitions
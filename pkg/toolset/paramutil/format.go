@@ -1,9 +1,13 @@
 package paramutil
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,16 +18,16 @@ func FormatAsTable(data []map[string]string, headers []string) string {
 		return "No data available"
 	}
 
-	// Calculate column widths
+	// Calculate column widths in runes, as the %-*s padding counts runes
 	widths := make([]int, len(headers))
 	for i, header := range headers {
-		widths[i] = len(header)
+		widths[i] = utf8.RuneCountInString(header)
 	}
 
 	for _, row := range data {
 		for i, header := range headers {
-			if value, ok := row[header]; ok && len(value) > widths[i] {
-				widths[i] = len(value)
+			if value, ok := row[header]; ok && utf8.RuneCountInString(value) > widths[i] {
+				widths[i] = utf8.RuneCountInString(value)
 			}
 		}
 	}
@@ -61,6 +65,86 @@ func FormatAsTable(data []map[string]string, headers []string) string {
 	return builder.String()
 }
 
+// FormatAsCSV formats data as comma-separated values with a header line.
+// Values containing commas, quotes or newlines are quoted as in RFC 4180.
+func FormatAsCSV(data []map[string]string, headers []string) (string, error) {
+	var builder strings.Builder
+	w := csv.NewWriter(&builder)
+	if err := w.Write(headers); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	record := make([]string, len(headers))
+	for _, row := range data {
+		for i, header := range headers {
+			record[i] = row[header]
+		}
+		if err := w.Write(record); err != nil {
+			return "", fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return builder.String(), nil
+}
+
+// markdownCellReplacer escapes the characters that would break a markdown table cell.
+var markdownCellReplacer = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+
+// FormatAsMarkdownTable formats data as a GitHub-flavored markdown table.
+func FormatAsMarkdownTable(data []map[string]string, headers []string) string {
+	if len(data) == 0 {
+		return "No data available"
+	}
+
+	var builder strings.Builder
+	writeRow := func(cells []string) {
+		builder.WriteString("|")
+		for _, cell := range cells {
+			builder.WriteString(" ")
+			builder.WriteString(markdownCellReplacer.Replace(cell))
+			builder.WriteString(" |")
+		}
+		builder.WriteString("\n")
+	}
+
+	writeRow(headers)
+	builder.WriteString("|")
+	for range headers {
+		builder.WriteString(" --- |")
+	}
+	builder.WriteString("\n")
+
+	cells := make([]string, len(headers))
+	for _, row := range data {
+		for i, header := range headers {
+			cells[i] = row[header]
+		}
+		writeRow(cells)
+	}
+	return builder.String()
+}
+
+// IsRowFormat reports whether format renders rows under headers: table, csv or markdown.
+func IsRowFormat(format string) bool {
+	return format == FormatTable || format == FormatCSV || format == FormatMarkdown
+}
+
+// FormatRows renders rows in one of the row formats: table, csv or markdown.
+func FormatRows(data []map[string]string, headers []string, format string) (string, error) {
+	switch format {
+	case FormatTable:
+		return FormatAsTable(data, headers), nil
+	case FormatCSV:
+		return FormatAsCSV(data, headers)
+	case FormatMarkdown:
+		return FormatAsMarkdownTable(data, headers), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidFormat, format)
+	}
+}
+
 // FormatAsYAML formats data as YAML
 func FormatAsYAML(data interface{}) (string, error) {
 	yamlBytes, err := yaml.Marshal(data)
@@ -96,13 +180,113 @@ func GetStringValue(v interface{}) string {
 	return fmt.Sprintf("%v", v)
 }
 
-// FormatTime formats time for display.
-// Returns "-" for empty timestamps.
+// tableWidthSettings are the auto-sized table settings; see SetTableAutoWidth.
+type tableWidthSettings struct {
+	autoWidth      bool
+	maxColumnWidth int
+}
+
+// tableWidth holds the settings behind one atomic pointer, so tool calls
+// formatting tables concurrently always read a consistent pair. Nil means
+// fixed widths.
+var tableWidth atomic.Pointer[tableWidthSettings]
+
+// SetTableAutoWidth makes the fixed-width tables size each column to its
+// widest value instead, truncating values longer than maxWidth runes
+// (DefaultTableMaxColumnWidth when maxWidth is not positive).
+// It is meant to be called at startup, but is safe to call while tools run.
+func SetTableAutoWidth(enabled bool, maxWidth int) {
+	if maxWidth <= 0 {
+		maxWidth = DefaultTableMaxColumnWidth
+	}
+	tableWidth.Store(&tableWidthSettings{autoWidth: enabled, maxColumnWidth: maxWidth})
+}
+
+// TableAutoWidth reports whether tables are auto-sized, and the maximum
+// column width in runes when they are.
+func TableAutoWidth() (bool, int) {
+	settings := tableWidth.Load()
+	if settings == nil {
+		return false, DefaultTableMaxColumnWidth
+	}
+	return settings.autoWidth, settings.maxColumnWidth
+}
+
+// relativeTime selects TimeDisplayRelative for FormatTime. It is atomic as
+// tool calls format timestamps concurrently.
+var relativeTime atomic.Bool
+
+// SetTimeDisplay sets how FormatTime renders timestamps: TimeDisplayAbsolute
+// (local time) or TimeDisplayRelative (age). Unknown values select absolute.
+// It is meant to be called at startup, but is safe to call while tools run.
+func SetTimeDisplay(display string) {
+	relativeTime.Store(strings.EqualFold(display, TimeDisplayRelative))
+}
+
+// FormatTime formats an RFC 3339 timestamp for display according to the
+// configured time display mode. It is meant for table output; json and yaml
+// should keep the RFC 3339 timestamp.
+// Returns "-" for empty timestamps and the timestamp itself if it cannot be parsed.
 func FormatTime(timestamp string) string {
+	if relativeTime.Load() {
+		return FormatTimeAs(timestamp, TimeDisplayRelative)
+	}
+	return FormatTimeAs(timestamp, TimeDisplayAbsolute)
+}
+
+// FormatTimeAs formats an RFC 3339 timestamp in the given display mode: as
+// local time ("2006-01-02 15:04:05 MST") or, for TimeDisplayRelative, as an age.
+func FormatTimeAs(timestamp, display string) string {
 	if timestamp == "" {
 		return "-"
 	}
-	return timestamp
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return timestamp
+	}
+	if display == TimeDisplayRelative {
+		return HumanizeAge(t)
+	}
+	return t.Local().Format("2006-01-02 15:04:05 MST")
+}
+
+// HumanizeAge formats the time elapsed since created as a compact age in the
+// style of kubectl, using up to two units (e.g. "45s", "12m", "5h3m", "3d4h").
+// Returns "-" for a zero time.
+func HumanizeAge(created time.Time) string {
+	if created.IsZero() {
+		return "-"
+	}
+	d := time.Since(created)
+	if d < 0 {
+		d = 0
+	}
+
+	days := int(d / (24 * time.Hour))
+	hours := int(d/time.Hour) % 24
+	minutes := int(d/time.Minute) % 60
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d/time.Second))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", minutes)
+	case days == 0:
+		if minutes == 0 {
+			return fmt.Sprintf("%dh", hours)
+		}
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		if hours == 0 {
+			return fmt.Sprintf("%dd", days)
+		}
+		return fmt.Sprintf("%dd%dh", days, hours)
+	}
+}
+
+// FormatAge formats an RFC 3339 timestamp as a HumanizeAge age.
+// Returns "-" for empty timestamps and the timestamp itself if it cannot be parsed.
+func FormatAge(timestamp string) string {
+	return FormatTimeAs(timestamp, TimeDisplayRelative)
 }
 
 // FormatEmptyResult formats an empty result based on the output format.
@@ -150,7 +334,7 @@ func FormatWithFields(data []map[string]string, fields []string, format string)
 		return FormatAsYAML(filteredData)
 	case FormatJSON:
 		return FormatAsJSON(filteredData)
-	case FormatTable:
+	case FormatTable, FormatCSV, FormatMarkdown:
 		// Use specified fields as headers, or derive from first row
 		headers := fields
 		if len(headers) == 0 && len(filteredData) > 0 {
@@ -160,7 +344,7 @@ func FormatWithFields(data []map[string]string, fields []string, format string)
 				headers = append(headers, key)
 			}
 		}
-		return FormatAsTable(filteredData, headers), nil
+		return FormatRows(filteredData, headers, format)
 	default:
 		return "", fmt.Errorf("%w: %s", ErrInvalidFormat, format)
 	}
@@ -184,8 +368,8 @@ func FormatOutput(data []map[string]string, format string, headers []string, fie
 		return FormatAsYAML(data)
 	case FormatJSON:
 		return FormatAsJSON(data)
-	case FormatTable:
-		return FormatAsTable(data, headers), nil
+	case FormatTable, FormatCSV, FormatMarkdown:
+		return FormatRows(data, headers, format)
 	default:
 		return "", fmt.Errorf("%w: %s", ErrInvalidFormat, format)
 	}
@@ -193,22 +377,22 @@ func FormatOutput(data []map[string]string, format string, headers []string, fie
 
 // FormatSingleResult formats a single result object (map[string]interface{}) in the specified format.
 // This is useful for get handlers that return a single resource.
-// tableHeaders is optional - if provided and format is "table", it renders a table with those fields.
+// tableHeaders is optional - if provided and format is "table", "csv" or "markdown", it renders a single row with those fields.
 func FormatSingleResult(data map[string]interface{}, format string, tableHeaders ...string) (string, error) {
 	switch format {
 	case FormatYAML:
 		return FormatAsYAML(data)
 	case FormatJSON:
 		return FormatAsJSON(data)
-	case FormatTable:
+	case FormatTable, FormatCSV, FormatMarkdown:
 		if len(tableHeaders) == 0 {
-			return "", fmt.Errorf("%w: table format requires headers", ErrInvalidFormat)
+			return "", fmt.Errorf("%w: %s format requires headers", ErrInvalidFormat, format)
 		}
 		row := make(map[string]string)
 		for _, header := range tableHeaders {
 			row[header] = GetStringValue(data[header])
 		}
-		return FormatAsTable([]map[string]string{row}, tableHeaders), nil
+		return FormatRows([]map[string]string{row}, tableHeaders, format)
 	default:
 		return "", fmt.Errorf("%w: %s", ErrInvalidFormat, format)
 	}
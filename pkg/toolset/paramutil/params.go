@@ -45,10 +45,10 @@ func ExtractFormat(params map[string]interface{}) string {
 // ValidateFormat validates that the format is one of the supported formats
 func ValidateFormat(format string) error {
 	switch format {
-	case FormatJSON, FormatYAML, FormatTable:
+	case FormatJSON, FormatYAML, FormatTable, FormatCSV, FormatMarkdown:
 		return nil
 	default:
-		return fmt.Errorf("%w: %s (supported: json, yaml, table)", ErrInvalidFormat, format)
+		return fmt.Errorf("%w: %s (supported: json, yaml, table, csv, markdown)", ErrInvalidFormat, format)
 	}
 }
 
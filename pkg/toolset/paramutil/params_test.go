@@ -2,7 +2,9 @@ package paramutil
 
 import (
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -92,7 +94,7 @@ func TestExtractFormat(t *testing.T) {
 }
 
 func TestValidateFormat(t *testing.T) {
-	for _, f := range []string{"json", "yaml", "table"} {
+	for _, f := range []string{"json", "yaml", "table", "csv", "markdown"} {
 		if err := ValidateFormat(f); err != nil {
 			t.Errorf("expected valid format %q, got error: %v", f, err)
 		}
@@ -115,11 +117,66 @@ func TestGetStringValue(t *testing.T) {
 }
 
 func TestFormatTime(t *testing.T) {
-	if v := FormatTime(""); v != "-" {
+	defer SetTimeDisplay(TimeDisplayAbsolute)
+
+	ts := "2024-01-15T10:30:00Z"
+	local := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC).Local().Format("2006-01-02 15:04:05 MST")
+
+	tests := []struct {
+		name      string
+		display   string
+		timestamp string
+		want      string
+	}{
+		{name: "empty", display: TimeDisplayAbsolute, timestamp: "", want: "-"},
+		{name: "malformed", display: TimeDisplayAbsolute, timestamp: "yesterday", want: "yesterday"},
+		{name: "malformed relative", display: TimeDisplayRelative, timestamp: "2024-13-45", want: "2024-13-45"},
+		{name: "absolute", display: TimeDisplayAbsolute, timestamp: ts, want: local},
+		{name: "unknown display is absolute", display: "fancy", timestamp: ts, want: local},
+		{name: "relative", display: TimeDisplayRelative, timestamp: time.Now().Add(-90 * time.Minute).UTC().Format(time.RFC3339), want: "1h30m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetTimeDisplay(tt.display)
+			if got := FormatTime(tt.timestamp); got != tt.want {
+				t.Errorf("FormatTime(%q) with %s display = %q, want %q", tt.timestamp, tt.display, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHumanizeAge(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		created time.Time
+		want    string
+	}{
+		{time.Time{}, "-"},
+		{now.Add(-45 * time.Second), "45s"},
+		{now.Add(-12 * time.Minute), "12m"},
+		{now.Add(-3 * time.Hour), "3h"},
+		{now.Add(-(5*time.Hour + 3*time.Minute)), "5h3m"},
+		{now.Add(-48 * time.Hour), "2d"},
+		{now.Add(-(76*time.Hour + 10*time.Minute)), "3d4h"},
+		{now.Add(time.Hour), "0s"},
+	}
+	for _, tt := range tests {
+		if got := HumanizeAge(tt.created); got != tt.want {
+			t.Errorf("HumanizeAge(now-%v) = %q, want %q", now.Sub(tt.created).Round(time.Second), got, tt.want)
+		}
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	if v := FormatAge(""); v != "-" {
 		t.Errorf("expected '-' for empty, got %q", v)
 	}
-	if v := FormatTime("2024-01-15T10:30:00Z"); v != "2024-01-15T10:30:00Z" {
-		t.Errorf("expected passthrough, got %q", v)
+	if v := FormatAge("not-a-time"); v != "not-a-time" {
+		t.Errorf("expected passthrough for unparsable timestamp, got %q", v)
+	}
+	created := time.Now().Add(-26 * time.Hour).UTC().Format(time.RFC3339)
+	if v := FormatAge(created); v != "1d2h" {
+		t.Errorf("FormatAge(%s) = %q, want 1d2h", created, v)
 	}
 }
 
@@ -301,6 +358,16 @@ func TestFormatOutput(t *testing.T) {
 		}
 	})
 
+	t.Run("csv format", func(t *testing.T) {
+		got, err := FormatOutput(data, "csv", []string{"name", "namespace"}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := "name,namespace\nnginx,default\n"; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
 	t.Run("empty data with json", func(t *testing.T) {
 		got, err := FormatOutput([]map[string]string{}, "json", nil, nil)
 		if err != nil || got == "" {
@@ -339,6 +406,137 @@ func TestFormatSingleResult(t *testing.T) {
 			t.Fatalf("expected table output, got err=%v, result=%q", err, got)
 		}
 	})
+
+	t.Run("csv format with headers", func(t *testing.T) {
+		got, err := FormatSingleResult(data, "csv", "name", "namespace")
+		if err != nil || got != "name,namespace\nnginx,default\n" {
+			t.Fatalf("expected csv output, got err=%v, result=%q", err, got)
+		}
+	})
+}
+
+func TestFormatAsTable_MultibyteAlignment(t *testing.T) {
+	data := []map[string]string{{"name": "前端服务", "ns": "default"}, {"name": "api", "ns": "prod"}}
+	lines := strings.Split(strings.TrimRight(FormatAsTable(data, []string{"name", "ns"}), "\n"), "\n")
+	// The second column starts at the same rune offset on every line
+	for _, line := range lines[2:] {
+		runes := []rune(line)
+		if got := string(runes[6:8]); got != "de" && got != "pr" {
+			t.Errorf("misaligned row %q", line)
+		}
+	}
+}
+
+func TestSetTableAutoWidth(t *testing.T) {
+	defer SetTableAutoWidth(false, 0)
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		maxWidth  int
+		wantWidth int
+	}{
+		{name: "custom width", enabled: true, maxWidth: 30, wantWidth: 30},
+		{name: "zero width uses default", enabled: true, maxWidth: 0, wantWidth: DefaultTableMaxColumnWidth},
+		{name: "negative width uses default", enabled: true, maxWidth: -5, wantWidth: DefaultTableMaxColumnWidth},
+		{name: "disabled", enabled: false, maxWidth: 30, wantWidth: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetTableAutoWidth(tt.enabled, tt.maxWidth)
+			enabled, width := TableAutoWidth()
+			if enabled != tt.enabled || width != tt.wantWidth {
+				t.Errorf("TableAutoWidth() = (%v, %d), want (%v, %d)", enabled, width, tt.enabled, tt.wantWidth)
+			}
+		})
+	}
+}
+
+func TestTableAutoWidth_Concurrent(t *testing.T) {
+	defer SetTableAutoWidth(false, 0)
+
+	// Readers must always see one of the pairs that was set, never a mix
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			SetTableAutoWidth(true, 30)
+			SetTableAutoWidth(false, 40)
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		enabled, width := TableAutoWidth()
+		if (enabled && width != 30) || (!enabled && width != 40 && width != DefaultTableMaxColumnWidth) {
+			t.Fatalf("TableAutoWidth() = (%v, %d), a mix of two settings", enabled, width)
+		}
+	}
+	wg.Wait()
+}
+
+func TestFormatAsCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		data []map[string]string
+		want string
+	}{
+		{name: "plain values", data: []map[string]string{{"name": "web", "roles": "worker"}}, want: "name,roles\nweb,worker\n"},
+		{name: "comma is quoted", data: []map[string]string{{"name": "cp-1", "roles": "controlplane,etcd"}}, want: "name,roles\ncp-1,\"controlplane,etcd\"\n"},
+		{name: "quote is doubled", data: []map[string]string{{"name": `say "hi"`}}, want: "name,roles\n\"say \"\"hi\"\"\",\n"},
+		{name: "newline is quoted", data: []map[string]string{{"name": "a\nb"}}, want: "name,roles\n\"a\nb\",\n"},
+		{name: "no rows", data: nil, want: "name,roles\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatAsCSV(tt.data, []string{"name", "roles"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatAsMarkdownTable(t *testing.T) {
+	tests := []struct {
+		name string
+		data []map[string]string
+		want string
+	}{
+		{name: "plain values", data: []map[string]string{{"name": "web", "roles": "worker"}}, want: "| name | roles |\n| --- | --- |\n| web | worker |\n"},
+		{name: "pipe is escaped", data: []map[string]string{{"name": "a|b"}}, want: "| name | roles |\n| --- | --- |\n| a\\|b |  |\n"},
+		{name: "newline becomes break", data: []map[string]string{{"name": "a\nb"}}, want: "| name | roles |\n| --- | --- |\n| a<br>b |  |\n"},
+		{name: "no rows", data: nil, want: "No data available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatAsMarkdownTable(tt.data, []string{"name", "roles"}); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatRows(t *testing.T) {
+	data := []map[string]string{{"name": "nginx"}}
+	for _, format := range []string{"table", "csv", "markdown"} {
+		if !IsRowFormat(format) {
+			t.Errorf("IsRowFormat(%q) = false, want true", format)
+		}
+		if got, err := FormatRows(data, []string{"name"}, format); err != nil || !strings.Contains(got, "nginx") {
+			t.Errorf("FormatRows(%q) = %q, %v", format, got, err)
+		}
+	}
+	if IsRowFormat("json") {
+		t.Error("IsRowFormat(json) = true, want false")
+	}
+	if _, err := FormatRows(data, []string{"name"}, "json"); err == nil {
+		t.Error("expected error for json")
+	}
 }
 
 func TestExtractOptionalInt64(t *testing.T) {
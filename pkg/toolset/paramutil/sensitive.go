@@ -1,6 +1,8 @@
 package paramutil
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -16,10 +18,15 @@ type SensitiveRule struct {
 	Fields []string
 }
 
-// SensitiveDataFilter masks values in sensitive fields based on resource kind.
+// SensitiveDataFilter masks values in sensitive fields based on resource kind,
+// and in additional field paths of any kind.
 // It preserves map keys but replaces all values with a masked placeholder.
 type SensitiveDataFilter struct {
 	rules []SensitiveRule
+	// maskPaths are field paths masked regardless of kind.
+	maskPaths [][]string
+	// maskPatterns match the dotted paths of fields masked regardless of kind.
+	maskPatterns []*regexp.Regexp
 }
 
 // NewSensitiveDataFilter creates a new SensitiveDataFilter with the specified rules.
@@ -41,32 +48,94 @@ func DefaultSensitiveRules() []SensitiveRule {
 }
 
 // NewSensitiveDataFilterFromParams creates a SensitiveDataFilter from handler params.
-// Returns nil if showSensitiveData is true (i.e., no masking needed).
-func NewSensitiveDataFilterFromParams(params map[string]interface{}) *SensitiveDataFilter {
-	if ExtractBool(params, ParamShowSensitiveData, false) {
+// Secret masking is skipped when showSensitiveData is true; the fields named by
+// maskPaths are masked either way. Returns nil if no masking is needed.
+func NewSensitiveDataFilterFromParams(params map[string]interface{}) (*SensitiveDataFilter, error) {
+	var rules []SensitiveRule
+	if !ExtractBool(params, ParamShowSensitiveData, false) {
+		rules = DefaultSensitiveRules()
+	}
+	maskPaths := ParseMaskPaths(ExtractOptionalString(params, ParamMaskPaths))
+	if len(rules) == 0 && len(maskPaths) == 0 {
+		return nil, nil
+	}
+	return NewSensitiveDataFilter(rules).WithMaskPaths(maskPaths)
+}
+
+// ParseMaskPaths splits a comma-separated maskPaths parameter, dropping empty entries.
+func ParseMaskPaths(maskPaths string) []string {
+	var paths []string
+	for _, path := range strings.Split(maskPaths, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// WithMaskPaths adds fields to mask in resources of every kind. An entry is
+// either a dotted field path (e.g. "data.token" or
+// "metadata.annotations.example.com/password"; label and annotation keys are
+// taken whole) or a regular expression enclosed in slashes (e.g. "/token$/")
+// matched against the dotted path of every field, with list items written as
+// [i]. A masked map keeps its keys; any other value becomes the placeholder.
+func (f *SensitiveDataFilter) WithMaskPaths(paths []string) (*SensitiveDataFilter, error) {
+	for _, path := range paths {
+		if len(path) > 2 && strings.HasPrefix(path, "/") && strings.HasSuffix(path, "/") {
+			pattern, err := regexp.Compile(path[1 : len(path)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid maskPaths pattern %s: %w", path, err)
+			}
+			f.maskPatterns = append(f.maskPatterns, pattern)
+			continue
+		}
+		if fields := SplitFieldPath(path); len(fields) > 0 {
+			f.maskPaths = append(f.maskPaths, fields)
+		}
+	}
+	return f, nil
+}
+
+// SplitFieldPath splits a dotted field path into fields. Label and annotation
+// keys often contain dots, so everything after metadata.labels or
+// metadata.annotations is kept as a single key.
+func SplitFieldPath(path string) []string {
+	if path == "" {
 		return nil
 	}
-	return NewSensitiveDataFilter(DefaultSensitiveRules())
+	fields := strings.Split(path, ".")
+	if len(fields) > 3 && fields[0] == "metadata" && (fields[1] == "labels" || fields[1] == "annotations") {
+		return []string{fields[0], fields[1], strings.Join(fields[2:], ".")}
+	}
+	return fields
 }
 
 // Filter masks sensitive field values in a resource and returns a cleaned copy.
 // The original resource is not modified.
 // If the resource kind does not match any rule, it is returned unchanged.
 func (f *SensitiveDataFilter) Filter(obj *unstructured.Unstructured) *unstructured.Unstructured {
-	if obj == nil || len(f.rules) == 0 {
+	if obj == nil || f.isEmpty() {
 		return obj
 	}
 
 	rule := f.findRule(obj.GetKind())
-	if rule == nil {
+	if rule == nil && !f.hasMaskPaths() {
 		return obj
 	}
 
 	// Deep copy to avoid modifying the original
 	result := obj.DeepCopy()
 
-	for _, field := range rule.Fields {
-		f.maskField(result.Object, field)
+	if rule != nil {
+		for _, field := range rule.Fields {
+			f.maskField(result.Object, field)
+		}
+	}
+	for _, fields := range f.maskPaths {
+		maskNestedField(result.Object, fields)
+	}
+	if len(f.maskPatterns) > 0 {
+		f.maskMatchingFields(result.Object, "")
 	}
 
 	return result
@@ -74,7 +143,7 @@ func (f *SensitiveDataFilter) Filter(obj *unstructured.Unstructured) *unstructur
 
 // FilterList applies sensitive masking to all resources in a list.
 func (f *SensitiveDataFilter) FilterList(list *unstructured.UnstructuredList) *unstructured.UnstructuredList {
-	if list == nil || len(f.rules) == 0 {
+	if list == nil || f.isEmpty() {
 		return list
 	}
 
@@ -93,6 +162,14 @@ func (f *SensitiveDataFilter) FilterList(list *unstructured.UnstructuredList) *u
 	return result
 }
 
+func (f *SensitiveDataFilter) hasMaskPaths() bool {
+	return len(f.maskPaths) > 0 || len(f.maskPatterns) > 0
+}
+
+func (f *SensitiveDataFilter) isEmpty() bool {
+	return len(f.rules) == 0 && !f.hasMaskPaths()
+}
+
 // findRule returns the matching rule for the given kind, or nil if none matches.
 func (f *SensitiveDataFilter) findRule(kind string) *SensitiveRule {
 	kindLower := strings.ToLower(kind)
@@ -122,3 +199,69 @@ func (f *SensitiveDataFilter) maskField(obj map[string]interface{}, field string
 		dataMap[key] = maskedValue
 	}
 }
+
+// maskNestedField masks the value at the field path, if it exists.
+func maskNestedField(obj map[string]interface{}, fields []string) {
+	current := obj
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := current[field].(map[string]interface{})
+		if !ok {
+			return
+		}
+		current = next
+	}
+	last := fields[len(fields)-1]
+	if value, ok := current[last]; ok {
+		current[last] = maskValue(value)
+	}
+}
+
+// maskMatchingFields masks every field below value whose dotted path matches
+// one of the mask patterns. Matched fields are not descended into.
+func (f *SensitiveDataFilter) maskMatchingFields(value interface{}, path string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			if f.matchesMaskPattern(childPath) {
+				v[key] = maskValue(child)
+				continue
+			}
+			f.maskMatchingFields(child, childPath)
+		}
+	case []interface{}:
+		for i, child := range v {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			if f.matchesMaskPattern(childPath) {
+				v[i] = maskValue(child)
+				continue
+			}
+			f.maskMatchingFields(child, childPath)
+		}
+	}
+}
+
+func (f *SensitiveDataFilter) matchesMaskPattern(path string) bool {
+	for _, pattern := range f.maskPatterns {
+		if pattern.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskValue returns the masked form of value: a map keeps its keys with every
+// value masked, anything else becomes the placeholder.
+func maskValue(value interface{}) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return maskedValue
+	}
+	for key := range m {
+		m[key] = maskedValue
+	}
+	return m
+}
@@ -131,7 +131,10 @@ func TestNewSensitiveDataFilterFromParams_ShowSensitiveDataTrue(t *testing.T) {
 	params := map[string]interface{}{
 		"showSensitiveData": true,
 	}
-	filter := NewSensitiveDataFilterFromParams(params)
+	filter, err := NewSensitiveDataFilterFromParams(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 	if filter != nil {
 		t.Error("expected nil filter when showSensitiveData is true")
 	}
@@ -141,7 +144,10 @@ func TestNewSensitiveDataFilterFromParams_ShowSensitiveDataFalse(t *testing.T) {
 	params := map[string]interface{}{
 		"showSensitiveData": false,
 	}
-	filter := NewSensitiveDataFilterFromParams(params)
+	filter, err := NewSensitiveDataFilterFromParams(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 	if filter == nil {
 		t.Error("expected non-nil filter when showSensitiveData is false")
 	}
@@ -149,7 +155,10 @@ func TestNewSensitiveDataFilterFromParams_ShowSensitiveDataFalse(t *testing.T) {
 
 func TestNewSensitiveDataFilterFromParams_Default(t *testing.T) {
 	params := map[string]interface{}{}
-	filter := NewSensitiveDataFilterFromParams(params)
+	filter, err := NewSensitiveDataFilterFromParams(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 	if filter == nil {
 		t.Error("expected non-nil filter when showSensitiveData is not set")
 	}
@@ -248,3 +257,107 @@ func TestSensitiveDataFilter_CaseInsensitiveKind(t *testing.T) {
 		t.Errorf("expected case-insensitive kind match to mask data, got %v", data["key"])
 	}
 }
+
+func TestNewSensitiveDataFilterFromParams_MaskPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "mask paths with secrets shown", params: map[string]interface{}{"showSensitiveData": true, "maskPaths": "data.token"}},
+		{name: "blank mask paths with secrets shown", params: map[string]interface{}{"showSensitiveData": true, "maskPaths": " , "}, wantNil: true},
+		{name: "invalid pattern", params: map[string]interface{}{"maskPaths": "/[/"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewSensitiveDataFilterFromParams(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (filter == nil) != tt.wantNil {
+				t.Errorf("filter = %v, wantNil %v", filter, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestSensitiveDataFilter_MaskPaths(t *testing.T) {
+	cm := newConfigMap("app", map[string]interface{}{
+		"token": "abc",
+		"level": "debug",
+	})
+	cm.Object["metadata"].(map[string]interface{})["annotations"] = map[string]interface{}{
+		"example.com/db-password": "hunter2",
+		"example.com/owner":       "team-a",
+	}
+	cm.Object["spec"] = map[string]interface{}{
+		"credentials": map[string]interface{}{"user": "admin", "pass": "secret"},
+		"containers": []interface{}{
+			map[string]interface{}{"name": "app", "env": []interface{}{
+				map[string]interface{}{"name": "API_TOKEN", "value": "xyz"},
+			}},
+		},
+	}
+
+	filter, err := NewSensitiveDataFilter(nil).WithMaskPaths([]string{
+		"data.token",
+		"metadata.annotations.example.com/db-password",
+		"spec.credentials",
+		"spec.missing.field",
+		`/^spec\.containers\[\d+\]\.env\[\d+\]\.value$/`,
+	})
+	if err != nil {
+		t.Fatalf("WithMaskPaths() unexpected error: %v", err)
+	}
+	result := filter.Filter(cm)
+
+	checks := []struct {
+		fields []string
+		want   interface{}
+	}{
+		{[]string{"data", "token"}, maskedValue},
+		{[]string{"data", "level"}, "debug"},
+		{[]string{"metadata", "annotations", "example.com/db-password"}, maskedValue},
+		{[]string{"metadata", "annotations", "example.com/owner"}, "team-a"},
+		{[]string{"spec", "credentials", "user"}, maskedValue},
+		{[]string{"spec", "credentials", "pass"}, maskedValue},
+	}
+	for _, c := range checks {
+		got, _, _ := unstructured.NestedFieldNoCopy(result.Object, c.fields...)
+		if got != c.want {
+			t.Errorf("%v = %v, want %v", c.fields, got, c.want)
+		}
+	}
+
+	containers, _, _ := unstructured.NestedSlice(result.Object, "spec", "containers")
+	env := containers[0].(map[string]interface{})["env"].([]interface{})[0].(map[string]interface{})
+	if env["value"] != maskedValue || env["name"] != "API_TOKEN" {
+		t.Errorf("env = %v, want only the value masked", env)
+	}
+	if _, found, _ := unstructured.NestedFieldNoCopy(result.Object, "spec", "missing"); found {
+		t.Error("masking a missing path must not create it")
+	}
+
+	if original, _, _ := unstructured.NestedString(cm.Object, "data", "token"); original != "abc" {
+		t.Errorf("original configmap was modified, token = %q", original)
+	}
+}
+
+func TestSensitiveDataFilter_MaskPathsWithSecretRule(t *testing.T) {
+	secret := newSecret("creds", map[string]interface{}{"password": "c2VjcmV0"})
+	secret.Object["metadata"].(map[string]interface{})["labels"] = map[string]interface{}{"team": "a"}
+
+	filter, err := NewSensitiveDataFilter(DefaultSensitiveRules()).WithMaskPaths([]string{"metadata.labels.team"})
+	if err != nil {
+		t.Fatalf("WithMaskPaths() unexpected error: %v", err)
+	}
+	result := filter.Filter(secret)
+
+	if got, _, _ := unstructured.NestedString(result.Object, "data", "password"); got != maskedValue {
+		t.Errorf("data.password = %q, want masked", got)
+	}
+	if got, _, _ := unstructured.NestedString(result.Object, "metadata", "labels", "team"); got != maskedValue {
+		t.Errorf("metadata.labels.team = %q, want masked", got)
+	}
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
 
-// clusterToMap converts a cluster to a map with full resource details.
+// clusterTableHeaders are the summary columns of the cluster_list table.
+var clusterTableHeaders = []string{"id", "name", "state", "provider", "version", "nodes", "cpu", "ram", "pods"}
+
+// clusterToMap converts a cluster to a map of its summary table columns.
 func clusterToMap(c norman.Cluster) map[string]string {
 	version := ""
 	if c.Version != nil {
@@ -29,6 +32,21 @@ func clusterToMap(c norman.Cluster) map[string]string {
 	}
 }
 
+// clusterDetailToMap converts a cluster to the map used for json/yaml output:
+// the table columns plus the raw resource figures and version info.
+func clusterDetailToMap(c norman.Cluster) map[string]interface{} {
+	data := make(map[string]interface{})
+	for k, v := range clusterToMap(c) {
+		data[k] = v
+	}
+	data["allocatable"] = c.Allocatable
+	data["capacity"] = c.Capacity
+	data["requested"] = c.Requested
+	data["limits"] = c.Limits
+	data["versionInfo"] = c.Version
+	return data
+}
+
 // clusterListHandler handles the cluster_list tool
 func clusterListHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
 	normanClient, err := toolset.ValidateNormanClient(client)
@@ -57,12 +75,32 @@ func clusterListHandler(ctx context.Context, client interface{}, params map[stri
 	// Apply pagination
 	paginated, _ := paramutil.ApplyPagination(filtered, limit, page)
 
-	clusterMaps := make([]map[string]string, len(paginated))
-	for i, c := range paginated {
-		clusterMaps[i] = clusterToMap(c)
+	return formatClusterList(paginated, format)
+}
+
+// formatClusterList renders clusters. The row formats keep to the summary columns,
+// while json/yaml carry each cluster's allocatable, capacity and version details.
+func formatClusterList(clusters []norman.Cluster, format string) (string, error) {
+	if paramutil.IsRowFormat(format) {
+		rows := make([]map[string]string, len(clusters))
+		for i, c := range clusters {
+			rows[i] = clusterToMap(c)
+		}
+		return paramutil.FormatRows(rows, clusterTableHeaders, format)
 	}
 
-	return paramutil.FormatOutput(clusterMaps, format, []string{"id", "name", "state", "provider", "version", "nodes", "cpu", "ram", "pods"}, nil)
+	details := make([]map[string]interface{}, len(clusters))
+	for i, c := range clusters {
+		details[i] = clusterDetailToMap(c)
+	}
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(details)
+	case paramutil.FormatJSON:
+		return paramutil.FormatAsJSON(details)
+	default:
+		return "", fmt.Errorf("%w: %s", paramutil.ErrInvalidFormat, format)
+	}
 }
 
 // filterClustersByName filters clusters by name (partial match, case-insensitive).
@@ -0,0 +1,56 @@
+package rancher
+
+import (
+	"strings"
+	"testing"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+)
+
+func TestFormatClusterList(t *testing.T) {
+	clusters := []norman.Cluster{{
+		Name:        "prod",
+		State:       "active",
+		Driver:      "rke2",
+		Allocatable: map[string]string{"cpu": "8", "memory": "32Gi", "pods": "220"},
+		Capacity:    map[string]string{"cpu": "8", "memory": "32Gi", "pods": "220"},
+		Requested:   map[string]string{"cpu": "1500m", "pods": "42"},
+		Version:     &managementClient.Info{GitVersion: "v1.30.4+rke2r1", Platform: "linux/amd64"},
+	}}
+
+	tests := []struct {
+		name    string
+		format  string
+		want    []string
+		notWant []string
+	}{
+		{name: "json includes details", format: "json", want: []string{`"allocatable"`, `"capacity"`, `"1500m"`, `"platform": "linux/amd64"`, `"version": "v1.30.4+rke2r1"`}},
+		{name: "yaml includes details", format: "yaml", want: []string{"allocatable:", "capacity:", "requested:", "platform: linux/amd64"}},
+		{name: "table stays summary", format: "table", want: []string{"prod", "RKE2", "42/220"}, notWant: []string{"linux/amd64", "1500m"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := formatClusterList(clusters, tt.format)
+			if err != nil {
+				t.Fatalf("formatClusterList() unexpected error: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("expected output to contain %q, got:\n%s", w, out)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(out, w) {
+					t.Errorf("expected output not to contain %q, got:\n%s", w, out)
+				}
+			}
+		})
+	}
+
+	if _, err := formatClusterList(clusters, "xml"); err == nil {
+		t.Error("formatClusterList() expected error for invalid format")
+	}
+}
@@ -0,0 +1,260 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+const (
+	healthVerdictOK       = "OK"
+	healthVerdictDegraded = "DEGRADED"
+)
+
+// clusterHealth summarizes the health of a single Rancher cluster.
+type clusterHealth struct {
+	Verdict             string              `json:"verdict" yaml:"verdict"`
+	Cluster             string              `json:"cluster" yaml:"cluster"`
+	Name                string              `json:"name" yaml:"name"`
+	State               string              `json:"state" yaml:"state"`
+	Nodes               nodeHealthCounts    `json:"nodes" yaml:"nodes"`
+	UnhealthyNodes      []unhealthyNode     `json:"unhealthyNodes,omitempty" yaml:"unhealthyNodes,omitempty"`
+	FailingConditions   []failingCondition  `json:"failingConditions,omitempty" yaml:"failingConditions,omitempty"`
+	UnhealthyComponents []unhealthyResource `json:"unhealthyComponents,omitempty" yaml:"unhealthyComponents,omitempty"`
+}
+
+// nodeHealthCounts counts nodes by their Ready condition.
+type nodeHealthCounts struct {
+	Total    int `json:"total" yaml:"total"`
+	Ready    int `json:"ready" yaml:"ready"`
+	NotReady int `json:"notReady" yaml:"notReady"`
+}
+
+// unhealthyNode describes a control-plane or etcd node that is not Ready.
+type unhealthyNode struct {
+	Name   string `json:"name" yaml:"name"`
+	Roles  string `json:"roles" yaml:"roles"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// failingCondition is a cluster condition that is not True.
+type failingCondition struct {
+	Type    string `json:"type" yaml:"type"`
+	Status  string `json:"status" yaml:"status"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// unhealthyResource is a cluster component reporting an unhealthy condition.
+type unhealthyResource struct {
+	Name    string `json:"name" yaml:"name"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// clusterHealthHandler handles the cluster_health tool
+func clusterHealthHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	clusterID, err := paramutil.ResolveCluster(ctx, normanClient, params)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := normanClient.LookupCluster(ctx, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	nodes, err := normanClient.ListNodes(ctx, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatClusterHealth(buildClusterHealth(*cluster, nodes), format)
+}
+
+// buildClusterHealth evaluates cluster state, cluster conditions, component
+// statuses and node readiness. Any problem marks the cluster DEGRADED.
+func buildClusterHealth(cluster norman.Cluster, nodes []norman.Node) clusterHealth {
+	health := clusterHealth{
+		Cluster: cluster.ID,
+		Name:    cluster.Name,
+		State:   cluster.State,
+	}
+
+	for _, cond := range cluster.Conditions {
+		// Rancher records transient provisioning conditions as Unknown; only
+		// the Ready condition reflects whether the cluster is serving.
+		if cond.Type == "Ready" && cond.Status != "True" {
+			health.FailingConditions = append(health.FailingConditions, failingCondition{
+				Type:    cond.Type,
+				Status:  cond.Status,
+				Message: cond.Message,
+			})
+		}
+	}
+
+	for _, component := range cluster.ComponentStatuses {
+		for _, cond := range component.Conditions {
+			if cond.Type == "Healthy" && cond.Status != "True" {
+				message := cond.Message
+				if message == "" {
+					message = cond.Error
+				}
+				health.UnhealthyComponents = append(health.UnhealthyComponents, unhealthyResource{
+					Name:    component.Name,
+					Message: message,
+				})
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		health.Nodes.Total++
+		ready, reason := nodeReadiness(node)
+		if ready {
+			health.Nodes.Ready++
+			continue
+		}
+		health.Nodes.NotReady++
+		if node.ControlPlane || node.Etcd {
+			health.UnhealthyNodes = append(health.UnhealthyNodes, unhealthyNode{
+				Name:   nodeDisplayName(node),
+				Roles:  nodeRoles(node),
+				Reason: reason,
+			})
+		}
+	}
+	sort.Slice(health.UnhealthyNodes, func(i, j int) bool {
+		return health.UnhealthyNodes[i].Name < health.UnhealthyNodes[j].Name
+	})
+
+	health.Verdict = healthVerdictOK
+	if health.State != "active" || health.Nodes.NotReady > 0 ||
+		len(health.FailingConditions) > 0 || len(health.UnhealthyComponents) > 0 {
+		health.Verdict = healthVerdictDegraded
+	}
+	return health
+}
+
+// nodeReadiness reports whether a node's Ready condition is True, with the
+// condition message (or a placeholder) when it is not.
+func nodeReadiness(node norman.Node) (bool, string) {
+	for _, cond := range node.Conditions {
+		if cond.Type != "Ready" {
+			continue
+		}
+		if cond.Status == "True" {
+			return true, ""
+		}
+		if cond.Message != "" {
+			return false, cond.Message
+		}
+		return false, "Ready=" + cond.Status
+	}
+	return false, "no Ready condition reported"
+}
+
+// nodeDisplayName prefers the Kubernetes node name over the Rancher node ID.
+func nodeDisplayName(node norman.Node) string {
+	switch {
+	case node.NodeName != "":
+		return node.NodeName
+	case node.Hostname != "":
+		return node.Hostname
+	default:
+		return node.ID
+	}
+}
+
+// nodeRoles lists the Rancher roles of a node, e.g. "controlplane,etcd".
+func nodeRoles(node norman.Node) string {
+	var roles []string
+	if node.ControlPlane {
+		roles = append(roles, "controlplane")
+	}
+	if node.Etcd {
+		roles = append(roles, "etcd")
+	}
+	if node.Worker {
+		roles = append(roles, "worker")
+	}
+	return strings.Join(roles, ",")
+}
+
+// formatClusterHealth renders the health summary. The table and markdown
+// formats print the verdict followed by one row per check; csv carries the
+// verdict as the first row.
+func formatClusterHealth(health clusterHealth, format string) (string, error) {
+	headers := []string{"check", "status", "detail"}
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(health)
+	case paramutil.FormatJSON:
+		return paramutil.FormatAsJSON(health)
+	case paramutil.FormatTable, paramutil.FormatMarkdown:
+		rows, err := paramutil.FormatRows(clusterHealthRows(health), headers, format)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Cluster %s (%s): %s\n\n%s", health.Name, health.Cluster, health.Verdict, rows), nil
+	case paramutil.FormatCSV:
+		verdict := map[string]string{"check": "verdict", "status": health.Verdict, "detail": fmt.Sprintf("%s (%s)", health.Name, health.Cluster)}
+		return paramutil.FormatAsCSV(append([]map[string]string{verdict}, clusterHealthRows(health)...), headers)
+	default:
+		return "", fmt.Errorf("%w: %s", paramutil.ErrInvalidFormat, format)
+	}
+}
+
+// clusterHealthRows flattens the health summary into table rows.
+func clusterHealthRows(health clusterHealth) []map[string]string {
+	rows := []map[string]string{
+		{"check": "state", "status": checkStatus(health.State == "active"), "detail": health.State},
+		{
+			"check":  "nodes",
+			"status": checkStatus(health.Nodes.NotReady == 0),
+			"detail": fmt.Sprintf("%d/%d ready", health.Nodes.Ready, health.Nodes.Total),
+		},
+	}
+	for _, node := range health.UnhealthyNodes {
+		rows = append(rows, map[string]string{
+			"check":  "node " + node.Name,
+			"status": checkStatus(false),
+			"detail": fmt.Sprintf("%s: %s", node.Roles, node.Reason),
+		})
+	}
+	for _, cond := range health.FailingConditions {
+		rows = append(rows, map[string]string{
+			"check":  "condition " + cond.Type,
+			"status": checkStatus(false),
+			"detail": fmt.Sprintf("%s: %s", cond.Status, cond.Message),
+		})
+	}
+	for _, component := range health.UnhealthyComponents {
+		rows = append(rows, map[string]string{
+			"check":  "component " + component.Name,
+			"status": checkStatus(false),
+			"detail": component.Message,
+		})
+	}
+	return rows
+}
+
+func checkStatus(ok bool) string {
+	if ok {
+		return healthVerdictOK
+	}
+	return "FAIL"
+}
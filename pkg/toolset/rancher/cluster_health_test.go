@@ -0,0 +1,142 @@
+package rancher
+
+import (
+	"strings"
+	"testing"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+)
+
+func makeHealthNode(name string, controlPlane, etcd, worker bool, readyStatus string) norman.Node {
+	n := norman.Node{NodeName: name, ControlPlane: controlPlane, Etcd: etcd, Worker: worker}
+	if readyStatus != "" {
+		n.Conditions = []managementClient.NodeCondition{{Type: "Ready", Status: readyStatus}}
+	}
+	return n
+}
+
+func TestBuildClusterHealth(t *testing.T) {
+	tests := []struct {
+		name               string
+		cluster            norman.Cluster
+		nodes              []norman.Node
+		wantVerdict        string
+		wantReady          int
+		wantNotReady       int
+		wantUnhealthyNodes []string
+	}{
+		{
+			name:    "healthy",
+			cluster: norman.Cluster{Name: "prod", State: "active"},
+			nodes: []norman.Node{
+				makeHealthNode("cp-1", true, true, false, "True"),
+				makeHealthNode("worker-1", false, false, true, "True"),
+			},
+			wantVerdict: healthVerdictOK,
+			wantReady:   2,
+		},
+		{
+			name:    "not ready worker degrades without listing it",
+			cluster: norman.Cluster{Name: "prod", State: "active"},
+			nodes: []norman.Node{
+				makeHealthNode("cp-1", true, true, false, "True"),
+				makeHealthNode("worker-1", false, false, true, "False"),
+			},
+			wantVerdict:  healthVerdictDegraded,
+			wantReady:    1,
+			wantNotReady: 1,
+		},
+		{
+			name:    "unhealthy control plane and etcd nodes are listed",
+			cluster: norman.Cluster{Name: "prod", State: "active"},
+			nodes: []norman.Node{
+				makeHealthNode("etcd-1", false, true, false, "Unknown"),
+				makeHealthNode("cp-1", true, false, false, ""),
+				makeHealthNode("worker-1", false, false, true, "True"),
+			},
+			wantVerdict:        healthVerdictDegraded,
+			wantReady:          1,
+			wantNotReady:       2,
+			wantUnhealthyNodes: []string{"cp-1", "etcd-1"},
+		},
+		{
+			name:        "inactive cluster state",
+			cluster:     norman.Cluster{Name: "prod", State: "updating"},
+			wantVerdict: healthVerdictDegraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildClusterHealth(tt.cluster, tt.nodes)
+			if got.Verdict != tt.wantVerdict {
+				t.Errorf("verdict = %q, want %q", got.Verdict, tt.wantVerdict)
+			}
+			if got.Nodes.Ready != tt.wantReady || got.Nodes.NotReady != tt.wantNotReady {
+				t.Errorf("nodes = %+v, want ready=%d notReady=%d", got.Nodes, tt.wantReady, tt.wantNotReady)
+			}
+			var names []string
+			for _, n := range got.UnhealthyNodes {
+				names = append(names, n.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantUnhealthyNodes, ",") {
+				t.Errorf("unhealthy nodes = %v, want %v", names, tt.wantUnhealthyNodes)
+			}
+		})
+	}
+}
+
+func TestBuildClusterHealth_ConditionsAndComponents(t *testing.T) {
+	cluster := norman.Cluster{
+		Name:  "prod",
+		State: "active",
+		Conditions: []managementClient.ClusterCondition{
+			{Type: "Provisioned", Status: "Unknown"},
+			{Type: "Ready", Status: "False", Message: "cluster agent is not connected"},
+		},
+		ComponentStatuses: []managementClient.ClusterComponentStatus{
+			{Name: "etcd-0", Conditions: []managementClient.ComponentCondition{{Type: "Healthy", Status: "True"}}},
+			{Name: "scheduler", Conditions: []managementClient.ComponentCondition{{Type: "Healthy", Status: "False", Error: "connection refused"}}},
+		},
+	}
+
+	got := buildClusterHealth(cluster, nil)
+	if got.Verdict != healthVerdictDegraded {
+		t.Errorf("verdict = %q, want %q", got.Verdict, healthVerdictDegraded)
+	}
+	if len(got.FailingConditions) != 1 || got.FailingConditions[0].Type != "Ready" {
+		t.Errorf("failing conditions = %+v, want only Ready", got.FailingConditions)
+	}
+	if len(got.UnhealthyComponents) != 1 || got.UnhealthyComponents[0].Message != "connection refused" {
+		t.Errorf("unhealthy components = %+v, want scheduler with error message", got.UnhealthyComponents)
+	}
+}
+
+func TestFormatClusterHealth_Table(t *testing.T) {
+	health := buildClusterHealth(
+		norman.Cluster{Name: "prod", State: "active"},
+		[]norman.Node{makeHealthNode("cp-1", true, false, false, "False")},
+	)
+	health.Cluster = "c-abc123"
+
+	out, err := formatClusterHealth(health, "table")
+	if err != nil {
+		t.Fatalf("formatClusterHealth() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(out, "Cluster prod (c-abc123): DEGRADED") {
+		t.Errorf("expected verdict header, got:\n%s", out)
+	}
+	for _, want := range []string{"0/1 ready", "node cp-1", "controlplane: Ready=False"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatClusterHealth_InvalidFormat(t *testing.T) {
+	if _, err := formatClusterHealth(clusterHealth{}, "xml"); err == nil {
+		t.Fatal("expected error for invalid format")
+	}
+}
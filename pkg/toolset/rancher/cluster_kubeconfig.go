@@ -0,0 +1,140 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/client/steve"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// Kubeconfig token lifetimes, in seconds.
+const (
+	DefaultKubeconfigTTLSeconds = 3600
+	MinKubeconfigTTLSeconds     = 60
+)
+
+// DefaultKubeconfigMaxTTL is the longest token lifetime cluster_kubeconfig
+// accepts unless kubeconfig_max_ttl configures another cap.
+const DefaultKubeconfigMaxTTL = 24 * time.Hour
+
+// Output formats of cluster_kubeconfig.
+const (
+	kubeconfigFormatKubeconfig = "kubeconfig"
+	kubeconfigFormatToken      = "token"
+)
+
+// kubeconfigRequest holds the validated parameters of cluster_kubeconfig.
+type kubeconfigRequest struct {
+	cluster string
+	project string
+	options norman.KubeconfigOptions
+	format  string
+}
+
+// clusterKubeconfigHandler handles the cluster_kubeconfig tool
+func clusterKubeconfigHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := buildKubeconfigRequest(params)
+	if err != nil {
+		return "", err
+	}
+
+	if request.project != "" {
+		project, err := normanClient.LookupProject(ctx, request.cluster, request.project)
+		if err != nil {
+			return "", err
+		}
+		request.options.ProjectID = project.ID
+		request.options.Namespace, err = projectDefaultNamespace(ctx, client, request.cluster, project.ID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	kubeconfig, err := normanClient.GenerateKubeconfigWithToken(ctx, request.cluster, request.options)
+	if err != nil {
+		return "", err
+	}
+	return formatKubeconfig(kubeconfig, request.format), nil
+}
+
+func buildKubeconfigRequest(params map[string]interface{}) (*kubeconfigRequest, error) {
+	cluster, err := paramutil.ExtractRequiredString(params, paramutil.ParamCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	ttlSeconds := paramutil.ExtractInt64(params, paramutil.ParamTTLSeconds, DefaultKubeconfigTTLSeconds)
+	if ttlSeconds < MinKubeconfigTTLSeconds {
+		return nil, fmt.Errorf("ttlSeconds must be at least %d, got %d", MinKubeconfigTTLSeconds, ttlSeconds)
+	}
+	maxTTLSeconds := paramutil.ExtractInt64(params, paramutil.ParamMaxTTLSeconds, int64(DefaultKubeconfigMaxTTL/time.Second))
+	if ttlSeconds > maxTTLSeconds {
+		return nil, fmt.Errorf("ttlSeconds must be at most %d (kubeconfig_max_ttl), got %d", maxTTLSeconds, ttlSeconds)
+	}
+
+	format := paramutil.ExtractOptionalStringWithDefault(params, paramutil.ParamFormat, kubeconfigFormatKubeconfig)
+	if format != kubeconfigFormatKubeconfig && format != kubeconfigFormatToken {
+		return nil, fmt.Errorf("%w: %s (supported formats: %s, %s)", paramutil.ErrInvalidFormat, format, kubeconfigFormatKubeconfig, kubeconfigFormatToken)
+	}
+
+	return &kubeconfigRequest{
+		cluster: cluster,
+		project: paramutil.ExtractOptionalString(params, paramutil.ParamProject),
+		options: norman.KubeconfigOptions{
+			TTL:           time.Duration(ttlSeconds) * time.Second,
+			ClusterScoped: paramutil.ExtractBool(params, paramutil.ParamClusterScoped, true),
+		},
+		format: format,
+	}, nil
+}
+
+// projectDefaultNamespace returns the first namespace, by name, assigned to the
+// project, or "" when the project has none or no Kubernetes client is
+// configured to look them up.
+func projectDefaultNamespace(ctx context.Context, client interface{}, clusterID, projectID string) (string, error) {
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", nil
+	}
+	_, shortID, found := strings.Cut(projectID, ":")
+	if !found {
+		shortID = projectID
+	}
+	list, err := steveClient.ListResources(ctx, clusterID, "namespace", "", &steve.ListOptions{LabelSelector: projectIDKey + "=" + shortID})
+	if err != nil {
+		return "", fmt.Errorf("failed to list namespaces of project %s: %w", projectID, err)
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, ns := range list.Items {
+		names = append(names, ns.GetName())
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	sort.Strings(names)
+	return names[0], nil
+}
+
+// formatKubeconfig returns the bearer token alone, or the kubeconfig with a
+// leading comment naming the token and its expiry so it can be revoked.
+func formatKubeconfig(kubeconfig *norman.Kubeconfig, format string) string {
+	if format == kubeconfigFormatToken {
+		return kubeconfig.Token
+	}
+	header := fmt.Sprintf("# Rancher token %s", kubeconfig.TokenName)
+	if kubeconfig.ExpiresAt != "" {
+		header += " expires at " + kubeconfig.ExpiresAt
+	}
+	return header + "\n" + kubeconfig.Config
+}
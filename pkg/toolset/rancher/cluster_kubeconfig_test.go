@@ -0,0 +1,67 @@
+package rancher
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+func TestBuildKubeconfigRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		wantTTL    time.Duration
+		wantScoped bool
+		wantFormat string
+		wantProj   string
+		wantErr    string
+		wantErrIs  error
+	}{
+		{name: "defaults", params: map[string]interface{}{"cluster": "c-1"}, wantTTL: time.Hour, wantScoped: true, wantFormat: "kubeconfig"},
+		{name: "unscoped token", params: map[string]interface{}{"cluster": "c-1", "ttlSeconds": float64(600), "clusterScoped": false, "format": "token"}, wantTTL: 10 * time.Minute, wantFormat: "token"},
+		{name: "project", params: map[string]interface{}{"cluster": "c-1", "project": "c-1:p-abc"}, wantTTL: time.Hour, wantScoped: true, wantFormat: "kubeconfig", wantProj: "c-1:p-abc"},
+		{name: "missing cluster", params: map[string]interface{}{}, wantErrIs: paramutil.ErrMissingParameter},
+		{name: "ttl too short", params: map[string]interface{}{"cluster": "c-1", "ttlSeconds": float64(30)}, wantErr: "ttlSeconds must be at least 60"},
+		{name: "ttl at default cap", params: map[string]interface{}{"cluster": "c-1", "ttlSeconds": float64(86400)}, wantTTL: 24 * time.Hour, wantScoped: true, wantFormat: "kubeconfig"},
+		{name: "ttl above default cap", params: map[string]interface{}{"cluster": "c-1", "ttlSeconds": float64(86401)}, wantErr: "ttlSeconds must be at most 86400"},
+		{name: "ttl above configured cap", params: map[string]interface{}{"cluster": "c-1", "ttlSeconds": float64(7200), "maxTTLSeconds": int64(3600)}, wantErr: "ttlSeconds must be at most 3600"},
+		{name: "invalid format", params: map[string]interface{}{"cluster": "c-1", "format": "json"}, wantErrIs: paramutil.ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := buildKubeconfigRequest(tt.params)
+			if tt.wantErr != "" || tt.wantErrIs != nil {
+				if err == nil || (tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr)) || (tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs)) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if request.options.TTL != tt.wantTTL || request.options.ClusterScoped != tt.wantScoped || request.format != tt.wantFormat || request.project != tt.wantProj {
+				t.Errorf("request = %+v, want ttl %s scoped %v format %s project %q", request, tt.wantTTL, tt.wantScoped, tt.wantFormat, tt.wantProj)
+			}
+		})
+	}
+}
+
+func TestFormatKubeconfig(t *testing.T) {
+	kubeconfig := &norman.Kubeconfig{
+		Config:    "apiVersion: v1\nkind: Config\n",
+		Token:     "kubeconfig-u-abc:secret",
+		TokenName: "kubeconfig-u-abc",
+		ExpiresAt: "2026-10-17T05:00:00Z",
+	}
+	if got := formatKubeconfig(kubeconfig, kubeconfigFormatToken); got != "kubeconfig-u-abc:secret" {
+		t.Errorf("token format = %q", got)
+	}
+	want := "# Rancher token kubeconfig-u-abc expires at 2026-10-17T05:00:00Z\napiVersion: v1\nkind: Config\n"
+	if got := formatKubeconfig(kubeconfig, kubeconfigFormatKubeconfig); got != want {
+		t.Errorf("kubeconfig format = %q, want %q", got, want)
+	}
+}
@@ -1,8 +1,9 @@
 // Package rancher provides Rancher-specific toolset for multi-cluster management.
 // It implements MCP tools for managing Rancher resources including:
-//   - Clusters (list)
-//   - Projects (list)
+//   - Clusters (list, health, kubeconfig)
+//   - Nodes (list across clusters)
+//   - Projects (list, get, members, namespace project lookup)
 //
-// All tools support multiple output formats (JSON, YAML, table) and
-// are marked as read-only operations.
+// All tools except cluster_kubeconfig, which mints a Rancher token, are
+// read-only and support multiple output formats (JSON, YAML, table).
 package rancher
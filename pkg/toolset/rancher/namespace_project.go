@@ -0,0 +1,90 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// projectIDKey is the annotation and label Rancher sets on namespaces that are
+// assigned to a project. The annotation holds "<clusterID>:<projectID>", the
+// label only "<projectID>".
+const projectIDKey = "field.cattle.io/projectId"
+
+// namespaceProjectHandler handles the namespace_project tool
+func namespaceProjectHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+	steveClient, err := toolset.ValidateSteveClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	clusterID, err := paramutil.ResolveCluster(ctx, normanClient, params)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := paramutil.ExtractRequiredString(params, paramutil.ParamNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	detected, ok := normanClient.CachedNamespaceProject(clusterID, namespace)
+	if !ok {
+		ns, err := steveClient.GetResource(ctx, clusterID, "namespace", "", namespace)
+		if err != nil {
+			return "", fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+		}
+		detected.ProjectID, detected.Source, err = detectNamespaceProject(ns, clusterID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	project, err := normanClient.LookupProject(ctx, clusterID, detected.ProjectID)
+	if err != nil {
+		// The project may have been deleted since the namespace was detected
+		normanClient.InvalidateNamespaceProject(clusterID, namespace)
+		return "", err
+	}
+	if !ok {
+		normanClient.StoreNamespaceProject(clusterID, namespace, detected)
+	}
+
+	data := map[string]interface{}{
+		"cluster":     clusterID,
+		"namespace":   namespace,
+		"projectId":   project.ID,
+		"projectName": project.Name,
+		"strategy":    detected.Source,
+	}
+	return paramutil.FormatSingleResult(data, format, "cluster", "namespace", "projectId", "projectName", "strategy")
+}
+
+// detectNamespaceProject returns the full project ID ("<clusterID>:<projectID>")
+// a namespace is assigned to and the metadata it was detected from. The
+// annotation is preferred because it carries the cluster ID; the label is the
+// fallback.
+func detectNamespaceProject(ns *unstructured.Unstructured, clusterID string) (projectID, strategy string, err error) {
+	if value := ns.GetAnnotations()[projectIDKey]; value != "" {
+		if !strings.Contains(value, ":") {
+			value = clusterID + ":" + value
+		}
+		return value, "annotation " + projectIDKey, nil
+	}
+	if value := ns.GetLabels()[projectIDKey]; value != "" {
+		return clusterID + ":" + value, "label " + projectIDKey, nil
+	}
+	return "", "", fmt.Errorf("namespace %s is not assigned to any project (no %s annotation or label); move it into a project in Rancher first", ns.GetName(), projectIDKey)
+}
@@ -0,0 +1,64 @@
+package rancher
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDetectNamespaceProject(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		labels       map[string]string
+		wantID       string
+		wantStrategy string
+		wantErr      bool
+	}{
+		{
+			name:         "annotation with cluster prefix",
+			annotations:  map[string]string{projectIDKey: "c-abc:p-xyz"},
+			labels:       map[string]string{projectIDKey: "p-xyz"},
+			wantID:       "c-abc:p-xyz",
+			wantStrategy: "annotation",
+		},
+		{
+			name:         "label only",
+			labels:       map[string]string{projectIDKey: "p-xyz"},
+			wantID:       "c-abc:p-xyz",
+			wantStrategy: "label",
+		},
+		{
+			name:    "unassigned namespace",
+			labels:  map[string]string{"team": "web"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			ns.SetName("web")
+			ns.SetAnnotations(tt.annotations)
+			ns.SetLabels(tt.labels)
+
+			id, strategy, err := detectNamespaceProject(ns, "c-abc")
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "not assigned to any project") {
+					t.Fatalf("detectNamespaceProject() error = %v, want not-assigned error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("detectNamespaceProject() unexpected error: %v", err)
+			}
+			if id != tt.wantID {
+				t.Errorf("projectID = %q, want %q", id, tt.wantID)
+			}
+			if !strings.HasPrefix(strategy, tt.wantStrategy) {
+				t.Errorf("strategy = %q, want prefix %q", strategy, tt.wantStrategy)
+			}
+		})
+	}
+}
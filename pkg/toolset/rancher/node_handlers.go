@@ -0,0 +1,261 @@
+package rancher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// maxConcurrentClusterRequests bounds the per-cluster API calls made in parallel
+// when listing across all clusters.
+const maxConcurrentClusterRequests = 8
+
+// clusterError records a cluster whose request failed during a multi-cluster listing.
+type clusterError struct {
+	Cluster string `json:"cluster" yaml:"cluster"`
+	Error   string `json:"error" yaml:"error"`
+}
+
+// nodeListResult is the json/yaml shape of node_list when includeErrors is set.
+type nodeListResult struct {
+	Nodes  []map[string]interface{} `json:"nodes" yaml:"nodes"`
+	Errors []clusterError           `json:"errors" yaml:"errors"`
+}
+
+// nodeTableHeaders are the summary columns of the node_list table.
+var nodeTableHeaders = []string{"cluster", "id", "name", "state", "roles", "ready", "age"}
+
+// clusterErrorHeaders are the columns of the failed clusters table.
+var clusterErrorHeaders = []string{"cluster", "error"}
+
+// nodeListFunc lists the nodes of one cluster; it matches norman.Client.ListNodes.
+type nodeListFunc func(ctx context.Context, clusterID string) ([]norman.Node, error)
+
+// nodeToMap converts a node to a string map for output formatting.
+func nodeToMap(n norman.Node) map[string]string {
+	ready, _ := nodeReadiness(n)
+	readyStr := "False"
+	if ready {
+		readyStr = "True"
+	}
+	return map[string]string{
+		"cluster": n.ClusterID,
+		"id":      n.ID,
+		"name":    nodeDisplayName(n),
+		"state":   n.State,
+		"roles":   nodeRoles(n),
+		"ready":   readyStr,
+		"age":     paramutil.FormatAge(n.Created),
+	}
+}
+
+// nodeDetailToMap converts a node to the map used for json/yaml output: the
+// table columns plus the resource and host details the table leaves out.
+func nodeDetailToMap(n norman.Node) map[string]interface{} {
+	data := make(map[string]interface{})
+	for k, v := range nodeToMap(n) {
+		data[k] = v
+	}
+	data["ipAddress"] = n.IPAddress
+	data["unschedulable"] = n.Unschedulable
+	data["allocatable"] = n.Allocatable
+	data["capacity"] = n.Capacity
+	data["requested"] = n.Requested
+	data["limits"] = n.Limits
+	data["taints"] = n.Taints
+	data["info"] = n.Info
+	return data
+}
+
+// nodeListHandler handles the node_list tool
+func nodeListHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract query and pagination parameters
+	nameFilter := paramutil.ExtractOptionalString(params, paramutil.ParamName)
+	limit := paramutil.ExtractInt64(params, paramutil.ParamLimit, 100)
+	page := paramutil.ExtractInt64(params, paramutil.ParamPage, 1)
+	includeErrors := paramutil.ExtractBool(params, paramutil.ParamIncludeErrors, false)
+
+	clusterID, err := paramutil.ResolveOptionalCluster(ctx, normanClient, params)
+	if err != nil {
+		return "", err
+	}
+
+	clusterIDs := []string{clusterID}
+	if clusterID == "" {
+		clusters, err := normanClient.ListClusters(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list clusters: %w", err)
+		}
+		clusterIDs = make([]string, len(clusters))
+		for i, c := range clusters {
+			clusterIDs[i] = c.ID
+		}
+	}
+
+	nodes, failures := fetchNodes(ctx, normanClient.ListNodes, clusterIDs)
+	// A single requested cluster that fails is an error, not an empty list
+	if clusterID != "" && len(failures) > 0 {
+		return "", fmt.Errorf("failed to list nodes for cluster %s: %s", clusterID, failures[0].Error)
+	}
+
+	// Apply name filter
+	filtered := filterNodesByName(nodes, nameFilter)
+
+	// Apply pagination
+	paginated, _ := paramutil.ApplyPagination(filtered, limit, page)
+
+	if !includeErrors {
+		return formatNodeList(paginated, format)
+	}
+	return formatNodeListWithErrors(paginated, failures, format)
+}
+
+// fetchNodes lists nodes for each cluster with a bounded worker pool. Nodes are
+// returned in cluster order; clusters whose listing failed are reported
+// separately instead of aborting the whole listing.
+func fetchNodes(ctx context.Context, listNodes nodeListFunc, clusterIDs []string) ([]norman.Node, []clusterError) {
+	return fetchAcrossClusters(ctx, listNodes, clusterIDs)
+}
+
+// fetchAcrossClusters calls list for each cluster with at most
+// maxConcurrentClusterRequests calls in flight. Items are returned in cluster
+// order, so the result does not depend on which call finishes first. Once ctx
+// is cancelled no further clusters are dispatched; they fail with ctx's error.
+func fetchAcrossClusters[T any](ctx context.Context, list func(context.Context, string) ([]T, error), clusterIDs []string) ([]T, []clusterError) {
+	results := make([][]T, len(clusterIDs))
+	errs := make([]error, len(clusterIDs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentClusterRequests)
+	for i, id := range clusterIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(clusterIDs); j++ {
+				errs[j] = err
+			}
+			break
+		}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = list(ctx, id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	var items []T
+	var failures []clusterError
+	for i, id := range clusterIDs {
+		if errs[i] != nil {
+			failures = append(failures, clusterError{Cluster: id, Error: errs[i].Error()})
+			continue
+		}
+		items = append(items, results[i]...)
+	}
+	return items, failures
+}
+
+// formatNodeList renders nodes. The row formats keep to the summary columns, while
+// json/yaml carry each node's full details such as allocatable, capacity and info.
+func formatNodeList(nodes []norman.Node, format string) (string, error) {
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(nodeDetails(nodes))
+	case paramutil.FormatJSON:
+		return paramutil.FormatAsJSON(nodeDetails(nodes))
+	default:
+		return paramutil.FormatRows(nodeRows(nodes), nodeTableHeaders, format)
+	}
+}
+
+// formatNodeListWithErrors renders nodes together with the clusters that could
+// not be listed: json/yaml wrap both in an object, the row formats append a
+// second table after a blank line.
+func formatNodeListWithErrors(nodes []norman.Node, failures []clusterError, format string) (string, error) {
+	if failures == nil {
+		failures = []clusterError{}
+	}
+
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(nodeListResult{Nodes: nodeDetails(nodes), Errors: failures})
+	case paramutil.FormatJSON:
+		return paramutil.FormatAsJSON(nodeListResult{Nodes: nodeDetails(nodes), Errors: failures})
+	}
+
+	return formatRowsWithClusterErrors(nodeRows(nodes), nodeTableHeaders, failures, format)
+}
+
+// formatRowsWithClusterErrors renders rows in a row format, followed by a
+// second table of the clusters that could not be listed, if any.
+func formatRowsWithClusterErrors(rows []map[string]string, headers []string, failures []clusterError, format string) (string, error) {
+	out, err := paramutil.FormatRows(rows, headers, format)
+	if err != nil || len(failures) == 0 {
+		return out, err
+	}
+	failed, err := paramutil.FormatRows(clusterErrorRows(failures), clusterErrorHeaders, format)
+	if err != nil {
+		return "", err
+	}
+	if format == paramutil.FormatCSV {
+		return out + "\n" + failed, nil
+	}
+	return fmt.Sprintf("%s\nFailed clusters (results are incomplete):\n%s", out, failed), nil
+}
+
+func clusterErrorRows(failures []clusterError) []map[string]string {
+	rows := make([]map[string]string, len(failures))
+	for i, f := range failures {
+		rows[i] = map[string]string{"cluster": f.Cluster, "error": f.Error}
+	}
+	return rows
+}
+
+func nodeRows(nodes []norman.Node) []map[string]string {
+	rows := make([]map[string]string, len(nodes))
+	for i, n := range nodes {
+		rows[i] = nodeToMap(n)
+	}
+	return rows
+}
+
+func nodeDetails(nodes []norman.Node) []map[string]interface{} {
+	details := make([]map[string]interface{}, len(nodes))
+	for i, n := range nodes {
+		details[i] = nodeDetailToMap(n)
+	}
+	return details
+}
+
+// filterNodesByName filters nodes by name (partial match, case-insensitive).
+func filterNodesByName(nodes []norman.Node, name string) []norman.Node {
+	if name == "" {
+		return nodes
+	}
+	var result []norman.Node
+	for _, n := range nodes {
+		if strings.Contains(strings.ToLower(nodeDisplayName(n)), strings.ToLower(name)) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
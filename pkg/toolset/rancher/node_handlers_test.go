@@ -0,0 +1,186 @@
+package rancher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+)
+
+func TestFetchNodes(t *testing.T) {
+	listNodes := func(_ context.Context, clusterID string) ([]norman.Node, error) {
+		if clusterID == "c-broken" {
+			return nil, errors.New("cluster agent disconnected")
+		}
+		return []norman.Node{
+			{NodeName: clusterID + "-n1", ClusterID: clusterID},
+			{NodeName: clusterID + "-n2", ClusterID: clusterID},
+		}, nil
+	}
+
+	nodes, failures := fetchNodes(context.Background(), listNodes, []string{"c-a", "c-broken", "c-b"})
+
+	var names []string
+	for _, n := range nodes {
+		names = append(names, n.NodeName)
+	}
+	if got, want := strings.Join(names, ","), "c-a-n1,c-a-n2,c-b-n1,c-b-n2"; got != want {
+		t.Errorf("nodes = %s, want %s", got, want)
+	}
+	if len(failures) != 1 || failures[0].Cluster != "c-broken" || failures[0].Error != "cluster agent disconnected" {
+		t.Errorf("failures = %+v, want only c-broken", failures)
+	}
+}
+
+func TestFetchNodes_BoundedConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	listNodes := func(_ context.Context, _ string) ([]norman.Node, error) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		defer inFlight.Add(-1)
+		return nil, nil
+	}
+
+	clusterIDs := make([]string, 5*maxConcurrentClusterRequests)
+	for i := range clusterIDs {
+		clusterIDs[i] = "c"
+	}
+	fetchNodes(context.Background(), listNodes, clusterIDs)
+
+	if p := peak.Load(); p > maxConcurrentClusterRequests {
+		t.Errorf("peak concurrency = %d, want <= %d", p, maxConcurrentClusterRequests)
+	}
+}
+
+func TestFetchNodes_StopsDispatchingOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	listNodes := func(ctx context.Context, _ string) ([]norman.Node, error) {
+		calls.Add(1)
+		cancel()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	clusterIDs := make([]string, 3*maxConcurrentClusterRequests)
+	for i := range clusterIDs {
+		clusterIDs[i] = "c"
+	}
+	_, failures := fetchNodes(ctx, listNodes, clusterIDs)
+
+	if got := calls.Load(); got > maxConcurrentClusterRequests {
+		t.Errorf("list calls = %d, want at most %d once the context is cancelled", got, maxConcurrentClusterRequests)
+	}
+	if len(failures) != len(clusterIDs) {
+		t.Fatalf("failures = %d, want every cluster to fail", len(failures))
+	}
+	for _, f := range failures {
+		if f.Error != context.Canceled.Error() {
+			t.Errorf("failure = %+v, want context canceled", f)
+		}
+	}
+}
+
+func TestFormatNodeListWithErrors(t *testing.T) {
+	nodes := []norman.Node{makeHealthNode("worker-1", false, false, true, "True")}
+	failures := []clusterError{{Cluster: "c-broken", Error: "timeout"}}
+
+	tests := []struct {
+		name     string
+		format   string
+		failures []clusterError
+		want     []string
+		notWant  []string
+	}{
+		{name: "json errors section", format: "json", failures: failures, want: []string{`"nodes"`, `"errors"`, `"c-broken"`, `"timeout"`}},
+		{name: "json empty errors", format: "json", want: []string{`"errors": []`}},
+		{name: "yaml errors section", format: "yaml", failures: failures, want: []string{"nodes:", "errors:", "cluster: c-broken"}},
+		{name: "table failed clusters", format: "table", failures: failures, want: []string{"worker-1", "Failed clusters", "c-broken", "timeout"}},
+		{name: "table without failures", format: "table", want: []string{"worker-1"}, notWant: []string{"Failed clusters"}},
+		{name: "markdown failed clusters", format: "markdown", failures: failures, want: []string{"| cluster | id |", "worker-1", "Failed clusters", "| c-broken | timeout |"}},
+		{name: "csv failed clusters", format: "csv", failures: failures, want: []string{"cluster,id,name,state,roles,ready,age\n", ",worker-1,", "\ncluster,error\nc-broken,timeout\n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := formatNodeListWithErrors(nodes, tt.failures, tt.format)
+			if err != nil {
+				t.Fatalf("formatNodeListWithErrors() unexpected error: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("expected output to contain %q, got:\n%s", w, out)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(out, w) {
+					t.Errorf("expected output not to contain %q, got:\n%s", w, out)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatNodeList(t *testing.T) {
+	node := makeHealthNode("worker-1", false, false, true, "True")
+	node.Allocatable = map[string]string{"cpu": "4", "memory": "16Gi"}
+	node.Capacity = map[string]string{"cpu": "4", "memory": "16Gi", "pods": "110"}
+	node.Info = &managementClient.NodeInfo{OS: &managementClient.OSInfo{KernelVersion: "6.1.0"}}
+	nodes := []norman.Node{node}
+
+	tests := []struct {
+		name    string
+		format  string
+		want    []string
+		notWant []string
+	}{
+		{name: "json includes details", format: "json", want: []string{`"allocatable"`, `"capacity"`, `"110"`, `"kernelVersion": "6.1.0"`, `"name": "worker-1"`}},
+		{name: "yaml includes details", format: "yaml", want: []string{"allocatable:", "capacity:", "kernelVersion: 6.1.0", "name: worker-1"}},
+		{name: "table stays summary", format: "table", want: []string{"worker-1", "roles"}, notWant: []string{"16Gi", "6.1.0"}},
+		{name: "csv stays summary", format: "csv", want: []string{"cluster,id,name,state,roles,ready,age\n", ",worker-1,"}, notWant: []string{"16Gi", "6.1.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := formatNodeList(nodes, tt.format)
+			if err != nil {
+				t.Fatalf("formatNodeList() unexpected error: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("expected output to contain %q, got:\n%s", w, out)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(out, w) {
+					t.Errorf("expected output not to contain %q, got:\n%s", w, out)
+				}
+			}
+		})
+	}
+
+	if _, err := formatNodeList(nodes, "xml"); err == nil {
+		t.Error("formatNodeList() expected error for invalid format")
+	}
+}
+
+func TestFilterNodesByName(t *testing.T) {
+	nodes := []norman.Node{{NodeName: "Worker-1"}, {NodeName: "cp-1"}, {Hostname: "worker-2"}}
+
+	if got := filterNodesByName(nodes, ""); len(got) != 3 {
+		t.Errorf("empty filter returned %d nodes, want 3", len(got))
+	}
+	if got := filterNodesByName(nodes, "worker"); len(got) != 2 {
+		t.Errorf("filter %q returned %d nodes, want 2", "worker", len(got))
+	}
+}
@@ -2,7 +2,10 @@ package rancher
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"sort"
 	"strings"
 
 	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
@@ -10,37 +13,39 @@ import (
 	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
 
-// fetchProjects retrieves projects based on optional cluster filter.
-// If clusterID is empty, returns projects from all clusters.
-func fetchProjects(ctx context.Context, client *norman.Client, clusterID string) ([]norman.Project, error) {
-	if clusterID != "" {
-		return client.ListProjects(ctx, clusterID)
-	}
+// projectListResult is the json/yaml shape of project_list when includeErrors is set.
+type projectListResult struct {
+	Projects []map[string]string `json:"projects" yaml:"projects"`
+	Errors   []clusterError      `json:"errors" yaml:"errors"`
+}
 
-	clusters, err := client.ListClusters(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list clusters: %w", err)
-	}
+// projectTableHeaders are the columns of the project_list table.
+var projectTableHeaders = []string{"id", "name", "cluster", "state", "created", "age", "description"}
 
-	var allProjects []norman.Project
-	for _, c := range clusters {
-		projects, err := client.ListProjects(ctx, c.ID)
-		if err != nil {
-			continue
-		}
-		allProjects = append(allProjects, projects...)
-	}
-	return allProjects, nil
+// projectListFunc lists the projects of one cluster; it matches norman.Client.ListProjects.
+type projectListFunc func(ctx context.Context, clusterID string) ([]norman.Project, error)
+
+// fetchProjects lists projects for each cluster with a bounded worker pool.
+// Projects are returned in cluster order; clusters whose listing failed are
+// reported separately instead of aborting the whole listing.
+func fetchProjects(ctx context.Context, listProjects projectListFunc, clusterIDs []string) ([]norman.Project, []clusterError) {
+	return fetchAcrossClusters(ctx, listProjects, clusterIDs)
 }
 
 // projectToMap converts a project to a string map for output formatting.
+// created keeps the RFC 3339 timestamp; see projectDisplayRows for tables.
 func projectToMap(p norman.Project) map[string]string {
+	created := p.Created
+	if created == "" {
+		created = "-"
+	}
 	return map[string]string{
 		"id":          p.ID,
 		"name":        p.Name,
 		"cluster":     p.ClusterID,
 		"state":       p.State,
-		"created":     paramutil.FormatTime(p.Created),
+		"created":     created,
+		"age":         paramutil.FormatAge(p.Created),
 		"description": p.Description,
 	}
 }
@@ -62,12 +67,26 @@ func projectListHandler(ctx context.Context, client interface{}, params map[stri
 	nameFilter := paramutil.ExtractOptionalString(params, paramutil.ParamName)
 	limit := paramutil.ExtractInt64(params, paramutil.ParamLimit, 100)
 	page := paramutil.ExtractInt64(params, paramutil.ParamPage, 1)
+	includeErrors := paramutil.ExtractBool(params, paramutil.ParamIncludeErrors, false)
 
 	clusterID, _ := paramutil.ResolveOptionalCluster(ctx, normanClient, params)
 
-	allProjects, err := fetchProjects(ctx, normanClient, clusterID)
-	if err != nil {
-		return "", err
+	clusterIDs := []string{clusterID}
+	if clusterID == "" {
+		clusters, err := normanClient.ListClusters(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list clusters: %w", err)
+		}
+		clusterIDs = make([]string, len(clusters))
+		for i, c := range clusters {
+			clusterIDs[i] = c.ID
+		}
+	}
+
+	allProjects, failures := fetchProjects(ctx, normanClient.ListProjects, clusterIDs)
+	// A single requested cluster that fails is an error, not an empty list
+	if clusterID != "" && len(failures) > 0 {
+		return "", fmt.Errorf("failed to list projects for cluster %s: %s", clusterID, failures[0].Error)
 	}
 
 	// Apply name filter
@@ -81,7 +100,138 @@ func projectListHandler(ctx context.Context, client interface{}, params map[stri
 		projectMaps[i] = projectToMap(p)
 	}
 
-	return paramutil.FormatOutput(projectMaps, format, []string{"id", "name", "cluster", "state", "created", "description"}, nil)
+	if !includeErrors {
+		return paramutil.FormatOutput(projectDisplayRows(projectMaps, format), format, projectTableHeaders, nil)
+	}
+	return formatProjectListWithErrors(projectMaps, failures, format)
+}
+
+// projectDisplayRows renders the created timestamps in the configured time
+// display mode for table and markdown output. json, yaml and csv keep RFC 3339.
+func projectDisplayRows(projects []map[string]string, format string) []map[string]string {
+	if format != paramutil.FormatTable && format != paramutil.FormatMarkdown {
+		return projects
+	}
+	rows := make([]map[string]string, len(projects))
+	for i, p := range projects {
+		row := maps.Clone(p)
+		row["created"] = paramutil.FormatTime(p["created"])
+		rows[i] = row
+	}
+	return rows
+}
+
+// formatProjectListWithErrors renders projects together with the clusters that
+// could not be listed, in the same layout as node_list.
+func formatProjectListWithErrors(projects []map[string]string, failures []clusterError, format string) (string, error) {
+	if failures == nil {
+		failures = []clusterError{}
+	}
+
+	switch format {
+	case paramutil.FormatYAML:
+		return paramutil.FormatAsYAML(projectListResult{Projects: projects, Errors: failures})
+	case paramutil.FormatJSON:
+		return paramutil.FormatAsJSON(projectListResult{Projects: projects, Errors: failures})
+	}
+	return formatRowsWithClusterErrors(projectDisplayRows(projects, format), projectTableHeaders, failures, format)
+}
+
+// projectGetHandler handles the project_get tool.
+func projectGetHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	clusterID, err := paramutil.ResolveCluster(ctx, normanClient, params)
+	if err != nil {
+		return "", err
+	}
+	projectID, err := paramutil.ExtractRequiredString(params, paramutil.ParamProject)
+	if err != nil {
+		return "", err
+	}
+
+	// LookupProject rejects projects that belong to a different cluster
+	project, err := normanClient.LookupProject(ctx, clusterID, projectID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatProjectDetail(*project, format)
+}
+
+// projectDetailToMap converts a project to a detailed map including resource quota limits.
+func projectDetailToMap(p norman.Project) map[string]interface{} {
+	var projectQuota, namespaceQuota map[string]string
+	if p.ResourceQuota != nil {
+		projectQuota = quotaLimitToMap(p.ResourceQuota.Limit)
+	}
+	if p.NamespaceDefaultResourceQuota != nil {
+		namespaceQuota = quotaLimitToMap(p.NamespaceDefaultResourceQuota.Limit)
+	}
+	return map[string]interface{}{
+		"id":                            p.ID,
+		"name":                          p.Name,
+		"clusterId":                     p.ClusterID,
+		"state":                         p.State,
+		"description":                   p.Description,
+		"resourceQuota":                 projectQuota,
+		"namespaceDefaultResourceQuota": namespaceQuota,
+	}
+}
+
+// formatProjectDetail renders a single project. The row formats flatten
+// quota limits into "resource=value" lists.
+func formatProjectDetail(p norman.Project, format string) (string, error) {
+	data := projectDetailToMap(p)
+	if !paramutil.IsRowFormat(format) {
+		return paramutil.FormatSingleResult(data, format)
+	}
+
+	data["resourceQuota"] = formatQuotaLimit(data["resourceQuota"].(map[string]string))
+	data["namespaceDefaultResourceQuota"] = formatQuotaLimit(data["namespaceDefaultResourceQuota"].(map[string]string))
+	return paramutil.FormatSingleResult(data, format, "id", "name", "clusterId", "state", "description", "resourceQuota", "namespaceDefaultResourceQuota")
+}
+
+// quotaLimitToMap converts a quota limit into a map of the limits that are set.
+func quotaLimitToMap(limit *norman.ResourceQuotaLimit) map[string]string {
+	if limit == nil {
+		return nil
+	}
+	// Every field is an omitempty string, so a JSON round trip keeps only the set limits
+	data, err := json.Marshal(limit)
+	if err != nil {
+		return nil
+	}
+	var result map[string]string
+	if err := json.Unmarshal(data, &result); err != nil || len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// formatQuotaLimit renders quota limits as a sorted "resource=value" list, or "-" when unset.
+func formatQuotaLimit(limits map[string]string) string {
+	if len(limits) == 0 {
+		return "-"
+	}
+	keys := make([]string, 0, len(limits))
+	for k := range limits {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + limits[k]
+	}
+	return strings.Join(parts, ",")
 }
 
 // filterProjectsByName filters projects by name (partial match, case-insensitive).
@@ -1,9 +1,15 @@
 package rancher
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	managementClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+
 	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
 )
 
 func TestProjectToMap(t *testing.T) {
@@ -31,13 +37,41 @@ func TestProjectToMap(t *testing.T) {
 		t.Errorf("expected state 'active', got %q", result["state"])
 	}
 	if result["created"] != "2024-01-15T10:30:00Z" {
-		t.Errorf("expected created timestamp, got %q", result["created"])
+		t.Errorf("expected RFC 3339 created timestamp, got %q", result["created"])
 	}
 	if result["description"] != "A test project" {
 		t.Errorf("expected description, got %q", result["description"])
 	}
 }
 
+func TestProjectDisplayRows(t *testing.T) {
+	p := norman.Project{Name: "test-project"}
+	p.Created = "2024-01-15T10:30:00Z"
+	projects := []map[string]string{projectToMap(p)}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{paramutil.FormatTable, paramutil.FormatTime("2024-01-15T10:30:00Z")},
+		{paramutil.FormatMarkdown, paramutil.FormatTime("2024-01-15T10:30:00Z")},
+		{paramutil.FormatJSON, "2024-01-15T10:30:00Z"},
+		{paramutil.FormatYAML, "2024-01-15T10:30:00Z"},
+		{paramutil.FormatCSV, "2024-01-15T10:30:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			rows := projectDisplayRows(projects, tt.format)
+			if rows[0]["created"] != tt.want {
+				t.Errorf("created = %q, want %q", rows[0]["created"], tt.want)
+			}
+		})
+	}
+	if projects[0]["created"] != "2024-01-15T10:30:00Z" {
+		t.Errorf("display rows must not modify the source maps, got %q", projects[0]["created"])
+	}
+}
+
 func TestProjectToMap_EmptyFields(t *testing.T) {
 	p := norman.Project{}
 	result := projectToMap(p)
@@ -45,11 +79,78 @@ func TestProjectToMap_EmptyFields(t *testing.T) {
 	if result["created"] != "-" {
 		t.Errorf("expected '-' for empty created, got %q", result["created"])
 	}
+	if result["age"] != "-" {
+		t.Errorf("expected '-' for empty age, got %q", result["age"])
+	}
 	if result["description"] != "" {
 		t.Errorf("expected empty description, got %q", result["description"])
 	}
 }
 
+func TestFetchProjects(t *testing.T) {
+	listProjects := func(_ context.Context, clusterID string) ([]norman.Project, error) {
+		if clusterID == "c-broken" {
+			return nil, errors.New("cluster agent disconnected")
+		}
+		return []norman.Project{
+			{Name: clusterID + "-default", ClusterID: clusterID},
+			{Name: clusterID + "-system", ClusterID: clusterID},
+		}, nil
+	}
+
+	projects, failures := fetchProjects(context.Background(), listProjects, []string{"c-a", "c-broken", "c-b"})
+
+	var names []string
+	for _, p := range projects {
+		names = append(names, p.Name)
+	}
+	if got, want := strings.Join(names, ","), "c-a-default,c-a-system,c-b-default,c-b-system"; got != want {
+		t.Errorf("projects = %s, want %s", got, want)
+	}
+	if len(failures) != 1 || failures[0].Cluster != "c-broken" || failures[0].Error != "cluster agent disconnected" {
+		t.Errorf("failures = %+v, want only c-broken", failures)
+	}
+}
+
+func TestFormatProjectListWithErrors(t *testing.T) {
+	projects := []map[string]string{projectToMap(norman.Project{Name: "default", ClusterID: "c-a"})}
+	failures := []clusterError{{Cluster: "c-broken", Error: "timeout"}}
+
+	tests := []struct {
+		name     string
+		format   string
+		failures []clusterError
+		want     []string
+		notWant  []string
+	}{
+		{name: "json errors section", format: "json", failures: failures, want: []string{`"projects"`, `"errors"`, `"c-broken"`}},
+		{name: "json empty errors", format: "json", want: []string{`"errors": []`}},
+		{name: "yaml errors section", format: "yaml", failures: failures, want: []string{"projects:", "errors:", "cluster: c-broken"}},
+		{name: "table failed clusters", format: "table", failures: failures, want: []string{"default", "Failed clusters", "c-broken", "timeout"}},
+		{name: "table without failures", format: "table", want: []string{"default"}, notWant: []string{"Failed clusters"}},
+		{name: "csv failed clusters", format: "csv", failures: failures, want: []string{"id,name,cluster,state,created,age,description\n", "\ncluster,error\nc-broken,timeout\n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := formatProjectListWithErrors(projects, tt.failures, tt.format)
+			if err != nil {
+				t.Fatalf("formatProjectListWithErrors() unexpected error: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("expected output to contain %q, got:\n%s", w, out)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(out, w) {
+					t.Errorf("expected output not to contain %q, got:\n%s", w, out)
+				}
+			}
+		})
+	}
+}
+
 func TestFilterProjectsByName(t *testing.T) {
 	projects := []norman.Project{
 		{Name: "System"},
@@ -78,3 +179,58 @@ func TestFilterProjectsByName(t *testing.T) {
 		}
 	})
 }
+
+func TestProjectDetailToMap(t *testing.T) {
+	p := norman.Project{
+		Name:        "web",
+		ClusterID:   "c-abc123",
+		Description: "Web team",
+		ResourceQuota: &managementClient.ProjectResourceQuota{
+			Limit: &norman.ResourceQuotaLimit{LimitsCPU: "4000m", Pods: "50"},
+		},
+	}
+	p.ID = "c-abc123:p-xyz789"
+
+	result := projectDetailToMap(p)
+
+	if result["clusterId"] != "c-abc123" {
+		t.Errorf("expected clusterId 'c-abc123', got %v", result["clusterId"])
+	}
+	quota, ok := result["resourceQuota"].(map[string]string)
+	if !ok {
+		t.Fatalf("expected resourceQuota map, got %T", result["resourceQuota"])
+	}
+	if len(quota) != 2 || quota["limitsCpu"] != "4000m" || quota["pods"] != "50" {
+		t.Errorf("unexpected resourceQuota %v", quota)
+	}
+	if result["namespaceDefaultResourceQuota"].(map[string]string) != nil {
+		t.Errorf("expected nil namespaceDefaultResourceQuota, got %v", result["namespaceDefaultResourceQuota"])
+	}
+}
+
+func TestFormatProjectDetail_Table(t *testing.T) {
+	p := norman.Project{
+		Name:      "web",
+		ClusterID: "c-abc123",
+		ResourceQuota: &managementClient.ProjectResourceQuota{
+			Limit: &norman.ResourceQuotaLimit{Pods: "50", LimitsCPU: "4000m"},
+		},
+	}
+
+	out, err := formatProjectDetail(p, "table")
+	if err != nil {
+		t.Fatalf("formatProjectDetail() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "limitsCpu=4000m,pods=50") {
+		t.Errorf("expected sorted quota list in table, got:\n%s", out)
+	}
+}
+
+func TestFormatQuotaLimit(t *testing.T) {
+	if got := formatQuotaLimit(nil); got != "-" {
+		t.Errorf("formatQuotaLimit(nil) = %q, want %q", got, "-")
+	}
+	if got := formatQuotaLimit(map[string]string{"secrets": "10", "configMaps": "5"}); got != "configMaps=5,secrets=10" {
+		t.Errorf("formatQuotaLimit() = %q, want %q", got, "configMaps=5,secrets=10")
+	}
+}
@@ -0,0 +1,106 @@
+package rancher
+
+import (
+	"context"
+	"strings"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset"
+	"github.com/futuretea/rancher-mcp-server/pkg/toolset/paramutil"
+)
+
+// projectMembersHandler handles the project_members tool
+func projectMembersHandler(ctx context.Context, client interface{}, params map[string]interface{}) (string, error) {
+	normanClient, err := toolset.ValidateNormanClient(client)
+	if err != nil {
+		return "", err
+	}
+
+	format, err := paramutil.ExtractAndValidateFormat(params)
+	if err != nil {
+		return "", err
+	}
+
+	clusterID, err := paramutil.ResolveCluster(ctx, normanClient, params)
+	if err != nil {
+		return "", err
+	}
+	projectID, err := paramutil.ExtractRequiredString(params, paramutil.ParamProject)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract query and pagination parameters
+	principalFilter := paramutil.ExtractOptionalString(params, paramutil.ParamPrincipal)
+	limit := paramutil.ExtractInt64(params, paramutil.ParamLimit, 100)
+	page := paramutil.ExtractInt64(params, paramutil.ParamPage, 1)
+
+	project, err := normanClient.LookupProject(ctx, clusterID, projectID)
+	if err != nil {
+		return "", err
+	}
+
+	bindings, err := normanClient.ListProjectRoleTemplateBindings(ctx, project.ID)
+	if err != nil {
+		return "", err
+	}
+
+	// Apply principal filter
+	filtered := filterBindingsByPrincipal(bindings, principalFilter)
+
+	// Apply pagination
+	paginated, _ := paramutil.ApplyPagination(filtered, limit, page)
+
+	memberMaps := make([]map[string]string, len(paginated))
+	for i, b := range paginated {
+		memberMaps[i] = projectBindingToMap(b)
+	}
+
+	return paramutil.FormatOutput(memberMaps, format, []string{"id", "principal", "principalType", "role"}, nil)
+}
+
+// bindingPrincipal returns the principal a binding grants access to and its
+// type (user, group or serviceAccount). Principal IDs are preferred over local
+// IDs since they identify users from external auth providers.
+func bindingPrincipal(b norman.ProjectRoleTemplateBinding) (principal, principalType string) {
+	switch {
+	case b.UserPrincipalID != "":
+		return b.UserPrincipalID, "user"
+	case b.UserID != "":
+		return b.UserID, "user"
+	case b.GroupPrincipalID != "":
+		return b.GroupPrincipalID, "group"
+	case b.GroupID != "":
+		return b.GroupID, "group"
+	case b.ServiceAccount != "":
+		return b.ServiceAccount, "serviceAccount"
+	default:
+		return "", ""
+	}
+}
+
+// projectBindingToMap converts a project role binding to a string map for output formatting.
+func projectBindingToMap(b norman.ProjectRoleTemplateBinding) map[string]string {
+	principal, principalType := bindingPrincipal(b)
+	return map[string]string{
+		"id":            b.ID,
+		"principal":     principal,
+		"principalType": principalType,
+		"role":          b.RoleTemplateID,
+	}
+}
+
+// filterBindingsByPrincipal filters bindings by principal (partial match, case-insensitive).
+func filterBindingsByPrincipal(bindings []norman.ProjectRoleTemplateBinding, principal string) []norman.ProjectRoleTemplateBinding {
+	if principal == "" {
+		return bindings
+	}
+	var result []norman.ProjectRoleTemplateBinding
+	for _, b := range bindings {
+		p, _ := bindingPrincipal(b)
+		if strings.Contains(strings.ToLower(p), strings.ToLower(principal)) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
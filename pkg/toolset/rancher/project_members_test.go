@@ -0,0 +1,87 @@
+package rancher
+
+import (
+	"testing"
+
+	"github.com/futuretea/rancher-mcp-server/pkg/client/norman"
+)
+
+func TestProjectBindingToMap(t *testing.T) {
+	tests := []struct {
+		name          string
+		binding       norman.ProjectRoleTemplateBinding
+		wantPrincipal string
+		wantType      string
+	}{
+		{
+			name:          "user principal preferred over user id",
+			binding:       norman.ProjectRoleTemplateBinding{UserID: "u-abc", UserPrincipalID: "local://u-abc", RoleTemplateID: "project-owner"},
+			wantPrincipal: "local://u-abc",
+			wantType:      "user",
+		},
+		{
+			name:          "user id only",
+			binding:       norman.ProjectRoleTemplateBinding{UserID: "u-abc", RoleTemplateID: "project-member"},
+			wantPrincipal: "u-abc",
+			wantType:      "user",
+		},
+		{
+			name:          "group principal",
+			binding:       norman.ProjectRoleTemplateBinding{GroupPrincipalID: "github_team://123", RoleTemplateID: "read-only"},
+			wantPrincipal: "github_team://123",
+			wantType:      "group",
+		},
+		{
+			name:          "service account",
+			binding:       norman.ProjectRoleTemplateBinding{ServiceAccount: "default:ci", RoleTemplateID: "project-member"},
+			wantPrincipal: "default:ci",
+			wantType:      "serviceAccount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.binding.ID = "p-xyz:prtb-1"
+			result := projectBindingToMap(tt.binding)
+			if result["principal"] != tt.wantPrincipal {
+				t.Errorf("principal = %q, want %q", result["principal"], tt.wantPrincipal)
+			}
+			if result["principalType"] != tt.wantType {
+				t.Errorf("principalType = %q, want %q", result["principalType"], tt.wantType)
+			}
+			if result["role"] != tt.binding.RoleTemplateID {
+				t.Errorf("role = %q, want %q", result["role"], tt.binding.RoleTemplateID)
+			}
+			if result["id"] != "p-xyz:prtb-1" {
+				t.Errorf("id = %q, want %q", result["id"], "p-xyz:prtb-1")
+			}
+		})
+	}
+}
+
+func TestFilterBindingsByPrincipal(t *testing.T) {
+	bindings := []norman.ProjectRoleTemplateBinding{
+		{UserPrincipalID: "local://u-alice"},
+		{GroupPrincipalID: "github_team://Platform"},
+		{UserID: "u-bob"},
+	}
+
+	t.Run("empty filter returns all", func(t *testing.T) {
+		if result := filterBindingsByPrincipal(bindings, ""); len(result) != 3 {
+			t.Fatalf("expected 3, got %d", len(result))
+		}
+	})
+
+	t.Run("case insensitive partial match", func(t *testing.T) {
+		result := filterBindingsByPrincipal(bindings, "platform")
+		if len(result) != 1 || result[0].GroupPrincipalID != "github_team://Platform" {
+			t.Fatalf("expected [github_team://Platform], got %d items", len(result))
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		if result := filterBindingsByPrincipal(bindings, "carol"); len(result) != 0 {
+			t.Fatalf("expected 0, got %d", len(result))
+		}
+	})
+}
@@ -26,7 +26,13 @@ func (t *Toolset) GetDescription() string {
 func (t *Toolset) GetTools(_ interface{}) []toolset.ServerTool {
 	return []toolset.ServerTool{
 		clusterListTool(),
+		clusterHealthTool(),
+		clusterKubeconfigTool(),
+		nodeListTool(),
 		projectListTool(),
+		projectGetTool(),
+		projectMembersTool(),
+		namespaceProjectTool(),
 	}
 }
 
@@ -56,8 +62,8 @@ func clusterListTool() toolset.ServerTool {
 					},
 					"format": map[string]any{
 						"type":        "string",
-						"description": "Output format: json, table, or yaml",
-						"enum":        []string{"json", "table", "yaml"},
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
 						"default":     "json",
 					},
 				},
@@ -70,6 +76,132 @@ func clusterListTool() toolset.ServerTool {
 	}
 }
 
+// clusterHealthTool returns the cluster_health tool definition.
+func clusterHealthTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "cluster_health",
+			Description: "Summarize a Rancher cluster's health: cluster state, Ready condition, component statuses, node readiness, and unhealthy control-plane/etcd nodes, with an overall OK/DEGRADED verdict",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Cluster ID (use cluster_list to get available cluster IDs)",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+				Required: []string{"cluster"},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: clusterHealthHandler,
+	}
+}
+
+// clusterKubeconfigTool returns the cluster_kubeconfig tool definition.
+func clusterKubeconfigTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "cluster_kubeconfig",
+			Description: "Generate a kubeconfig for a Rancher cluster backed by a newly minted, short-lived token. The token lifetime (ttlSeconds) and whether it is scoped to this cluster only are configurable; format=token returns just the bearer token. Disabled unless the server enables it (enable_cluster_kubeconfig) and unavailable in read-only mode, because it creates a credential.",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Cluster ID (use cluster_list to get available cluster IDs)",
+					},
+					"ttlSeconds": map[string]any{
+						"type":        "integer",
+						"description": "Token lifetime in seconds; values above the server's kubeconfig_max_ttl (default 24h) are rejected, and Rancher may cap it further at its auth-token-max-ttl-minutes setting",
+						"default":     DefaultKubeconfigTTLSeconds,
+						"minimum":     MinKubeconfigTTLSeconds,
+					},
+					"clusterScoped": map[string]any{
+						"type":        "boolean",
+						"description": "Scope the token to this cluster only, instead of every cluster and the Rancher API the user can reach",
+						"default":     true,
+					},
+					"project": map[string]any{
+						"type":        "string",
+						"description": "Project ID to scope the token to (use project_list to get available project IDs). The context defaults to the project's first namespace. Fails with a capability error when the Rancher server only supports cluster-scoped tokens",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: kubeconfig (YAML) or token (the bearer token only)",
+						"enum":        []string{kubeconfigFormatKubeconfig, kubeconfigFormatToken},
+						"default":     kubeconfigFormatKubeconfig,
+					},
+				},
+				Required: []string{"cluster"},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint:    paramutil.BoolPtr(false),
+			DestructiveHint: paramutil.BoolPtr(false),
+		},
+		Handler: clusterKubeconfigHandler,
+	}
+}
+
+// nodeListTool returns the node_list tool definition.
+func nodeListTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "node_list",
+			Description: "List Rancher nodes across clusters with their roles and readiness",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Filter by cluster ID (use cluster_list to get available cluster IDs); all clusters when empty",
+						"default":     "",
+					},
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Filter by node name (partial match)",
+						"default":     "",
+					},
+					"includeErrors": map[string]any{
+						"type":        "boolean",
+						"description": "Report clusters whose nodes could not be listed instead of silently skipping them",
+						"default":     false,
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Number of items per page",
+						"default":     100,
+					},
+					"page": map[string]any{
+						"type":        "integer",
+						"description": "Page number (starting from 1)",
+						"default":     1,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: nodeListHandler,
+	}
+}
+
 // projectListTool returns the project_list tool definition.
 func projectListTool() toolset.ServerTool {
 	return toolset.ServerTool{
@@ -89,6 +221,11 @@ func projectListTool() toolset.ServerTool {
 						"description": "Filter by project name (partial match)",
 						"default":     "",
 					},
+					"includeErrors": map[string]any{
+						"type":        "boolean",
+						"description": "Report clusters whose projects could not be listed instead of silently skipping them",
+						"default":     false,
+					},
 					"limit": map[string]any{
 						"type":        "integer",
 						"description": "Number of items per page",
@@ -101,8 +238,8 @@ func projectListTool() toolset.ServerTool {
 					},
 					"format": map[string]any{
 						"type":        "string",
-						"description": "Output format: json, table, or yaml",
-						"enum":        []string{"json", "table", "yaml"},
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
 						"default":     "json",
 					},
 				},
@@ -114,3 +251,121 @@ func projectListTool() toolset.ServerTool {
 		Handler: projectListHandler,
 	}
 }
+
+// projectGetTool returns the project_get tool definition.
+func projectGetTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "project_get",
+			Description: "Get a Rancher project by ID, including its resource quota limits",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Cluster ID (use cluster_list to get available cluster IDs)",
+					},
+					"project": map[string]any{
+						"type":        "string",
+						"description": "Project ID (use project_list to get available project IDs)",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+				Required: []string{"cluster", "project"},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: projectGetHandler,
+	}
+}
+
+// projectMembersTool returns the project_members tool definition.
+func projectMembersTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "project_members",
+			Description: "List the users and groups with access to a Rancher project and their role templates (project role template bindings)",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Cluster ID (use cluster_list to get available cluster IDs)",
+					},
+					"project": map[string]any{
+						"type":        "string",
+						"description": "Project ID (use project_list to get available project IDs)",
+					},
+					"principal": map[string]any{
+						"type":        "string",
+						"description": "Filter by user or group principal (partial match)",
+						"default":     "",
+					},
+					"limit": map[string]any{
+						"type":        "integer",
+						"description": "Number of items per page",
+						"default":     100,
+					},
+					"page": map[string]any{
+						"type":        "integer",
+						"description": "Page number (starting from 1)",
+						"default":     1,
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+				Required: []string{"cluster", "project"},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint: paramutil.BoolPtr(true),
+		},
+		Handler: projectMembersHandler,
+	}
+}
+
+// namespaceProjectTool returns the namespace_project tool definition.
+func namespaceProjectTool() toolset.ServerTool {
+	return toolset.ServerTool{
+		Tool: mcp.Tool{
+			Name:        "namespace_project",
+			Description: "Show which Rancher project a namespace belongs to, detected from the namespace's field.cattle.io/projectId annotation or label",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]any{
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "Cluster ID (use cluster_list to get available cluster IDs)",
+					},
+					"namespace": map[string]any{
+						"type":        "string",
+						"description": "Namespace name",
+					},
+					"format": map[string]any{
+						"type":        "string",
+						"description": "Output format: json, table, yaml, csv, or markdown",
+						"enum":        []string{"json", "table", "yaml", "csv", "markdown"},
+						"default":     "json",
+					},
+				},
+				Required: []string{"cluster", "namespace"},
+			},
+		},
+		Annotations: toolset.ToolAnnotations{
+			ReadOnlyHint:       paramutil.BoolPtr(true),
+			RequiresKubernetes: paramutil.BoolPtr(true),
+		},
+		Handler: namespaceProjectHandler,
+	}
+}